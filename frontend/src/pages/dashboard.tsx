@@ -2,7 +2,7 @@ import Head from "next/head";
 import { useRouter } from "next/router";
 import { useEffect, useRef, useState } from "react";
 import { useQueryClient } from "@tanstack/react-query";
-import { useAddItem, useCompleteItem, useItems, ItemWithChildren, organizeItemsIntoTree } from "~/query/item";
+import { useAddItem, useCompleteItem, useItems, ItemWithChildren, organizeItemsIntoTree, formatMoney, parseMoney } from "~/query/item";
 import { User, useUser, useUserById } from "~/query/user";
 import { useCreateWebsocket, useWebsocket, WebSocketProvider } from "~/ws/hook";
 
@@ -212,9 +212,9 @@ function TaskItem({
                                 {item.created_by && (
                                     <TaskCreator userId={item.created_by} isSubtask={isSubtask} />
                                 )}
-                                {item.cost !== 0 ?
+                                {item.cost && item.cost.amount !== 0 ?
                                     <div className="mt-1 text-xs text-gray-500">
-                                        Cost: {item.cost}
+                                        Cost: {formatMoney(item.cost)}
                                     </div> : null
                                 }
                                 {hasChildren && (
@@ -420,7 +420,7 @@ function AddItemModal({ onClose, parentId }: { onClose: () => void, parentId?: s
 
         // Use either the provided parentId or the selected one from dropdown
         const selectedParentId = parentId || (parentElement?.value !== "none" ? parentElement?.value : undefined);
-        const selectedCost = (costElement?.value !== "" ? parseInt(costElement?.value) : undefined);
+        const selectedCost = (costElement?.value !== "" ? parseMoney(costElement?.value) : undefined);
 
         const formData = {
             title: titleElement.value,
@@ -489,8 +489,10 @@ function AddItemModal({ onClose, parentId }: { onClose: () => void, parentId?: s
                         <input
                             className="shadow appearance-none border rounded w-full py-2 px-3 text-gray-700 leading-tight focus:outline-none focus:shadow-outline"
                             id="cost"
-                            type="text"
-                            placeholder="Enter task cost"
+                            type="number"
+                            min="0"
+                            step="0.01"
+                            placeholder="Enter task cost, e.g. 12.50"
                         />
                     </div>
 
@@ -4,13 +4,73 @@ import { useWebsocket, EnhancedWebSocket } from '~/ws/hook';
 import { User } from './user';
 import { env } from '~/env';
 
+/**
+ * An amount in the minor units of the currency, e.g. cents. The server uses
+ * its configured currency when the currency is left out.
+ */
+export type Money = {
+    amount: number;
+    currency?: string;
+}
+
 export type Item = {
     title: string;
     id: string;
     completed?: boolean;
     created_by: number;
     parent_id?: string;
-    cost?: number;
+    cost?: Money;
+}
+
+/**
+ * Number of digits after the decimal point in the currency, e.g. 2 for EUR.
+ */
+function minorDigits(currency?: string): number {
+    if (!currency) {
+        return 2;
+    }
+
+    try {
+        return new Intl.NumberFormat(undefined, { style: 'currency', currency })
+            .resolvedOptions().maximumFractionDigits;
+    } catch {
+        return 2;
+    }
+}
+
+/**
+ * Converts an amount typed in by the user, e.g. "12.50", to the minor units
+ * of the currency. Returns undefined when it is not a number.
+ */
+export function parseMoney(value: string, currency?: string): Money | undefined {
+    const amount = parseFloat(value);
+    if (isNaN(amount)) {
+        return undefined;
+    }
+
+    return { amount: Math.round(amount * 10 ** minorDigits(currency)), currency };
+}
+
+export function formatMoney(money: Money): string {
+    const digits = minorDigits(money.currency);
+    const value = money.amount / 10 ** digits;
+    if (!money.currency) {
+        return value.toFixed(digits);
+    }
+
+    try {
+        return new Intl.NumberFormat(undefined, { style: 'currency', currency: money.currency }).format(value);
+    } catch {
+        return `${value} ${money.currency}`;
+    }
+}
+
+/**
+ * Tasks cached before costs had a currency have the cost as a plain number,
+ * the server doesn't accept those anymore.
+ */
+function hasLegacyCosts(tasks: Item[]): boolean {
+    return tasks.some(task => typeof task.cost === 'number');
 }
 
 export function useItems() {
@@ -22,8 +82,11 @@ export function useItems() {
 
             // If we've already fetched tasks and have them in localStorage, use that
             if (hasFetchedTasks && tasksString) {
-                console.log('Using cached tasks from localStorage');
-                return JSON.parse(tasksString) as Item[];
+                const cachedTasks = JSON.parse(tasksString) as Item[];
+                if (!hasLegacyCosts(cachedTasks)) {
+                    console.log('Using cached tasks from localStorage');
+                    return cachedTasks;
+                }
             }
 
             // Otherwise fetch from server (first login or explicit refresh)
@@ -49,7 +112,9 @@ export function useItems() {
                 // If we have data in localStorage, use it as fallback
                 if (tasksString) {
                     console.log('Falling back to localStorage tasks');
-                    return JSON.parse(tasksString) as Item[];
+                    return (JSON.parse(tasksString) as Item[]).map(task =>
+                        typeof task.cost === 'number' ? { ...task, cost: undefined } : task
+                    );
                 }
 
                 // Otherwise return empty array
@@ -0,0 +1,20 @@
+// Package blob keeps the contents of uploaded files. The metadata of the files
+// lives in the DB, this only knows about keys and bytes.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when there is nothing stored under the key.
+var ErrNotFound = errors.New("blob not found")
+
+// Store is implemented by the local filesystem and S3, which one is used is
+// picked by the config.
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,201 @@
+package blob
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		store func(t *testing.T) Store
+	}{
+		{
+			name: "local",
+			store: func(t *testing.T) Store {
+				store, err := NewLocal(t.TempDir())
+				require.NoError(t, err)
+				return store
+			},
+		},
+		{
+			name: "s3",
+			store: func(t *testing.T) Store {
+				srv := httptest.NewServer(newFakeS3())
+				t.Cleanup(srv.Close)
+
+				store, err := NewS3(context.Background(), S3Options{
+					Endpoint:  strings.TrimPrefix(srv.URL, "http://"),
+					Region:    "us-east-1",
+					Bucket:    "attachments",
+					AccessKey: "minioadmin",
+					SecretKey: "minioadmin",
+				})
+				require.NoError(t, err)
+				return store
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			store := tt.store(t)
+			content := []byte("receipt for the groceries")
+
+			_, err := store.Get(ctx, "missing")
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			require.NoError(t, store.Put(ctx, "key", bytes.NewReader(content), int64(len(content)), "text/plain"))
+
+			r, err := store.Get(ctx, "key")
+			require.NoError(t, err)
+			got, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.Equal(t, content, got)
+
+			require.NoError(t, store.Delete(ctx, "key"))
+
+			_, err = store.Get(ctx, "key")
+			assert.ErrorIs(t, err, ErrNotFound)
+		})
+	}
+}
+
+func TestLocalRejectsPathKeys(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", ".", "..", "../escape", "a/b"} {
+		err := store.Put(context.Background(), key, strings.NewReader("x"), 1, "text/plain")
+		assert.Error(t, err, "key %q must be rejected", key)
+	}
+}
+
+// fakeS3 is a stand-in for MinIO that knows just enough of the S3 API for
+// the S3 store: path style buckets and put, get, stat and delete of objects.
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]bool
+	objects map[string][]byte
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{buckets: map[string]bool{}, objects: map[string][]byte{}}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	if key == "" {
+		switch r.Method {
+		case http.MethodHead:
+			if !f.buckets[bucket] {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		case http.MethodPut:
+			f.buckets[bucket] = true
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+		return
+	}
+
+	if !f.buckets[bucket] {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	name := bucket + "/" + key
+	switch r.Method {
+	case http.MethodPut:
+		body, err := readS3Body(r)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		f.objects[name] = body
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodGet, http.MethodHead:
+		body, ok := f.objects[name]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 19 Oct 2026 10:00:00 GMT")
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		if r.Method == http.MethodGet {
+			w.Write(body)
+		}
+	case http.MethodDelete:
+		delete(f.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// readS3Body undoes the aws-chunked encoding the client uses for signed
+// uploads over plain HTTP.
+func readS3Body(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var body bytes.Buffer
+	br := bufio.NewReader(r.Body)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chunk size: %w", err)
+		}
+
+		if size == 0 {
+			return body.Bytes(), nil
+		}
+
+		if _, err := io.CopyN(&body, br, size); err != nil {
+			return nil, err
+		}
+
+		if _, err := br.Discard(2); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
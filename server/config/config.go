@@ -2,7 +2,11 @@ package config
 
 import (
 	"cmp"
+	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func Load() *Config {
@@ -14,12 +18,71 @@ func Load() *Config {
 			Driver: cmp.Or(os.Getenv("DB_DRIVER"), "sqlite3"),
 			DSN:    cmp.Or(os.Getenv("DB_DSN"), "./db.sqlite"),
 		},
+		Reminders: Reminders{
+			LeadTimes:    durationsOr("REMINDER_LEAD_TIMES", []time.Duration{24 * time.Hour, time.Hour}),
+			PollInterval: durationOr("REMINDER_POLL_INTERVAL", time.Minute),
+		},
+		Trash: Trash{
+			Retention:     durationOr("TRASH_RETENTION", 30*24*time.Hour),
+			PurgeInterval: durationOr("TRASH_PURGE_INTERVAL", time.Hour),
+		},
+		Archive: Archive{
+			After:          durationOr("ARCHIVE_AFTER", 30*24*time.Hour),
+			PollInterval:   durationOr("ARCHIVE_POLL_INTERVAL", time.Hour),
+			RollUpArchived: os.Getenv("ARCHIVE_ROLL_UP_COST") != "false",
+		},
+		Undo: Undo{
+			Depth: int(int64Or("UNDO_DEPTH", 50)),
+		},
+		Costs: Costs{
+			Currency: cmp.Or(os.Getenv("COST_CURRENCY"), "EUR"),
+			Rates:    mapOr("COST_RATES", map[string]string{}),
+		},
+		Attachments: Attachments{
+			Backend: cmp.Or(os.Getenv("ATTACHMENTS_BACKEND"), "local"),
+			Dir:     cmp.Or(os.Getenv("ATTACHMENTS_DIR"), "./attachments"),
+			MaxSize: int64Or("ATTACHMENTS_MAX_SIZE", 10<<20),
+			AllowedTypes: listOr("ATTACHMENTS_ALLOWED_TYPES", []string{
+				"image/png", "image/jpeg", "image/gif", "image/webp", "application/pdf", "text/plain",
+			}),
+			S3: S3{
+				Endpoint:  os.Getenv("S3_ENDPOINT"),
+				Region:    cmp.Or(os.Getenv("S3_REGION"), "us-east-1"),
+				Bucket:    cmp.Or(os.Getenv("S3_BUCKET"), "attachments"),
+				AccessKey: os.Getenv("S3_ACCESS_KEY"),
+				SecretKey: os.Getenv("S3_SECRET_KEY"),
+				UseSSL:    os.Getenv("S3_USE_SSL") != "false",
+			},
+		},
+		Webhooks: Webhooks{
+			MaxAttempts:  int(int64Or("WEBHOOK_MAX_ATTEMPTS", 8)),
+			RetryBackoff: durationOr("WEBHOOK_RETRY_BACKOFF", 30*time.Second),
+			Timeout:      durationOr("WEBHOOK_TIMEOUT", 10*time.Second),
+			PollInterval: durationOr("WEBHOOK_POLL_INTERVAL", 10*time.Second),
+		},
+		SMTP: SMTP{
+			Addr:           os.Getenv("SMTP_ADDR"),
+			Domain:         cmp.Or(os.Getenv("SMTP_DOMAIN"), "localhost"),
+			MaxMessageSize: int64Or("SMTP_MAX_MESSAGE_SIZE", 10<<20),
+		},
+		GRPC: GRPC{
+			Addr: os.Getenv("GRPC_ADDR"),
+		},
 	}
 }
 
 type Config struct {
-	HTTP HTTP
-	DB   DB
+	HTTP        HTTP
+	DB          DB
+	Reminders   Reminders
+	Trash       Trash
+	Archive     Archive
+	Undo        Undo
+	Costs       Costs
+	Attachments Attachments
+	Webhooks    Webhooks
+	SMTP        SMTP
+	GRPC        GRPC
 }
 
 type HTTP struct {
@@ -30,3 +93,185 @@ type DB struct {
 	Driver string
 	DSN    string
 }
+
+type Reminders struct {
+	// LeadTimes are how long before the due date the due soon reminders are
+	// sent, e.g. "24h,1h".
+	LeadTimes []time.Duration
+	// PollInterval is how often the scheduler checks the DB for new or changed
+	// due dates.
+	PollInterval time.Duration
+}
+
+type Trash struct {
+	// Retention is how long deleted tasks are kept in the trash before they
+	// are removed for good.
+	Retention time.Duration
+	// PurgeInterval is how often the trash is checked for expired tasks.
+	PurgeInterval time.Duration
+}
+
+type Archive struct {
+	// After is how long after being completed tasks are archived, zero turns
+	// automatic archiving off.
+	After time.Duration
+	// PollInterval is how often completed tasks are checked.
+	PollInterval time.Duration
+	// RollUpArchived keeps the cost and logged time of archived tasks in the
+	// totals of their ancestors.
+	RollUpArchived bool
+}
+
+type Undo struct {
+	// Depth is how many operations of every user can be undone.
+	Depth int
+}
+
+type Costs struct {
+	// Currency is the currency of costs that are stored without one.
+	Currency string
+	// Rates are the values of one unit of other currencies in Currency, e.g.
+	// "USD=0.92,GBP=1.17". Costs in currencies without a rate can't be rolled
+	// up into tasks in another currency.
+	Rates map[string]string
+}
+
+type Attachments struct {
+	// Backend is where the files are kept, either "local" or "s3".
+	Backend string
+	// Dir is the directory used by the local backend.
+	Dir string
+	// MaxSize is the largest file in bytes that can be uploaded.
+	MaxSize int64
+	// AllowedTypes are the MIME types that can be uploaded, e.g.
+	// "image/png,application/pdf".
+	AllowedTypes []string
+	S3           S3
+}
+
+// S3 is the config for any S3 compatible storage, e.g. AWS or MinIO.
+type S3 struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+}
+
+type Webhooks struct {
+	// MaxAttempts is how many times an event is posted before its delivery
+	// fails.
+	MaxAttempts int
+	// RetryBackoff is how long to wait before the first retry, it doubles
+	// with every retry after it.
+	RetryBackoff time.Duration
+	// Timeout is how long to wait for the response to a post.
+	Timeout time.Duration
+	// PollInterval is how often the queue is checked for retries that are
+	// due.
+	PollInterval time.Duration
+}
+
+type SMTP struct {
+	// Addr is where emails are received, e.g. ":2525". Nothing is received
+	// when it's empty.
+	Addr string
+	// Domain is the domain of the addresses, mail to other domains is
+	// rejected.
+	Domain string
+	// MaxMessageSize is the largest email in bytes that is accepted.
+	MaxMessageSize int64
+}
+
+type GRPC struct {
+	// Addr is where the gRPC API listens, e.g. ":9090". It's not served when
+	// it's empty.
+	Addr string
+}
+
+func durationOr(env string, fallback time.Duration) time.Duration {
+	value := os.Getenv(env)
+	if value == "" {
+		return fallback
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("invalid duration '%s' in %s, using %s\n", value, env, fallback)
+		return fallback
+	}
+
+	return d
+}
+
+func durationsOr(env string, fallback []time.Duration) []time.Duration {
+	value := os.Getenv(env)
+	if value == "" {
+		return fallback
+	}
+
+	durations := make([]time.Duration, 0)
+	for _, part := range strings.Split(value, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(part))
+		if err != nil {
+			log.Printf("invalid duration list '%s' in %s, using %v\n", value, env, fallback)
+			return fallback
+		}
+
+		durations = append(durations, d)
+	}
+
+	return durations
+}
+
+func int64Or(env string, fallback int64) int64 {
+	value := os.Getenv(env)
+	if value == "" {
+		return fallback
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n <= 0 {
+		log.Printf("invalid number '%s' in %s, using %d\n", value, env, fallback)
+		return fallback
+	}
+
+	return n
+}
+
+func listOr(env string, fallback []string) []string {
+	value := os.Getenv(env)
+	if value == "" {
+		return fallback
+	}
+
+	list := make([]string, 0)
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			list = append(list, part)
+		}
+	}
+
+	return list
+}
+
+func mapOr(env string, fallback map[string]string) map[string]string {
+	value := os.Getenv(env)
+	if value == "" {
+		return fallback
+	}
+
+	m := make(map[string]string)
+	for _, part := range listOr(env, nil) {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			log.Printf("invalid map '%s' in %s, using %v\n", value, env, fallback)
+			return fallback
+		}
+
+		m[strings.TrimSpace(key)] = strings.TrimSpace(val)
+	}
+
+	return m
+}
@@ -1,12 +1,32 @@
 package container
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+
 	"github.com/jmoiron/sqlx"
 	"github.com/samber/do"
+	"github.com/zemzale/ubiquitest/blob"
 	"github.com/zemzale/ubiquitest/config"
+	"github.com/zemzale/ubiquitest/dav"
+	"github.com/zemzale/ubiquitest/domain/attachments"
+	"github.com/zemzale/ubiquitest/domain/calendar"
+	"github.com/zemzale/ubiquitest/domain/comments"
+	"github.com/zemzale/ubiquitest/domain/dependencies"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/inbox"
+	"github.com/zemzale/ubiquitest/domain/labels"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/reports"
 	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/domain/timetracking"
+	"github.com/zemzale/ubiquitest/domain/transfer"
 	"github.com/zemzale/ubiquitest/domain/users"
+	"github.com/zemzale/ubiquitest/domain/webhooks"
 	"github.com/zemzale/ubiquitest/router"
+	"github.com/zemzale/ubiquitest/rpc"
+	"github.com/zemzale/ubiquitest/smtpd"
 	"github.com/zemzale/ubiquitest/storage"
 	"github.com/zemzale/ubiquitest/ws"
 )
@@ -41,6 +61,11 @@ func Load() {
 			return nil, err
 		}
 
+		taskUpdate, err := do.Invoke[*tasks.Update](i)
+		if err != nil {
+			return nil, err
+		}
+
 		taskList, err := do.Invoke[*tasks.List](i)
 		if err != nil {
 			return nil, err
@@ -66,144 +91,2059 @@ func Load() {
 			return nil, err
 		}
 
-		return router.NewRouter(cfg.HTTP.Port, taskStore, taskList, taskCalculate, upsertUser, userFindByID, wss), nil
-	})
+		taskAssign, err := do.Invoke[*tasks.Assign](i)
+		if err != nil {
+			return nil, err
+		}
 
-	do.Provide(nil, func(i *do.Injector) (*tasks.CalculateCost, error) {
-		return tasks.NewCalculateCost(), nil
-	})
+		taskUnassign, err := do.Invoke[*tasks.Unassign](i)
+		if err != nil {
+			return nil, err
+		}
 
-	do.Provide(nil, func(i *do.Injector) (*tasks.Store, error) {
-		db, err := do.Invoke[*sqlx.DB](i)
+		taskDelete, err := do.Invoke[*tasks.Delete](i)
 		if err != nil {
 			return nil, err
 		}
 
-		taskRepo := storage.NewTaskRepository(db)
-		updateParentCost, err := do.Invoke[*tasks.UpdateParentCost](i)
+		taskRestore, err := do.Invoke[*tasks.Restore](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return tasks.NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db)), nil
-	})
+		taskListTrash, err := do.Invoke[*tasks.ListTrash](i)
+		if err != nil {
+			return nil, err
+		}
 
-	do.Provide(nil, func(i *do.Injector) (*tasks.UpdateParentCost, error) {
-		db, err := do.Invoke[*sqlx.DB](i)
+		taskTrashPurger, err := do.Invoke[*tasks.TrashPurger](i)
 		if err != nil {
 			return nil, err
 		}
 
-		findAllParents, err := do.Invoke[*tasks.FindAllParents](i)
+		taskUndoStack, err := do.Invoke[*tasks.UndoStack](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return tasks.NewUpdateParentCost(findAllParents, storage.NewTaskRepository(db)), nil
-	})
+		taskUndo, err := do.Invoke[*tasks.Undo](i)
+		if err != nil {
+			return nil, err
+		}
 
-	do.Provide(nil, func(i *do.Injector) (*tasks.List, error) {
-		db, err := do.Invoke[*sqlx.DB](i)
+		taskRedo, err := do.Invoke[*tasks.Redo](i)
 		if err != nil {
 			return nil, err
 		}
 
-		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		taskArchive, err := do.Invoke[*tasks.Archive](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return tasks.NewList(db, taskRepo), nil
-	})
+		taskUnarchive, err := do.Invoke[*tasks.Unarchive](i)
+		if err != nil {
+			return nil, err
+		}
 
-	do.Provide(nil, func(i *do.Injector) (*storage.TaksRepository, error) {
-		db, err := do.Invoke[*sqlx.DB](i)
+		labelList, err := do.Invoke[*labels.List](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return storage.NewTaskRepository(db), nil
-	})
+		labelCreate, err := do.Invoke[*labels.Create](i)
+		if err != nil {
+			return nil, err
+		}
 
-	do.Provide(nil, func(i *do.Injector) (*users.FindOrCreate, error) {
-		db, err := do.Invoke[*sqlx.DB](i)
+		labelUpdate, err := do.Invoke[*labels.Update](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return users.NewFindOrCreate(db), nil
-	})
+		labelDelete, err := do.Invoke[*labels.Delete](i)
+		if err != nil {
+			return nil, err
+		}
 
-	do.Provide(nil, func(i *do.Injector) (*users.FindByID, error) {
-		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		labelAttach, err := do.Invoke[*labels.Attach](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return users.NewFindById(userRepo), nil
-	})
+		labelDetach, err := do.Invoke[*labels.Detach](i)
+		if err != nil {
+			return nil, err
+		}
 
-	do.Provide(nil, func(i *do.Injector) (*ws.Server, error) {
-		storeTask, err := do.Invoke[*tasks.Store](i)
+		commentList, err := do.Invoke[*comments.List](i)
 		if err != nil {
 			return nil, err
 		}
 
-		updateTask, err := do.Invoke[*tasks.Update](i)
+		commentAdd, err := do.Invoke[*comments.Add](i)
 		if err != nil {
 			return nil, err
 		}
 
-		findUserByUsername, err := do.Invoke[*users.FindByUsername](i)
+		commentEdit, err := do.Invoke[*comments.Edit](i)
 		if err != nil {
 			return nil, err
 		}
 
-		taskCalculateCost, err := do.Invoke[*tasks.CalculateCost](i)
+		commentDelete, err := do.Invoke[*comments.Delete](i)
 		if err != nil {
 			return nil, err
 		}
-		taskFindAllParents, err := do.Invoke[*tasks.FindAllParents](i)
+
+		attachmentUpload, err := do.Invoke[*attachments.Upload](i)
+		if err != nil {
+			return nil, err
+		}
+
+		attachmentDownload, err := do.Invoke[*attachments.Download](i)
+		if err != nil {
+			return nil, err
+		}
+
+		attachmentDelete, err := do.Invoke[*attachments.Delete](i)
+		if err != nil {
+			return nil, err
+		}
+
+		historyListByTask, err := do.Invoke[*history.ListByTask](i)
+		if err != nil {
+			return nil, err
+		}
+
+		historyListActivity, err := do.Invoke[*history.ListActivity](i)
+		if err != nil {
+			return nil, err
+		}
+
+		dependencyAdd, err := do.Invoke[*dependencies.Add](i)
+		if err != nil {
+			return nil, err
+		}
+
+		dependencyRemove, err := do.Invoke[*dependencies.Remove](i)
+		if err != nil {
+			return nil, err
+		}
+
+		dependencyListOrdered, err := do.Invoke[*dependencies.ListOrdered](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskReorder, err := do.Invoke[*tasks.Reorder](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskExport, err := do.Invoke[*tasks.Export](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskImport, err := do.Invoke[*tasks.Import](i)
+		if err != nil {
+			return nil, err
+		}
+
+		transferDecoder, err := do.Invoke[*transfer.Decoder](i)
+		if err != nil {
+			return nil, err
+		}
+
+		transferImport, err := do.Invoke[*transfer.Import](i)
+		if err != nil {
+			return nil, err
+		}
+
+		timeEntryList, err := do.Invoke[*timetracking.List](i)
+		if err != nil {
+			return nil, err
+		}
+
+		timeEntryAdd, err := do.Invoke[*timetracking.Add](i)
+		if err != nil {
+			return nil, err
+		}
+
+		timeEntryDelete, err := do.Invoke[*timetracking.Delete](i)
+		if err != nil {
+			return nil, err
+		}
+
+		reportCost, err := do.Invoke[*reports.Cost](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return ws.NewServer(storeTask, updateTask, taskCalculateCost, taskFindAllParents, findUserByUsername), nil
+		reportCompletion, err := do.Invoke[*reports.Completion](i)
+		if err != nil {
+			return nil, err
+		}
+
+		calendarGetToken, err := do.Invoke[*calendar.GetToken](i)
+		if err != nil {
+			return nil, err
+		}
+
+		calendarRotateToken, err := do.Invoke[*calendar.RotateToken](i)
+		if err != nil {
+			return nil, err
+		}
+
+		calendarFeed, err := do.Invoke[*calendar.Feed](i)
+		if err != nil {
+			return nil, err
+		}
+
+		davHandler, err := do.Invoke[*dav.Handler](i)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookList, err := do.Invoke[*webhooks.List](i)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookCreate, err := do.Invoke[*webhooks.Create](i)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookDelete, err := do.Invoke[*webhooks.Delete](i)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookListDeliveries, err := do.Invoke[*webhooks.ListDeliveries](i)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookRedeliver, err := do.Invoke[*webhooks.Redeliver](i)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookDeliverer, err := do.Invoke[*webhooks.Deliverer](i)
+		if err != nil {
+			return nil, err
+		}
+
+		inboxGetAddress, err := do.Invoke[*inbox.GetAddress](i)
+		if err != nil {
+			return nil, err
+		}
+
+		inboxRotateAddress, err := do.Invoke[*inbox.RotateAddress](i)
+		if err != nil {
+			return nil, err
+		}
+
+		smtpServer, err := do.Invoke[*smtpd.Server](i)
+		if err != nil {
+			return nil, err
+		}
+
+		grpcServer, err := do.Invoke[*rpc.Server](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return router.NewRouter(
+			cfg.HTTP.Port,
+			taskStore,
+			taskUpdate,
+			taskList,
+			taskCalculate,
+			taskAssign,
+			taskUnassign,
+			taskDelete,
+			taskRestore,
+			taskListTrash,
+			taskTrashPurger,
+			taskUndoStack,
+			taskUndo,
+			taskRedo,
+			taskArchive,
+			taskUnarchive,
+			taskReorder,
+			taskExport,
+			taskImport,
+			transferDecoder,
+			transferImport,
+			upsertUser,
+			userFindByID,
+			labelList,
+			labelCreate,
+			labelUpdate,
+			labelDelete,
+			labelAttach,
+			labelDetach,
+			commentList,
+			commentAdd,
+			commentEdit,
+			commentDelete,
+			attachmentUpload,
+			attachmentDownload,
+			attachmentDelete,
+			historyListByTask,
+			historyListActivity,
+			dependencyAdd,
+			dependencyRemove,
+			dependencyListOrdered,
+			timeEntryList,
+			timeEntryAdd,
+			timeEntryDelete,
+			reportCost,
+			reportCompletion,
+			calendarGetToken,
+			calendarRotateToken,
+			calendarFeed,
+			davHandler,
+			webhookList,
+			webhookCreate,
+			webhookDelete,
+			webhookListDeliveries,
+			webhookRedeliver,
+			webhookDeliverer,
+			inboxGetAddress,
+			inboxRotateAddress,
+			smtpServer,
+			grpcServer,
+			wss,
+		), nil
 	})
 
-	do.Provide(nil, func(i *do.Injector) (*tasks.FindAllParents, error) {
-		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+	do.Provide(nil, func(i *do.Injector) (*money.Rates, error) {
+		cfg, err := do.Invoke[*config.Config](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return tasks.NewFindAllParents(taskRepo), nil
+		return money.NewRates(cfg.Costs.Currency, cfg.Costs.Rates)
 	})
 
-	do.Provide(nil, func(i *do.Injector) (*users.FindByUsername, error) {
-		userRepo, err := do.Invoke[*storage.UserRepository](i)
+	do.Provide(nil, func(i *do.Injector) (*tasks.CalculateCost, error) {
+		rates, err := do.Invoke[*money.Rates](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return users.NewFindByUsername(userRepo), nil
+		return tasks.NewCalculateCost(rates), nil
 	})
 
-	do.Provide(nil, func(i *do.Injector) (*tasks.Update, error) {
+	do.Provide(nil, func(i *do.Injector) (*tasks.Store, error) {
 		db, err := do.Invoke[*sqlx.DB](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return tasks.NewUpdate(db), nil
+		taskRepo := storage.NewTaskRepository(db)
+		updateParentCost, err := do.Invoke[*tasks.UpdateParentCost](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rates, err := do.Invoke[*money.Rates](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewStore(
+			updateParentCost,
+			taskRepo,
+			storage.NewUserRepository(db),
+			storage.NewAssigneeRepository(db),
+			recordHistory,
+			rates,
+		), nil
 	})
 
-	do.Provide(nil, func(i *do.Injector) (*storage.UserRepository, error) {
+	do.Provide(nil, func(i *do.Injector) (*tasks.UpdateParentCost, error) {
 		db, err := do.Invoke[*sqlx.DB](i)
 		if err != nil {
 			return nil, err
 		}
 
-		return storage.NewUserRepository(db), nil
+		findAllParents, err := do.Invoke[*tasks.FindAllParents](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rates, err := do.Invoke[*money.Rates](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewUpdateParentCost(findAllParents, storage.NewTaskRepository(db), rates), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.List, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		assigneeRepo, err := do.Invoke[*storage.AssigneeRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		labelRepo, err := do.Invoke[*storage.LabelRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		attachmentRepo, err := do.Invoke[*storage.AttachmentRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		dependencyRepo, err := do.Invoke[*storage.DependencyRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rollUp, err := do.Invoke[*tasks.RollUp](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewList(db, taskRepo, assigneeRepo, labelRepo, attachmentRepo, dependencyRepo, rollUp), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.RollUp, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rates, err := do.Invoke[*money.Rates](i)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewRollUp(taskRepo, tasks.DefaultMetrics(rates), cfg.Archive.RollUpArchived), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.TaksRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewTaskRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*users.FindOrCreate, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return users.NewFindOrCreate(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*users.FindByID, error) {
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return users.NewFindById(userRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*ws.Server, error) {
+		storeTask, err := do.Invoke[*tasks.Store](i)
+		if err != nil {
+			return nil, err
+		}
+
+		updateTask, err := do.Invoke[*tasks.Update](i)
+		if err != nil {
+			return nil, err
+		}
+
+		findUserByUsername, err := do.Invoke[*users.FindByUsername](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskCalculateCost, err := do.Invoke[*tasks.CalculateCost](i)
+		if err != nil {
+			return nil, err
+		}
+		taskFindAllParents, err := do.Invoke[*tasks.FindAllParents](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskDueScheduler, err := do.Invoke[*tasks.DueScheduler](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskAssign, err := do.Invoke[*tasks.Assign](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskUnassign, err := do.Invoke[*tasks.Unassign](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskUndoStack, err := do.Invoke[*tasks.UndoStack](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskUndo, err := do.Invoke[*tasks.Undo](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRedo, err := do.Invoke[*tasks.Redo](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskArchiver, err := do.Invoke[*tasks.Archiver](i)
+		if err != nil {
+			return nil, err
+		}
+
+		dependencyAdd, err := do.Invoke[*dependencies.Add](i)
+		if err != nil {
+			return nil, err
+		}
+
+		dependencyRemove, err := do.Invoke[*dependencies.Remove](i)
+		if err != nil {
+			return nil, err
+		}
+
+		dependentsList, err := do.Invoke[*dependencies.ListDependents](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskReorder, err := do.Invoke[*tasks.Reorder](i)
+		if err != nil {
+			return nil, err
+		}
+
+		updateParentCost, err := do.Invoke[*tasks.UpdateParentCost](i)
+		if err != nil {
+			return nil, err
+		}
+
+		timerStart, err := do.Invoke[*timetracking.Start](i)
+		if err != nil {
+			return nil, err
+		}
+
+		timerStop, err := do.Invoke[*timetracking.Stop](i)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookPublish, err := do.Invoke[*webhooks.Publish](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return ws.NewServer(
+			storeTask,
+			updateTask,
+			taskCalculateCost,
+			taskFindAllParents,
+			taskDueScheduler,
+			taskAssign,
+			taskUnassign,
+			taskUndoStack,
+			taskUndo,
+			taskRedo,
+			taskArchiver,
+			taskReorder,
+			updateParentCost,
+			dependencyAdd,
+			dependencyRemove,
+			dependentsList,
+			timerStart,
+			timerStop,
+			findUserByUsername,
+			webhookPublish,
+		), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.DueScheduler, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		assigneeRepo, err := do.Invoke[*storage.AssigneeRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewDueScheduler(
+			taskRepo,
+			storage.NewReminderRepository(db),
+			assigneeRepo,
+			cfg.Reminders.LeadTimes,
+			cfg.Reminders.PollInterval,
+		), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.FindAllParents, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewFindAllParents(taskRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*users.FindByUsername, error) {
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return users.NewFindByUsername(userRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Update, error) {
+		updateParentCost, err := do.Invoke[*tasks.UpdateParentCost](i)
+		if err != nil {
+			return nil, err
+		}
+
+		createNextOccurrence, err := do.Invoke[*tasks.CreateNextOccurrence](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		dependencyRepo, err := do.Invoke[*storage.DependencyRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rates, err := do.Invoke[*money.Rates](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewUpdate(updateParentCost, taskRepo, dependencyRepo, createNextOccurrence, recordHistory, rates), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.CreateNextOccurrence, error) {
+		taskStore, err := do.Invoke[*tasks.Store](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		assigneeRepo, err := do.Invoke[*storage.AssigneeRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewCreateNextOccurrence(taskStore, taskRepo, assigneeRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Assign, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		assigneeRepo, err := do.Invoke[*storage.AssigneeRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewAssign(taskRepo, assigneeRepo, userRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Unassign, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		assigneeRepo, err := do.Invoke[*storage.AssigneeRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewUnassign(taskRepo, assigneeRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Delete, error) {
+		updateParentCost, err := do.Invoke[*tasks.UpdateParentCost](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewDelete(updateParentCost, taskRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Restore, error) {
+		updateParentCost, err := do.Invoke[*tasks.UpdateParentCost](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		assigneeRepo, err := do.Invoke[*storage.AssigneeRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewRestore(updateParentCost, taskRepo, assigneeRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.ListTrash, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewListTrash(taskRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.PurgeTrash, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		blobStore, err := do.Invoke[blob.Store](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewPurgeTrash(taskRepo, blobStore), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.TrashPurger, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		purgeTrash, err := do.Invoke[*tasks.PurgeTrash](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewTrashPurger(purgeTrash, cfg.Trash.Retention, cfg.Trash.PurgeInterval), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.UndoStack, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewUndoStack(cfg.Undo.Depth), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Undo, error) {
+		undoStack, err := do.Invoke[*tasks.UndoStack](i)
+		if err != nil {
+			return nil, err
+		}
+
+		update, err := do.Invoke[*tasks.Update](i)
+		if err != nil {
+			return nil, err
+		}
+
+		deleteTask, err := do.Invoke[*tasks.Delete](i)
+		if err != nil {
+			return nil, err
+		}
+
+		restore, err := do.Invoke[*tasks.Restore](i)
+		if err != nil {
+			return nil, err
+		}
+
+		reorder, err := do.Invoke[*tasks.Reorder](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewUndo(undoStack, update, deleteTask, restore, reorder), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Redo, error) {
+		undoStack, err := do.Invoke[*tasks.UndoStack](i)
+		if err != nil {
+			return nil, err
+		}
+
+		update, err := do.Invoke[*tasks.Update](i)
+		if err != nil {
+			return nil, err
+		}
+
+		deleteTask, err := do.Invoke[*tasks.Delete](i)
+		if err != nil {
+			return nil, err
+		}
+
+		restore, err := do.Invoke[*tasks.Restore](i)
+		if err != nil {
+			return nil, err
+		}
+
+		reorder, err := do.Invoke[*tasks.Reorder](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewRedo(undoStack, update, deleteTask, restore, reorder), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Archive, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		updateParentCost, err := do.Invoke[*tasks.UpdateParentCost](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewArchive(updateParentCost, taskRepo, recordHistory, cfg.Archive.RollUpArchived), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Unarchive, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		updateParentCost, err := do.Invoke[*tasks.UpdateParentCost](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		assigneeRepo, err := do.Invoke[*storage.AssigneeRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewUnarchive(updateParentCost, taskRepo, assigneeRepo, recordHistory, cfg.Archive.RollUpArchived), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Archiver, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		archive, err := do.Invoke[*tasks.Archive](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewArchiver(
+			tasks.NewArchiveCompleted(archive, taskRepo),
+			cfg.Archive.After,
+			cfg.Archive.PollInterval,
+		), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.AssigneeRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewAssigneeRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.UserRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewUserRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.LabelRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewLabelRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*labels.List, error) {
+		labelRepo, err := do.Invoke[*storage.LabelRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return labels.NewList(labelRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*labels.Create, error) {
+		labelRepo, err := do.Invoke[*storage.LabelRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return labels.NewCreate(labelRepo, taskRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*labels.Update, error) {
+		labelRepo, err := do.Invoke[*storage.LabelRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return labels.NewUpdate(labelRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*labels.Delete, error) {
+		labelRepo, err := do.Invoke[*storage.LabelRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return labels.NewDelete(labelRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*labels.Attach, error) {
+		labelRepo, err := do.Invoke[*storage.LabelRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return labels.NewAttach(labelRepo, taskRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*labels.Detach, error) {
+		labelRepo, err := do.Invoke[*storage.LabelRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return labels.NewDetach(labelRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.TimeEntryRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewTimeEntryRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*timetracking.Start, error) {
+		entryRepo, err := do.Invoke[*storage.TimeEntryRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return timetracking.NewStart(entryRepo, taskRepo, userRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*timetracking.Stop, error) {
+		entryRepo, err := do.Invoke[*storage.TimeEntryRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return timetracking.NewStop(entryRepo, taskRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*timetracking.Add, error) {
+		entryRepo, err := do.Invoke[*storage.TimeEntryRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return timetracking.NewAdd(entryRepo, taskRepo, userRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*timetracking.Delete, error) {
+		entryRepo, err := do.Invoke[*storage.TimeEntryRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return timetracking.NewDelete(entryRepo, taskRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*timetracking.List, error) {
+		entryRepo, err := do.Invoke[*storage.TimeEntryRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return timetracking.NewList(entryRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*reports.Cost, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rates, err := do.Invoke[*money.Rates](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return reports.NewCost(taskRepo, userRepo, rates), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*reports.Completion, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		historyRepo, err := do.Invoke[*storage.HistoryRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rates, err := do.Invoke[*money.Rates](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return reports.NewCompletion(taskRepo, userRepo, historyRepo, rates), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.CalendarTokenRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewCalendarTokenRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.InboxTokenRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewInboxTokenRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.WebhookRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewWebhookRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*webhooks.List, error) {
+		webhookRepo, err := do.Invoke[*storage.WebhookRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return webhooks.NewList(webhookRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*webhooks.Create, error) {
+		webhookRepo, err := do.Invoke[*storage.WebhookRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return webhooks.NewCreate(webhookRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*webhooks.Delete, error) {
+		webhookRepo, err := do.Invoke[*storage.WebhookRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return webhooks.NewDelete(webhookRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*webhooks.ListDeliveries, error) {
+		webhookRepo, err := do.Invoke[*storage.WebhookRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return webhooks.NewListDeliveries(webhookRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*webhooks.Redeliver, error) {
+		webhookRepo, err := do.Invoke[*storage.WebhookRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		deliverer, err := do.Invoke[*webhooks.Deliverer](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return webhooks.NewRedeliver(webhookRepo, deliverer), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*webhooks.Publish, error) {
+		webhookRepo, err := do.Invoke[*storage.WebhookRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		deliverer, err := do.Invoke[*webhooks.Deliverer](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return webhooks.NewPublish(webhookRepo, deliverer), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*webhooks.Deliverer, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookRepo, err := do.Invoke[*storage.WebhookRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return webhooks.NewDeliverer(
+			webhookRepo,
+			&http.Client{Timeout: cfg.Webhooks.Timeout},
+			cfg.Webhooks.MaxAttempts,
+			cfg.Webhooks.RetryBackoff,
+			cfg.Webhooks.PollInterval,
+		), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*inbox.Resolve, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenRepo, err := do.Invoke[*storage.InboxTokenRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return inbox.NewResolve(userRepo, taskRepo, tokenRepo, cfg.SMTP.Domain), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*inbox.GetAddress, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenRepo, err := do.Invoke[*storage.InboxTokenRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return inbox.NewGetAddress(tokenRepo, userRepo, cfg.SMTP.Domain), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*inbox.RotateAddress, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenRepo, err := do.Invoke[*storage.InboxTokenRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return inbox.NewRotateAddress(tokenRepo, userRepo, cfg.SMTP.Domain), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*inbox.Create, error) {
+		store, err := do.Invoke[*tasks.Store](i)
+		if err != nil {
+			return nil, err
+		}
+
+		upload, err := do.Invoke[*attachments.Upload](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rates, err := do.Invoke[*money.Rates](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return inbox.NewCreate(store, upload, rates), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*smtpd.Server, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		resolve, err := do.Invoke[*inbox.Resolve](i)
+		if err != nil {
+			return nil, err
+		}
+
+		create, err := do.Invoke[*inbox.Create](i)
+		if err != nil {
+			return nil, err
+		}
+
+		undoStack, err := do.Invoke[*tasks.UndoStack](i)
+		if err != nil {
+			return nil, err
+		}
+
+		wss, err := do.Invoke[*ws.Server](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return smtpd.NewServer(
+			cfg.SMTP.Addr,
+			cfg.SMTP.Domain,
+			cfg.SMTP.MaxMessageSize,
+			resolve,
+			create,
+			undoStack,
+			wss,
+		), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Find, error) {
+		list, err := do.Invoke[*tasks.List](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewFind(list), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*rpc.Server, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		store, err := do.Invoke[*tasks.Store](i)
+		if err != nil {
+			return nil, err
+		}
+
+		find, err := do.Invoke[*tasks.Find](i)
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := do.Invoke[*tasks.List](i)
+		if err != nil {
+			return nil, err
+		}
+
+		update, err := do.Invoke[*tasks.Update](i)
+		if err != nil {
+			return nil, err
+		}
+
+		deleteTask, err := do.Invoke[*tasks.Delete](i)
+		if err != nil {
+			return nil, err
+		}
+
+		undoStack, err := do.Invoke[*tasks.UndoStack](i)
+		if err != nil {
+			return nil, err
+		}
+
+		findByID, err := do.Invoke[*users.FindByID](i)
+		if err != nil {
+			return nil, err
+		}
+
+		findByUsername, err := do.Invoke[*users.FindByUsername](i)
+		if err != nil {
+			return nil, err
+		}
+
+		wss, err := do.Invoke[*ws.Server](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return rpc.NewServer(
+			cfg.GRPC.Addr,
+			store,
+			find,
+			list,
+			update,
+			deleteTask,
+			undoStack,
+			findByID,
+			findByUsername,
+			wss,
+		), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*calendar.GetToken, error) {
+		tokenRepo, err := do.Invoke[*storage.CalendarTokenRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return calendar.NewGetToken(tokenRepo, userRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*calendar.RotateToken, error) {
+		tokenRepo, err := do.Invoke[*storage.CalendarTokenRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return calendar.NewRotateToken(tokenRepo, userRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*calendar.Feed, error) {
+		tokenRepo, err := do.Invoke[*storage.CalendarTokenRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		assigneeRepo, err := do.Invoke[*storage.AssigneeRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return calendar.NewFeed(tokenRepo, userRepo, taskRepo, assigneeRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*calendar.Authenticate, error) {
+		tokenRepo, err := do.Invoke[*storage.CalendarTokenRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return calendar.NewAuthenticate(tokenRepo, userRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*calendar.ListBoards, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return calendar.NewListBoards(taskRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*calendar.ListTodos, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return calendar.NewListTodos(taskRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*calendar.FindTodo, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return calendar.NewFindTodo(taskRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*calendar.PutTodo, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		store, err := do.Invoke[*tasks.Store](i)
+		if err != nil {
+			return nil, err
+		}
+
+		update, err := do.Invoke[*tasks.Update](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rates, err := do.Invoke[*money.Rates](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return calendar.NewPutTodo(taskRepo, store, update, rates), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*calendar.DeleteTodo, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		deleteTask, err := do.Invoke[*tasks.Delete](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return calendar.NewDeleteTodo(taskRepo, deleteTask), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*dav.Handler, error) {
+		authenticate, err := do.Invoke[*calendar.Authenticate](i)
+		if err != nil {
+			return nil, err
+		}
+
+		listBoards, err := do.Invoke[*calendar.ListBoards](i)
+		if err != nil {
+			return nil, err
+		}
+
+		listTodos, err := do.Invoke[*calendar.ListTodos](i)
+		if err != nil {
+			return nil, err
+		}
+
+		findTodo, err := do.Invoke[*calendar.FindTodo](i)
+		if err != nil {
+			return nil, err
+		}
+
+		putTodo, err := do.Invoke[*calendar.PutTodo](i)
+		if err != nil {
+			return nil, err
+		}
+
+		deleteTodo, err := do.Invoke[*calendar.DeleteTodo](i)
+		if err != nil {
+			return nil, err
+		}
+
+		undoStack, err := do.Invoke[*tasks.UndoStack](i)
+		if err != nil {
+			return nil, err
+		}
+
+		wss, err := do.Invoke[*ws.Server](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return dav.NewHandler(authenticate, listBoards, listTodos, findTodo, putTodo, deleteTodo, undoStack, wss), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.CommentRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewCommentRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*comments.List, error) {
+		commentRepo, err := do.Invoke[*storage.CommentRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return comments.NewList(commentRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*comments.Add, error) {
+		commentRepo, err := do.Invoke[*storage.CommentRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return comments.NewAdd(commentRepo, taskRepo, userRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*comments.Edit, error) {
+		commentRepo, err := do.Invoke[*storage.CommentRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return comments.NewEdit(commentRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*comments.Delete, error) {
+		commentRepo, err := do.Invoke[*storage.CommentRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return comments.NewDelete(commentRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (blob.Store, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		switch cfg.Attachments.Backend {
+		case "local":
+			return blob.NewLocal(cfg.Attachments.Dir)
+		case "s3":
+			return blob.NewS3(context.Background(), blob.S3Options{
+				Endpoint:  cfg.Attachments.S3.Endpoint,
+				Region:    cfg.Attachments.S3.Region,
+				Bucket:    cfg.Attachments.S3.Bucket,
+				AccessKey: cfg.Attachments.S3.AccessKey,
+				SecretKey: cfg.Attachments.S3.SecretKey,
+				UseSSL:    cfg.Attachments.S3.UseSSL,
+			})
+		default:
+			return nil, fmt.Errorf("unknown attachments backend %q", cfg.Attachments.Backend)
+		}
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.AttachmentRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewAttachmentRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*attachments.Upload, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		attachmentRepo, err := do.Invoke[*storage.AttachmentRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		blobStore, err := do.Invoke[blob.Store](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		limits := attachments.Limits{
+			MaxSize:      cfg.Attachments.MaxSize,
+			AllowedTypes: cfg.Attachments.AllowedTypes,
+		}
+
+		return attachments.NewUpload(attachmentRepo, taskRepo, blobStore, limits, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*attachments.Download, error) {
+		attachmentRepo, err := do.Invoke[*storage.AttachmentRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		blobStore, err := do.Invoke[blob.Store](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return attachments.NewDownload(attachmentRepo, blobStore), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*attachments.Delete, error) {
+		attachmentRepo, err := do.Invoke[*storage.AttachmentRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		blobStore, err := do.Invoke[blob.Store](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return attachments.NewDelete(attachmentRepo, blobStore, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.HistoryRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewHistoryRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*history.Record, error) {
+		historyRepo, err := do.Invoke[*storage.HistoryRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return history.NewRecord(historyRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*history.ListByTask, error) {
+		historyRepo, err := do.Invoke[*storage.HistoryRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return history.NewListByTask(historyRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*history.ListActivity, error) {
+		historyRepo, err := do.Invoke[*storage.HistoryRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return history.NewListActivity(historyRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Reorder, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewReorder(taskRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Export, error) {
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewExport(taskRepo, userRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*tasks.Import, error) {
+		cfg, err := do.Invoke[*config.Config](i)
+		if err != nil {
+			return nil, err
+		}
+
+		updateParentCost, err := do.Invoke[*tasks.UpdateParentCost](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := do.Invoke[*storage.UserRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		rates, err := do.Invoke[*money.Rates](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return tasks.NewImport(updateParentCost, taskRepo, userRepo, recordHistory, rates, cfg.Archive.RollUpArchived), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*transfer.Decoder, error) {
+		rates, err := do.Invoke[*money.Rates](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return transfer.NewDecoder(rates), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*transfer.Import, error) {
+		taskImport, err := do.Invoke[*tasks.Import](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskStore, err := do.Invoke[*tasks.Store](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return transfer.NewImport(taskImport, taskStore), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*storage.DependencyRepository, error) {
+		db, err := do.Invoke[*sqlx.DB](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return storage.NewDependencyRepository(db), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*dependencies.Add, error) {
+		dependencyRepo, err := do.Invoke[*storage.DependencyRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, err := do.Invoke[*storage.TaksRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return dependencies.NewAdd(dependencyRepo, taskRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*dependencies.Remove, error) {
+		dependencyRepo, err := do.Invoke[*storage.DependencyRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		recordHistory, err := do.Invoke[*history.Record](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return dependencies.NewRemove(dependencyRepo, recordHistory), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*dependencies.ListDependents, error) {
+		dependencyRepo, err := do.Invoke[*storage.DependencyRepository](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return dependencies.NewListDependents(dependencyRepo), nil
+	})
+
+	do.Provide(nil, func(i *do.Injector) (*dependencies.ListOrdered, error) {
+		taskList, err := do.Invoke[*tasks.List](i)
+		if err != nil {
+			return nil, err
+		}
+
+		return dependencies.NewListOrdered(taskList), nil
 	})
 }
@@ -0,0 +1,307 @@
+package dav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/emersion/go-ical"
+	"github.com/emersion/go-webdav"
+	"github.com/emersion/go-webdav/caldav"
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/calendar"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/ws"
+)
+
+// backend serves every board as a calendar of to-dos, under the home set of
+// the user:
+//
+//	/caldav/{user_id}/                             the user
+//	/caldav/{user_id}/boards/                      their calendars
+//	/caldav/{user_id}/boards/{board_id}/           a board
+//	/caldav/{user_id}/boards/{board_id}/{id}.ics   a task in the board
+type backend struct {
+	listBoards      *calendar.ListBoards
+	listTodos       *calendar.ListTodos
+	findTodo        *calendar.FindTodo
+	putTodo         *calendar.PutTodo
+	deleteTodo      *calendar.DeleteTodo
+	undoStack       *tasks.UndoStack
+	websocketServer *ws.Server
+}
+
+var _ caldav.Backend = (*backend)(nil)
+
+func (b *backend) CurrentUserPrincipal(ctx context.Context) (string, error) {
+	user, err := userFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return Prefix + "/" + strconv.FormatUint(uint64(user.ID), 10) + "/", nil
+}
+
+func (b *backend) CalendarHomeSetPath(ctx context.Context) (string, error) {
+	principal, err := b.CurrentUserPrincipal(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return principal + "boards/", nil
+}
+
+func (b *backend) CreateCalendar(ctx context.Context, cal *caldav.Calendar) error {
+	return webdav.NewHTTPError(http.StatusForbidden, errors.New("boards can't be created over caldav"))
+}
+
+func (b *backend) ListCalendars(ctx context.Context) ([]caldav.Calendar, error) {
+	home, err := b.CalendarHomeSetPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	boards, err := b.listBoards.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	calendars := make([]caldav.Calendar, 0, len(boards))
+	for _, board := range boards {
+		calendars = append(calendars, mapCalendar(home, board))
+	}
+
+	return calendars, nil
+}
+
+func (b *backend) GetCalendar(ctx context.Context, p string) (*caldav.Calendar, error) {
+	home, boardID, _, err := b.parsePath(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	board, _, err := b.listTodos.Run(boardID)
+	if err != nil {
+		return nil, httpError(err)
+	}
+
+	cal := mapCalendar(home, board)
+
+	return &cal, nil
+}
+
+func (b *backend) GetCalendarObject(
+	ctx context.Context, p string, req *caldav.CalendarCompRequest,
+) (*caldav.CalendarObject, error) {
+	home, boardID, id, err := b.parsePath(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == uuid.Nil {
+		return nil, webdav.NewHTTPError(http.StatusNotFound, errors.New("not a to-do"))
+	}
+
+	todo, err := b.findTodo.Run(boardID, id)
+	if err != nil {
+		return nil, httpError(err)
+	}
+
+	object := mapCalendarObject(home, todo)
+
+	return &object, nil
+}
+
+func (b *backend) ListCalendarObjects(
+	ctx context.Context, p string, req *caldav.CalendarCompRequest,
+) ([]caldav.CalendarObject, error) {
+	home, boardID, _, err := b.parsePath(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	_, todos, err := b.listTodos.Run(boardID)
+	if err != nil {
+		return nil, httpError(err)
+	}
+
+	objects := make([]caldav.CalendarObject, 0, len(todos))
+	for _, todo := range todos {
+		objects = append(objects, mapCalendarObject(home, todo))
+	}
+
+	return objects, nil
+}
+
+func (b *backend) QueryCalendarObjects(
+	ctx context.Context, p string, query *caldav.CalendarQuery,
+) ([]caldav.CalendarObject, error) {
+	objects, err := b.ListCalendarObjects(ctx, p, &query.CompRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return caldav.Filter(query, objects)
+}
+
+func (b *backend) PutCalendarObject(
+	ctx context.Context, p string, cal *ical.Calendar, opts *caldav.PutCalendarObjectOptions,
+) (*caldav.CalendarObject, error) {
+	user, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	home, boardID, id, err := b.parsePath(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == uuid.Nil {
+		return nil, webdav.NewHTTPError(http.StatusMethodNotAllowed, errors.New("only to-dos can be put"))
+	}
+
+	change, err := todoFromCalendar(cal)
+	if err != nil {
+		return nil, err
+	}
+	change.ID, change.BoardID = id, boardID
+
+	precondition := calendar.Precondition{Absent: opts.IfNoneMatch.IsWildcard()}
+	if opts.IfMatch.IsSet() && !opts.IfMatch.IsWildcard() {
+		if precondition.Version, err = opts.IfMatch.ETag(); err != nil {
+			return nil, webdav.NewHTTPError(http.StatusBadRequest, err)
+		}
+	}
+
+	saved, err := b.putTodo.Run(change, precondition, user.ID)
+	b.broadcastSaved(saved, user.ID)
+	if err != nil {
+		return nil, httpError(err)
+	}
+
+	object := mapCalendarObject(home, saved.Todo)
+
+	return &object, nil
+}
+
+func (b *backend) DeleteCalendarObject(ctx context.Context, p string) error {
+	user, err := userFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, boardID, id, err := b.parsePath(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	if id == uuid.Nil {
+		return webdav.NewHTTPError(http.StatusForbidden, errors.New("boards can't be deleted over caldav"))
+	}
+
+	var precondition calendar.Precondition
+	if ifMatch := webdav.ConditionalMatch(ctx.Value(ifMatchKey).(string)); ifMatch.IsSet() && !ifMatch.IsWildcard() {
+		if precondition.Version, err = ifMatch.ETag(); err != nil {
+			return webdav.NewHTTPError(http.StatusBadRequest, err)
+		}
+	}
+
+	task, deletedIDs, err := b.deleteTodo.Run(boardID, id, precondition, user.ID)
+	if err != nil {
+		return httpError(err)
+	}
+
+	b.undoStack.Push(user.ID, tasks.Operation{Kind: tasks.OperationDelete, Before: task})
+	b.websocketServer.BroadcastTaskDeleted(task, deletedIDs)
+
+	return nil
+}
+
+// broadcastSaved sends what putting a to-do did to everyone, as if it was done
+// over the websocket, and lets the user undo it.
+func (b *backend) broadcastSaved(saved calendar.Saved, userID uint) {
+	created := saved.Created.ID != uuid.Nil
+	updated := saved.Updated.Kind != ""
+	if created {
+		b.undoStack.Push(userID, tasks.Operation{Kind: tasks.OperationCreate, After: saved.Created})
+	}
+
+	if updated {
+		b.undoStack.Push(userID, saved.Updated)
+	}
+
+	// The events are sent in order, a created to-do is completed after it's
+	// created.
+	go func() {
+		if created {
+			b.websocketServer.BroadcastTaskCreated(saved.Created)
+		}
+
+		if updated {
+			b.websocketServer.BroadcastTaskUpdated(saved.Updated)
+		}
+	}()
+}
+
+// parsePath returns the home set of the user with the board and the to-do of
+// a path, the to-do is uuid.Nil for the path of a board. To-dos are named by
+// the ID of their task, apps that name new ones otherwise get the same ID for
+// the same name.
+func (b *backend) parsePath(ctx context.Context, p string) (string, uuid.UUID, uuid.UUID, error) {
+	home, err := b.CalendarHomeSetPath(ctx)
+	if err != nil {
+		return "", uuid.Nil, uuid.Nil, err
+	}
+
+	notFound := webdav.NewHTTPError(http.StatusNotFound, fmt.Errorf("nothing at %s", p))
+	rest, ok := strings.CutPrefix(path.Clean(p)+"/", home)
+	if !ok {
+		return "", uuid.Nil, uuid.Nil, notFound
+	}
+
+	parts := strings.Split(strings.TrimSuffix(rest, "/"), "/")
+	if len(parts) > 2 {
+		return "", uuid.Nil, uuid.Nil, notFound
+	}
+
+	boardID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return "", uuid.Nil, uuid.Nil, notFound
+	}
+
+	if len(parts) == 1 {
+		return home, boardID, uuid.Nil, nil
+	}
+
+	name := strings.TrimSuffix(parts[1], ".ics")
+	id, err := uuid.Parse(name)
+	if err != nil {
+		id = uuid.NewSHA1(boardID, []byte(name))
+	}
+
+	return home, boardID, id, nil
+}
+
+func httpError(err error) error {
+	switch {
+	case errors.Is(err, calendar.ErrNotFound):
+		return webdav.NewHTTPError(http.StatusNotFound, err)
+	case errors.Is(err, calendar.ErrVersionMismatch):
+		return webdav.NewHTTPError(http.StatusPreconditionFailed, err)
+	case errors.Is(err, calendar.ErrIDConflict):
+		return caldav.NewPreconditionError(caldav.PreconditionNoUIDConflict)
+	case errors.Is(err, calendar.ErrInvalidTodo), errors.Is(err, tasks.ErrNegativeBudget),
+		errors.Is(err, money.ErrUnknownCurrency), errors.Is(err, money.ErrCurrencyMismatch):
+		return webdav.NewHTTPError(http.StatusBadRequest, err)
+	case errors.Is(err, tasks.ErrBlocked), errors.Is(err, tasks.ErrTrashed), errors.Is(err, tasks.ErrArchived):
+		return webdav.NewHTTPError(http.StatusConflict, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,100 @@
+// Package dav serves the boards over CalDAV, so native task apps can sync
+// them both ways.
+package dav
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/emersion/go-webdav/caldav"
+	"github.com/zemzale/ubiquitest/domain/calendar"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/domain/users"
+	"github.com/zemzale/ubiquitest/ws"
+)
+
+// Prefix is the path everything is served under. Apps that only get the
+// server discover it through /.well-known/caldav.
+const Prefix = "/caldav"
+
+type contextKey int
+
+const (
+	userKey contextKey = iota
+	ifMatchKey
+)
+
+// Handler authenticates the requests with HTTP basic auth, the username and
+// the calendar token of the user as the password, and serves CalDAV.
+type Handler struct {
+	authenticate *calendar.Authenticate
+	caldav       *caldav.Handler
+}
+
+func NewHandler(
+	authenticate *calendar.Authenticate,
+	listBoards *calendar.ListBoards,
+	listTodos *calendar.ListTodos,
+	findTodo *calendar.FindTodo,
+	putTodo *calendar.PutTodo,
+	deleteTodo *calendar.DeleteTodo,
+	undoStack *tasks.UndoStack,
+	websocketServer *ws.Server,
+) *Handler {
+	return &Handler{
+		authenticate: authenticate,
+		caldav: &caldav.Handler{
+			Prefix: Prefix,
+			Backend: &backend{
+				listBoards:      listBoards,
+				listTodos:       listTodos,
+				findTodo:        findTodo,
+				putTodo:         putTodo,
+				deleteTodo:      deleteTodo,
+				undoStack:       undoStack,
+				websocketServer: websocketServer,
+			},
+		},
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		unauthorized(w)
+		return
+	}
+
+	user, err := h.authenticate.Run(username, password)
+	if errors.Is(err, calendar.ErrUnknownToken) {
+		unauthorized(w)
+		return
+	}
+	if err != nil {
+		log.Println("failed to authenticate caldav user ", err)
+		http.Error(w, "failed to authenticate", http.StatusInternalServerError)
+		return
+	}
+
+	// The backend is only given the path of a DELETE, so the version the
+	// client expects is passed along with the user.
+	ctx := context.WithValue(r.Context(), userKey, user)
+	ctx = context.WithValue(ctx, ifMatchKey, r.Header.Get("If-Match"))
+	h.caldav.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func unauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", `Basic realm="ubiquitest", charset="UTF-8"`)
+	http.Error(w, "the username and the calendar token are required", http.StatusUnauthorized)
+}
+
+func userFromContext(ctx context.Context) (users.User, error) {
+	user, ok := ctx.Value(userKey).(users.User)
+	if !ok {
+		return users.User{}, errors.New("no authenticated user")
+	}
+
+	return user, nil
+}
@@ -0,0 +1,119 @@
+package dav
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/emersion/go-webdav"
+	"github.com/emersion/go-webdav/caldav"
+	"github.com/zemzale/ubiquitest/domain/calendar"
+)
+
+const (
+	statusCompleted   = "COMPLETED"
+	statusNeedsAction = "NEEDS-ACTION"
+)
+
+func mapCalendar(home string, board calendar.Board) caldav.Calendar {
+	return caldav.Calendar{
+		Path:                  home + board.ID.String() + "/",
+		Name:                  board.Title,
+		SupportedComponentSet: []string{ical.CompToDo},
+	}
+}
+
+func mapCalendarObject(home string, todo calendar.Todo) caldav.CalendarObject {
+	return caldav.CalendarObject{
+		Path: home + todo.BoardID.String() + "/" + todo.ID.String() + ".ics",
+		ETag: todo.Version(),
+		Data: calendarFromTodo(todo),
+	}
+}
+
+func calendarFromTodo(todo calendar.Todo) *ical.Calendar {
+	comp := ical.NewComponent(ical.CompToDo)
+	comp.Props.SetText(ical.PropUID, calendar.TaskUID(todo.ID))
+	comp.Props.SetDateTime(ical.PropDateTimeStamp, time.Now().UTC())
+	comp.Props.SetText(ical.PropSummary, todo.Title)
+	if !todo.DueAt.IsZero() {
+		comp.Props.SetDateTime(ical.PropDue, todo.DueAt.UTC())
+	}
+
+	if todo.Completed {
+		comp.Props.SetText(ical.PropStatus, statusCompleted)
+		if !todo.CompletedAt.IsZero() {
+			comp.Props.SetDateTime(ical.PropCompleted, todo.CompletedAt.UTC())
+		}
+	} else {
+		comp.Props.SetText(ical.PropStatus, statusNeedsAction)
+	}
+
+	// The top level tasks of a board are not related to anything, since the
+	// board is not a to-do.
+	if todo.ParentID != todo.BoardID {
+		related := ical.NewProp(ical.PropRelatedTo)
+		related.SetText(calendar.TaskUID(todo.ParentID))
+		related.Params.Set(ical.ParamRelationshipType, "PARENT")
+		comp.Props.Add(related)
+	}
+
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropVersion, "2.0")
+	cal.Props.SetText(ical.PropProductID, "-//ubiquitest//Tasks//EN")
+	cal.Children = append(cal.Children, comp)
+
+	return cal
+}
+
+// todoFromCalendar reads the fields of a task from a to-do, the ones a task
+// doesn't have are dropped.
+func todoFromCalendar(cal *ical.Calendar) (calendar.Todo, error) {
+	compType, _, err := caldav.ValidateCalendarObject(cal)
+	if err != nil {
+		return calendar.Todo{}, webdav.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	if compType != ical.CompToDo {
+		return calendar.Todo{}, caldav.NewPreconditionError(caldav.PreconditionSupportedCalendarComponent)
+	}
+
+	var comp *ical.Component
+	for _, child := range cal.Children {
+		if child.Name == ical.CompToDo {
+			comp = child
+			break
+		}
+	}
+
+	var todo calendar.Todo
+	if todo.Title, err = comp.Props.Text(ical.PropSummary); err != nil {
+		return calendar.Todo{}, webdav.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	status, err := comp.Props.Text(ical.PropStatus)
+	if err != nil {
+		return calendar.Todo{}, webdav.NewHTTPError(http.StatusBadRequest, err)
+	}
+	todo.Completed = status == statusCompleted || comp.Props.Get(ical.PropCompleted) != nil
+
+	if todo.DueAt, err = comp.Props.DateTime(ical.PropDue, time.UTC); err != nil {
+		return calendar.Todo{}, webdav.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid due date: %w", err))
+	}
+	todo.DueAt = todo.DueAt.UTC()
+
+	for _, related := range comp.Props.Values(ical.PropRelatedTo) {
+		if reltype := related.Params.Get(ical.ParamRelationshipType); reltype != "" && reltype != "PARENT" {
+			continue
+		}
+
+		// Parents that are not tasks are left out, the to-do is put in the
+		// board then.
+		if parentID, err := calendar.ParseTaskUID(related.Value); err == nil {
+			todo.ParentID = parentID
+		}
+	}
+
+	return todo, nil
+}
@@ -0,0 +1,46 @@
+package attachments
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/blob"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Delete struct {
+	attachmentRepo *storage.AttachmentRepository
+	blobStore      blob.Store
+	recordHistory  *history.Record
+}
+
+func NewDelete(attachmentRepo *storage.AttachmentRepository, blobStore blob.Store, recordHistory *history.Record) *Delete {
+	return &Delete{attachmentRepo: attachmentRepo, blobStore: blobStore, recordHistory: recordHistory}
+}
+
+func (d *Delete) Run(ctx context.Context, taskID uuid.UUID, id uuid.UUID, actorID uint) error {
+	attachment, err := find(d.attachmentRepo, taskID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := d.attachmentRepo.Delete(attachment.ID.String()); err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	change := history.Change{Field: history.FieldAttachment, OldValue: attachment.Filename}
+	if err := d.recordHistory.Run(taskID, actorID, change); err != nil {
+		log.Println("failed to record history of deleted attachment ", err)
+	}
+
+	// The attachment is already gone for the users, a leftover blob only
+	// takes up space.
+	if err := d.blobStore.Delete(ctx, attachment.ID.String()); err != nil {
+		log.Println("failed to delete blob of attachment ", err)
+	}
+
+	return nil
+}
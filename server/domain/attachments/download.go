@@ -0,0 +1,39 @@
+package attachments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/blob"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Download struct {
+	attachmentRepo *storage.AttachmentRepository
+	blobStore      blob.Store
+}
+
+func NewDownload(attachmentRepo *storage.AttachmentRepository, blobStore blob.Store) *Download {
+	return &Download{attachmentRepo: attachmentRepo, blobStore: blobStore}
+}
+
+// Run returns the attachment and its content, the caller has to close it.
+func (d *Download) Run(ctx context.Context, taskID uuid.UUID, id uuid.UUID) (Attachment, io.ReadCloser, error) {
+	attachment, err := find(d.attachmentRepo, taskID, id)
+	if err != nil {
+		return Attachment{}, nil, err
+	}
+
+	content, err := d.blobStore.Get(ctx, attachment.ID.String())
+	if errors.Is(err, blob.ErrNotFound) {
+		return Attachment{}, nil, fmt.Errorf("%w: file is missing from the store", ErrNotFound)
+	}
+	if err != nil {
+		return Attachment{}, nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	return attachment, content, nil
+}
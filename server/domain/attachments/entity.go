@@ -0,0 +1,84 @@
+package attachments
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+var (
+	// ErrTooLarge is returned when the uploaded file is over the size limit.
+	ErrTooLarge = errors.New("attachment is too large")
+	// ErrUnsupportedType is returned when the uploaded file is not one of the
+	// allowed MIME types.
+	ErrUnsupportedType = errors.New("attachment type is not allowed")
+	// ErrEmpty is returned when the uploaded file has no content.
+	ErrEmpty = errors.New("attachment is empty")
+	// ErrNotFound is returned when the task has no such attachment.
+	ErrNotFound = errors.New("attachment not found")
+)
+
+type Attachment struct {
+	ID          uuid.UUID
+	TaskID      uuid.UUID
+	Filename    string
+	ContentType string
+	Size        int64
+	UploadedBy  uint
+	CreatedAt   time.Time
+}
+
+// Limits are what the uploaded files are checked against.
+type Limits struct {
+	// MaxSize is the largest file in bytes.
+	MaxSize int64
+	// AllowedTypes are the MIME types without parameters, e.g. "image/png".
+	AllowedTypes []string
+}
+
+func mapAttachmentToDB(attachment Attachment) storage.Attachment {
+	return storage.Attachment{
+		ID:          attachment.ID.String(),
+		TaskID:      attachment.TaskID.String(),
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		UploadedBy:  attachment.UploadedBy,
+		CreatedAt:   attachment.CreatedAt.UTC(),
+	}
+}
+
+// MapAttachmentFromDB maps a stored attachment, it is also used where the
+// attachments are loaded together with their tasks.
+func MapAttachmentFromDB(record storage.Attachment) Attachment {
+	return Attachment{
+		ID:          uuid.MustParse(record.ID),
+		TaskID:      uuid.MustParse(record.TaskID),
+		Filename:    record.Filename,
+		ContentType: record.ContentType,
+		Size:        record.Size,
+		UploadedBy:  record.UploadedBy,
+		CreatedAt:   record.CreatedAt.UTC(),
+	}
+}
+
+func find(attachmentRepo *storage.AttachmentRepository, taskID uuid.UUID, id uuid.UUID) (Attachment, error) {
+	record, err := attachmentRepo.Find(id.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return Attachment{}, ErrNotFound
+	}
+	if err != nil {
+		return Attachment{}, fmt.Errorf("failed to find attachment: %w", err)
+	}
+
+	attachment := MapAttachmentFromDB(record)
+	if attachment.TaskID != taskID {
+		return Attachment{}, fmt.Errorf("%w: it doesn't belong to task %s", ErrNotFound, taskID)
+	}
+
+	return attachment, nil
+}
@@ -0,0 +1,125 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/blob"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Upload struct {
+	attachmentRepo *storage.AttachmentRepository
+	taskRepo       *storage.TaksRepository
+	blobStore      blob.Store
+	limits         Limits
+	recordHistory  *history.Record
+}
+
+func NewUpload(
+	attachmentRepo *storage.AttachmentRepository,
+	taskRepo *storage.TaksRepository,
+	blobStore blob.Store,
+	limits Limits,
+	recordHistory *history.Record,
+) *Upload {
+	return &Upload{
+		attachmentRepo: attachmentRepo,
+		taskRepo:       taskRepo,
+		blobStore:      blobStore,
+		limits:         limits,
+		recordHistory:  recordHistory,
+	}
+}
+
+// Run stores the file and attaches it to the task. The MIME type is sniffed
+// from the content, what the client claims is not trusted.
+func (u *Upload) Run(ctx context.Context, taskID uuid.UUID, uploadedBy uint, filename string, r io.Reader) (Attachment, error) {
+	if _, err := u.taskRepo.Find(taskID.String()); err != nil {
+		return Attachment{}, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	// Read one byte over the limit so we can tell if the file is too large
+	// without reading all of it.
+	var content bytes.Buffer
+	if _, err := io.Copy(&content, io.LimitReader(r, u.limits.MaxSize+1)); err != nil {
+		return Attachment{}, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	if int64(content.Len()) > u.limits.MaxSize {
+		return Attachment{}, fmt.Errorf("%w: max size is %d bytes", ErrTooLarge, u.limits.MaxSize)
+	}
+
+	if content.Len() == 0 {
+		return Attachment{}, ErrEmpty
+	}
+
+	contentType, err := u.detectContentType(content.Bytes())
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	attachment := Attachment{
+		ID:          uuid.New(),
+		TaskID:      taskID,
+		Filename:    cleanFilename(filename),
+		ContentType: contentType,
+		Size:        int64(content.Len()),
+		UploadedBy:  uploadedBy,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	err = u.blobStore.Put(ctx, attachment.ID.String(), &content, attachment.Size, attachment.ContentType)
+	if err != nil {
+		return Attachment{}, fmt.Errorf("failed to store file: %w", err)
+	}
+
+	if err := u.attachmentRepo.Create(mapAttachmentToDB(attachment)); err != nil {
+		if err := u.blobStore.Delete(ctx, attachment.ID.String()); err != nil {
+			log.Println("failed to clean up blob of failed attachment ", err)
+		}
+
+		return Attachment{}, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	change := history.Change{Field: history.FieldAttachment, NewValue: attachment.Filename}
+	if err := u.recordHistory.Run(taskID, uploadedBy, change); err != nil {
+		log.Println("failed to record history of uploaded attachment ", err)
+	}
+
+	return attachment, nil
+}
+
+func (u *Upload) detectContentType(content []byte) (string, error) {
+	contentType := http.DetectContentType(content)
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse content type: %w", err)
+	}
+
+	if !slices.Contains(u.limits.AllowedTypes, mediaType) {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedType, mediaType)
+	}
+
+	return contentType, nil
+}
+
+func cleanFilename(filename string) string {
+	filename = strings.TrimSpace(filepath.Base(strings.ReplaceAll(filename, `\`, "/")))
+	if filename == "" || filename == "." || filename == "/" {
+		return "file"
+	}
+
+	return filename
+}
@@ -0,0 +1,152 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/blob"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestUpload(t *testing.T) {
+	t.Parallel()
+
+	taskID := uuid.MustParse("7b9d3c2a-1e4f-4a6b-8c5d-9e0f1a2b3c4d")
+	png := append([]byte("\x89PNG\x0D\x0A\x1A\x0A"), bytes.Repeat([]byte{0}, 32)...)
+
+	tests := []struct {
+		name     string
+		taskID   uuid.UUID
+		filename string
+		content  []byte
+		wantErr  error
+		wantType string
+	}{
+		{
+			name:     "png is stored",
+			taskID:   taskID,
+			filename: "screenshot.png",
+			content:  png,
+			wantType: "image/png",
+		},
+		{
+			name:     "path is stripped from the filename",
+			taskID:   taskID,
+			filename: `C:\Users\me\receipt.txt`,
+			content:  []byte("milk 2.50"),
+			wantType: "text/plain; charset=utf-8",
+		},
+		{
+			name:     "type is sniffed, not taken from the name",
+			taskID:   taskID,
+			filename: "page.png",
+			content:  []byte("<html><body>hi</body></html>"),
+			wantErr:  ErrUnsupportedType,
+		},
+		{
+			name:     "too large",
+			taskID:   taskID,
+			filename: "big.txt",
+			content:  bytes.Repeat([]byte("a"), 65),
+			wantErr:  ErrTooLarge,
+		},
+		{
+			name:     "empty",
+			taskID:   taskID,
+			filename: "empty.txt",
+			content:  []byte{},
+			wantErr:  ErrEmpty,
+		},
+	}
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	taskRepo := storage.NewTaskRepository(db)
+	attachmentRepo := storage.NewAttachmentRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	require.NoError(t, taskRepo.Create(storage.Task{ID: taskID.String(), Title: "Buy milk", CreatedBy: 1}))
+
+	blobStore, err := blob.NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	upload := NewUpload(attachmentRepo, taskRepo, blobStore, Limits{
+		MaxSize:      64,
+		AllowedTypes: []string{"image/png", "text/plain"},
+	}, recordHistory)
+	download := NewDownload(attachmentRepo, blobStore)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			attachment, err := upload.Run(ctx, tt.taskID, 1, tt.filename, bytes.NewReader(tt.content))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, attachment.ContentType)
+			assert.Equal(t, int64(len(tt.content)), attachment.Size)
+			assert.False(t, strings.ContainsAny(attachment.Filename, `/\`))
+
+			got, content, err := download.Run(ctx, tt.taskID, attachment.ID)
+			require.NoError(t, err)
+			body, err := io.ReadAll(content)
+			require.NoError(t, err)
+			require.NoError(t, content.Close())
+			assert.Equal(t, attachment, got)
+			assert.Equal(t, tt.content, body)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	taskID := uuid.MustParse("2c4e6a8b-0d1f-4e3a-9b5c-7d9e1f3a5b7c")
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	taskRepo := storage.NewTaskRepository(db)
+	attachmentRepo := storage.NewAttachmentRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	require.NoError(t, taskRepo.Create(storage.Task{ID: taskID.String(), Title: "Buy milk", CreatedBy: 1}))
+
+	blobStore, err := blob.NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	upload := NewUpload(attachmentRepo, taskRepo, blobStore, Limits{MaxSize: 64, AllowedTypes: []string{"text/plain"}}, recordHistory)
+	attachment, err := upload.Run(ctx, taskID, 1, "note.txt", strings.NewReader("note"))
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, NewDelete(attachmentRepo, blobStore, recordHistory).Run(ctx, uuid.New(), attachment.ID, 1), ErrNotFound,
+		"attachment must not be deleted through another task")
+
+	require.NoError(t, NewDelete(attachmentRepo, blobStore, recordHistory).Run(ctx, taskID, attachment.ID, 1))
+
+	_, _, err = NewDownload(attachmentRepo, blobStore).Run(ctx, taskID, attachment.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = blobStore.Get(ctx, attachment.ID.String())
+	assert.ErrorIs(t, err, blob.ErrNotFound, "blob must be removed with the attachment")
+}
@@ -0,0 +1,146 @@
+package calendar
+
+import (
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// ListBoards returns the top level tasks that are not in the trash or the
+// archive, in order.
+type ListBoards struct {
+	taskRepo *storage.TaksRepository
+}
+
+func NewListBoards(taskRepo *storage.TaksRepository) *ListBoards {
+	return &ListBoards{taskRepo: taskRepo}
+}
+
+func (l *ListBoards) Run() ([]Board, error) {
+	records, err := l.taskRepo.List(storage.TaskFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]Board, 0)
+	for _, record := range records {
+		if isBoard(record) {
+			id, err := uuid.Parse(record.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			boards = append(boards, Board{ID: id, Title: record.Title})
+		}
+	}
+
+	return boards, nil
+}
+
+// ListTodos returns the to-dos of a board, parents before their children.
+type ListTodos struct {
+	taskRepo *storage.TaksRepository
+}
+
+func NewListTodos(taskRepo *storage.TaksRepository) *ListTodos {
+	return &ListTodos{taskRepo: taskRepo}
+}
+
+func (l *ListTodos) Run(boardID uuid.UUID) (Board, []Todo, error) {
+	return boardTodos(l.taskRepo, boardID)
+}
+
+// FindTodo returns a to-do of a board.
+type FindTodo struct {
+	taskRepo *storage.TaksRepository
+}
+
+func NewFindTodo(taskRepo *storage.TaksRepository) *FindTodo {
+	return &FindTodo{taskRepo: taskRepo}
+}
+
+func (f *FindTodo) Run(boardID uuid.UUID, id uuid.UUID) (Todo, error) {
+	_, todos, err := boardTodos(f.taskRepo, boardID)
+	if err != nil {
+		return Todo{}, err
+	}
+
+	todo, ok := findTodo(todos, id)
+	if !ok {
+		return Todo{}, ErrNotFound
+	}
+
+	return todo, nil
+}
+
+func isBoard(record *storage.Task) bool {
+	return !record.ParentID.Valid || record.ParentID.V == uuid.Nil.String()
+}
+
+// boardTodos returns the board with all the tasks under it. The tasks in the
+// trash or the archive are left out, with their whole subtree.
+func boardTodos(taskRepo *storage.TaksRepository, boardID uuid.UUID) (Board, []Todo, error) {
+	records, err := taskRepo.List(storage.TaskFilter{})
+	if err != nil {
+		return Board{}, nil, err
+	}
+
+	var board *storage.Task
+	children := make(map[string][]*storage.Task)
+	for _, record := range records {
+		if record.ID == boardID.String() && isBoard(record) {
+			board = record
+		}
+
+		children[record.ParentID.V] = append(children[record.ParentID.V], record)
+	}
+
+	if board == nil {
+		return Board{}, nil, ErrNotFound
+	}
+
+	todos := make([]Todo, 0)
+	// Listed tasks are ordered by their position, so siblings stay in order.
+	queue := children[board.ID]
+	for len(queue) > 0 {
+		record := queue[0]
+		queue = append(queue[1:], children[record.ID]...)
+
+		todo, err := mapTodo(record, boardID)
+		if err != nil {
+			return Board{}, nil, err
+		}
+
+		todos = append(todos, todo)
+	}
+
+	return Board{ID: boardID, Title: board.Title}, todos, nil
+}
+
+func mapTodo(record *storage.Task, boardID uuid.UUID) (Todo, error) {
+	id, err := uuid.Parse(record.ID)
+	if err != nil {
+		return Todo{}, err
+	}
+
+	parentID, err := uuid.Parse(record.ParentID.V)
+	if err != nil {
+		return Todo{}, err
+	}
+
+	todo := Todo{
+		ID:        id,
+		BoardID:   boardID,
+		ParentID:  parentID,
+		Title:     record.Title,
+		Completed: record.Completed,
+	}
+	if record.DueAt.Valid {
+		todo.DueAt = record.DueAt.V.UTC()
+	}
+
+	if record.Completed && record.CompletedAt.Valid {
+		todo.CompletedAt = record.CompletedAt.V.UTC()
+	}
+
+	return todo, nil
+}
@@ -0,0 +1,247 @@
+package calendar
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+const (
+	rootID     = "7a6b5c4d-3e2f-4a1b-8c9d-0e1f2a3b4c01"
+	paintID    = "7a6b5c4d-3e2f-4a1b-8c9d-0e1f2a3b4c02"
+	tilesID    = "7a6b5c4d-3e2f-4a1b-8c9d-0e1f2a3b4c03"
+	gardenID   = "7a6b5c4d-3e2f-4a1b-8c9d-0e1f2a3b4c04"
+	archivedID = "7a6b5c4d-3e2f-4a1b-8c9d-0e1f2a3b4c05"
+)
+
+func TestFeed(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'alice'), (2, 'bob')")
+	require.NoError(t, err, "failed to insert users")
+
+	due := func(d int) sql.Null[time.Time] {
+		return sql.Null[time.Time]{V: time.Date(2025, 6, d, 10, 0, 0, 0, time.UTC), Valid: true}
+	}
+	taskRepo := storage.NewTaskRepository(db)
+	top := sql.Null[string]{V: uuid.Nil.String(), Valid: true}
+	under := sql.Null[string]{V: rootID, Valid: true}
+	for _, task := range []storage.Task{
+		{ID: rootID, Title: "Renovate", CreatedBy: 1, ParentID: top},
+		{ID: paintID, Title: "Paint", CreatedBy: 1, ParentID: under, DueAt: due(3)},
+		{ID: tilesID, Title: "Tiles", CreatedBy: 2, ParentID: under, DueAt: due(1)},
+		{ID: gardenID, Title: "Garden", CreatedBy: 2, ParentID: top, DueAt: due(2)},
+		{ID: archivedID, Title: "Archived", CreatedBy: 1, ParentID: top, DueAt: due(2)},
+	} {
+		require.NoError(t, taskRepo.Create(task))
+	}
+
+	_, err = db.Exec("UPDATE tasks SET completed = true, completed_by = 1, completed_at = ? WHERE id = ?", due(4).V, paintID)
+	require.NoError(t, err)
+	_, err = taskRepo.ArchiveSubtree(archivedID, due(5).V)
+	require.NoError(t, err)
+
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	_, err = assigneeRepo.Create(storage.Assignee{TaskID: tilesID, UserID: 1, AssignedBy: 2, AssignedAt: due(1).V})
+	require.NoError(t, err)
+
+	tokenRepo := storage.NewCalendarTokenRepository(db)
+	userRepo := storage.NewUserRepository(db)
+	getToken := NewGetToken(tokenRepo, userRepo)
+	feed := NewFeed(tokenRepo, userRepo, taskRepo, assigneeRepo)
+
+	token, err := getToken.Run(1)
+	require.NoError(t, err)
+	again, err := getToken.Run(1)
+	require.NoError(t, err)
+	assert.Equal(t, token, again, "the token is created once")
+
+	calendar, err := feed.Run(token)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", calendar.Username)
+	assert.Equal(t, []Entry{
+		{
+			TaskID:   uuid.MustParse(tilesID),
+			ParentID: uuid.MustParse(rootID),
+			Title:    "Tiles",
+			Path:     []string{"Renovate"},
+			DueAt:    due(1).V,
+		},
+		{
+			TaskID:      uuid.MustParse(paintID),
+			ParentID:    uuid.MustParse(rootID),
+			Title:       "Paint",
+			Path:        []string{"Renovate"},
+			DueAt:       due(3).V,
+			Completed:   true,
+			CompletedAt: due(4).V,
+		},
+	}, calendar.Entries, "only the due tasks the user created or is assigned to, by due date")
+
+	rotated, err := NewRotateToken(tokenRepo, userRepo).Run(1)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, rotated)
+
+	_, err = feed.Run(token)
+	assert.ErrorIs(t, err, ErrUnknownToken, "the old token stops working")
+
+	_, err = getToken.Run(3)
+	assert.ErrorIs(t, err, ErrUnknownUser)
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	calendar := Calendar{
+		Username: "alice",
+		Entries: []Entry{
+			{
+				TaskID:   uuid.MustParse(paintID),
+				ParentID: uuid.MustParse(rootID),
+				Title:    "Paint; walls, then\nceiling \\ " + strings.Repeat("é", 40),
+				Path:     []string{"House", "Renovate"},
+				DueAt:    time.Date(2025, 6, 3, 10, 0, 0, 0, time.UTC),
+			},
+			{
+				TaskID:      uuid.MustParse(gardenID),
+				Title:       "Garden",
+				DueAt:       time.Date(2025, 6, 2, 10, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+				Completed:   true,
+				CompletedAt: time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	var b strings.Builder
+	require.NoError(t, calendar.Encode(&b, time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)))
+	ics := b.String()
+
+	require.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineLength, "line is folded: %q", line)
+		assert.True(t, utf8.ValidString(line), "lines are folded between characters: %q", line)
+	}
+
+	unfolded := strings.ReplaceAll(ics, "\r\n ", "")
+	assert.Contains(t, unfolded, `SUMMARY:Paint\; walls\, then\nceiling \\ `+strings.Repeat("é", 40)+"\r\n",
+		"text is escaped")
+	assert.Contains(t, unfolded, "UID:"+paintID+"@ubiquitest\r\n")
+	assert.Contains(t, unfolded, "UID:"+paintID+"-due@ubiquitest\r\n")
+	assert.Contains(t, unfolded, "DESCRIPTION:Part of House › Renovate\r\n")
+	assert.Contains(t, unfolded, "RELATED-TO;RELTYPE=PARENT:"+rootID+"@ubiquitest\r\n")
+	assert.Contains(t, unfolded, "STATUS:NEEDS-ACTION\r\n")
+	assert.Contains(t, unfolded, "DTSTAMP:20250501T000000Z\r\n")
+	assert.Contains(t, unfolded, "DUE:20250602T080000Z\r\nSTATUS:COMPLETED\r\nPERCENT-COMPLETE:100\r\nCOMPLETED:20250601T080000Z\r\n")
+	assert.Contains(t, unfolded, "SUMMARY:✓ Garden\r\n")
+	assert.Equal(t, 1, strings.Count(unfolded, "RELATED-TO"), "top level tasks have no parent")
+}
+
+func TestPutDeleteTodo(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'alice'), (2, 'bob')")
+	require.NoError(t, err, "failed to insert users")
+
+	rates, err := money.NewRates("EUR", nil)
+	require.NoError(t, err)
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := tasks.NewUpdateParentCost(tasks.NewFindAllParents(taskRepo), taskRepo, rates)
+	store := tasks.NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, rates)
+	update := tasks.NewUpdate(
+		updateParentCost, taskRepo, storage.NewDependencyRepository(db),
+		tasks.NewCreateNextOccurrence(store, taskRepo, assigneeRepo, recordHistory), recordHistory, rates,
+	)
+	putTodo := NewPutTodo(taskRepo, store, update, rates)
+	deleteTodo := NewDeleteTodo(taskRepo, tasks.NewDelete(updateParentCost, taskRepo, recordHistory))
+
+	boardID := uuid.MustParse(rootID)
+	top := sql.Null[string]{V: uuid.Nil.String(), Valid: true}
+	require.NoError(t, taskRepo.Create(storage.Task{ID: rootID, Title: "Renovate", CreatedBy: 1, ParentID: top, Currency: "EUR"}))
+	require.NoError(t, taskRepo.Create(storage.Task{ID: gardenID, Title: "Garden", CreatedBy: 1, ParentID: top, Currency: "EUR"}))
+
+	boards, err := NewListBoards(taskRepo).Run()
+	require.NoError(t, err)
+	assert.Len(t, boards, 2, "top level tasks are boards")
+
+	due := time.Date(2025, 6, 3, 10, 0, 0, 0, time.UTC)
+	saved, err := putTodo.Run(Todo{
+		ID: uuid.MustParse(paintID), BoardID: boardID, ParentID: uuid.New(), Title: " Paint ", Completed: true, DueAt: due,
+	}, Precondition{Absent: true}, 2)
+	require.NoError(t, err, "failed to create to-do")
+	assert.Equal(t, uuid.MustParse(paintID), saved.Created.ID)
+	assert.Equal(t, boardID, saved.Created.ParentID, "unknown parents are replaced by the board")
+	assert.True(t, saved.Updated.After.Completed, "completed to-dos are completed after they're created")
+	assert.Equal(t, "Paint", saved.Todo.Title)
+	assert.True(t, saved.Todo.Completed)
+	assert.Equal(t, due, saved.Todo.DueAt)
+
+	found, err := NewFindTodo(taskRepo).Run(boardID, saved.Todo.ID)
+	require.NoError(t, err)
+	assert.Equal(t, saved.Todo.Version(), found.Version(), "the version only changes with the to-do")
+
+	_, err = putTodo.Run(Todo{ID: saved.Todo.ID, BoardID: boardID, Title: "Paint"}, Precondition{Absent: true}, 2)
+	assert.ErrorIs(t, err, ErrVersionMismatch, "existing to-dos can't be created again")
+
+	_, err = putTodo.Run(Todo{ID: uuid.MustParse(gardenID), BoardID: boardID, Title: "Garden"}, Precondition{}, 2)
+	assert.ErrorIs(t, err, ErrIDConflict, "tasks outside the board can't be put in it")
+
+	_, err = putTodo.Run(Todo{ID: saved.Todo.ID, BoardID: boardID, Title: " "}, Precondition{}, 2)
+	assert.ErrorIs(t, err, ErrInvalidTodo)
+
+	changed, err := putTodo.Run(
+		Todo{ID: saved.Todo.ID, BoardID: boardID, Title: "Paint walls"}, Precondition{Version: saved.Todo.Version()}, 1,
+	)
+	require.NoError(t, err, "failed to change to-do")
+	assert.Equal(t, "Paint", changed.Updated.Before.Title)
+	assert.False(t, changed.Todo.Completed)
+	assert.True(t, changed.Todo.DueAt.IsZero())
+	assert.NotEqual(t, saved.Todo.Version(), changed.Todo.Version())
+
+	unchanged, err := putTodo.Run(changed.Todo, Precondition{}, 1)
+	require.NoError(t, err)
+	assert.Empty(t, unchanged.Updated.Kind, "nothing is updated when nothing changed")
+
+	_, _, err = deleteTodo.Run(boardID, saved.Todo.ID, Precondition{Version: saved.Todo.Version()}, 1)
+	assert.ErrorIs(t, err, ErrVersionMismatch, "stale versions can't be deleted")
+
+	deleted, deletedIDs, err := deleteTodo.Run(boardID, saved.Todo.ID, Precondition{Version: changed.Todo.Version()}, 1)
+	require.NoError(t, err, "failed to delete to-do")
+	assert.Equal(t, "Paint walls", deleted.Title)
+	assert.Equal(t, []uuid.UUID{saved.Todo.ID}, deletedIDs)
+
+	_, todos, err := NewListTodos(taskRepo).Run(boardID)
+	require.NoError(t, err)
+	assert.Empty(t, todos, "deleted to-dos are not listed")
+
+	_, err = NewFindTodo(taskRepo).Run(uuid.New(), saved.Todo.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
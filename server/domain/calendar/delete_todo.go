@@ -0,0 +1,39 @@
+package calendar
+
+import (
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// DeleteTodo moves a to-do of a board and its subtree to the trash through
+// tasks.Delete, so it can be restored like any other task.
+type DeleteTodo struct {
+	taskRepo   *storage.TaksRepository
+	deleteTask *tasks.Delete
+}
+
+func NewDeleteTodo(taskRepo *storage.TaksRepository, deleteTask *tasks.Delete) *DeleteTodo {
+	return &DeleteTodo{taskRepo: taskRepo, deleteTask: deleteTask}
+}
+
+// Run returns the deleted task with the IDs of everything that was deleted.
+func (d *DeleteTodo) Run(
+	boardID uuid.UUID, id uuid.UUID, precondition Precondition, actorID uint,
+) (tasks.Task, []uuid.UUID, error) {
+	_, todos, err := boardTodos(d.taskRepo, boardID)
+	if err != nil {
+		return tasks.Task{}, nil, err
+	}
+
+	todo, ok := findTodo(todos, id)
+	if !ok {
+		return tasks.Task{}, nil, ErrNotFound
+	}
+
+	if err := precondition.check(todo, true); err != nil {
+		return tasks.Task{}, nil, err
+	}
+
+	return d.deleteTask.Run(id, actorID)
+}
@@ -0,0 +1,126 @@
+// Package calendar serves tasks to calendar apps, the ones with due dates of a
+// user as an iCalendar feed they can subscribe to and every board as a
+// collection of to-dos they can sync both ways over CalDAV.
+package calendar
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// secretSize is the number of random bytes in a token.
+const secretSize = 32
+
+var (
+	// ErrUnknownToken is returned for a feed whose token doesn't exist or was
+	// rotated.
+	ErrUnknownToken = errors.New("unknown calendar token")
+	// ErrUnknownUser is returned when getting the token of a user that
+	// doesn't exist.
+	ErrUnknownUser = errors.New("unknown user")
+	// ErrNotFound is returned for a board or a to-do that doesn't exist, is
+	// in the trash or the archive, or is in another board.
+	ErrNotFound = errors.New("not found")
+	// ErrVersionMismatch is returned when changing a to-do that changed since
+	// the version the change was made to, or creating one that exists.
+	ErrVersionMismatch = errors.New("to-do has a different version")
+	// ErrIDConflict is returned when creating a to-do with the ID of a task
+	// that isn't in the board.
+	ErrIDConflict = errors.New("a task with the ID already exists elsewhere")
+	// ErrInvalidTodo is returned for a to-do that can't be a task.
+	ErrInvalidTodo = errors.New("invalid to-do")
+)
+
+// Calendar is the feed of a user.
+type Calendar struct {
+	Username string
+	// Entries are ordered by their due date.
+	Entries []Entry
+}
+
+// Entry is a task with a due date.
+type Entry struct {
+	TaskID uuid.UUID
+	// ParentID is uuid.Nil for top level tasks.
+	ParentID uuid.UUID
+	Title    string
+	// Path are the titles of the parents of the task, the top level one
+	// first.
+	Path      []string
+	DueAt     time.Time
+	Completed bool
+	// CompletedAt is the zero time when the task is not completed or was
+	// completed before the time was recorded.
+	CompletedAt time.Time
+}
+
+// Board is a top level task, its subtasks are synced as its to-dos.
+type Board struct {
+	ID    uuid.UUID
+	Title string
+}
+
+// Todo is a task in a board.
+type Todo struct {
+	ID      uuid.UUID
+	BoardID uuid.UUID
+	// ParentID is the board for the top level tasks of the board.
+	ParentID  uuid.UUID
+	Title     string
+	Completed bool
+	// CompletedAt is the zero time when the task is not completed or was
+	// completed before the time was recorded.
+	CompletedAt time.Time
+	// DueAt is the zero time when the task has no due date.
+	DueAt time.Time
+}
+
+// Version changes whenever a synced field of the to-do does, so it's used to
+// detect changes made since a client last read it.
+func (t Todo) Version() string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s|%s|%q|%t|%s|%s",
+		t.ID, t.ParentID, t.Title, t.Completed, t.CompletedAt.Format(time.RFC3339Nano), t.DueAt.Format(time.RFC3339Nano),
+	))
+
+	return hex.EncodeToString(sum[:16])
+}
+
+// Precondition is what the client expects of the to-do it changes.
+type Precondition struct {
+	// Version is the version the to-do has to have, any when empty.
+	Version string
+	// Absent is set when the to-do has to be created, not changed.
+	Absent bool
+}
+
+func (p Precondition) check(todo Todo, exists bool) error {
+	if exists && p.Absent {
+		return fmt.Errorf("%w: it already exists", ErrVersionMismatch)
+	}
+
+	if p.Version == "" {
+		return nil
+	}
+
+	if !exists || todo.Version() != p.Version {
+		return ErrVersionMismatch
+	}
+
+	return nil
+}
+
+func newSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate calendar token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
@@ -0,0 +1,119 @@
+package calendar
+
+import (
+	"cmp"
+	"database/sql"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// Feed returns the calendar of the user with the token. It has the tasks with
+// a due date that the user created or is assigned to, leaving out the ones in
+// the trash or the archive.
+type Feed struct {
+	tokenRepo    *storage.CalendarTokenRepository
+	userRepo     *storage.UserRepository
+	taskRepo     *storage.TaksRepository
+	assigneeRepo *storage.AssigneeRepository
+}
+
+func NewFeed(
+	tokenRepo *storage.CalendarTokenRepository,
+	userRepo *storage.UserRepository,
+	taskRepo *storage.TaksRepository,
+	assigneeRepo *storage.AssigneeRepository,
+) *Feed {
+	return &Feed{tokenRepo: tokenRepo, userRepo: userRepo, taskRepo: taskRepo, assigneeRepo: assigneeRepo}
+}
+
+func (f *Feed) Run(secret string) (Calendar, error) {
+	token, err := f.tokenRepo.FindByToken(secret)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Calendar{}, ErrUnknownToken
+		}
+
+		return Calendar{}, err
+	}
+
+	user, err := f.userRepo.FindByID(token.UserID)
+	if err != nil {
+		return Calendar{}, err
+	}
+
+	// Archiving and trashing take the whole subtree, so the parents of the
+	// listed tasks are listed too.
+	records, err := f.taskRepo.List(storage.TaskFilter{})
+	if err != nil {
+		return Calendar{}, err
+	}
+
+	assignees, err := f.assigneeRepo.ListAll()
+	if err != nil {
+		return Calendar{}, err
+	}
+
+	byID := make(map[string]*storage.Task, len(records))
+	for _, record := range records {
+		byID[record.ID] = record
+	}
+
+	entries := make([]Entry, 0)
+	for _, record := range records {
+		if !record.DueAt.Valid {
+			continue
+		}
+
+		if record.CreatedBy != user.ID && !slices.Contains(assignees[record.ID], user.ID) {
+			continue
+		}
+
+		entry, err := mapEntry(record, byID)
+		if err != nil {
+			return Calendar{}, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	slices.SortFunc(entries, func(a, b Entry) int {
+		return cmp.Or(a.DueAt.Compare(b.DueAt), cmp.Compare(a.TaskID.String(), b.TaskID.String()))
+	})
+
+	return Calendar{Username: user.Username, Entries: entries}, nil
+}
+
+func mapEntry(record *storage.Task, byID map[string]*storage.Task) (Entry, error) {
+	id, err := uuid.Parse(record.ID)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	entry := Entry{
+		TaskID:    id,
+		Title:     record.Title,
+		DueAt:     record.DueAt.V.UTC(),
+		Completed: record.Completed,
+	}
+	if record.Completed && record.CompletedAt.Valid {
+		entry.CompletedAt = record.CompletedAt.V.UTC()
+	}
+
+	if parent, ok := byID[record.ParentID.V]; ok {
+		if entry.ParentID, err = uuid.Parse(parent.ID); err != nil {
+			return Entry{}, err
+		}
+	}
+
+	// The depth is bounded by the number of tasks in case the tree has a
+	// cycle.
+	for parent, ok := byID[record.ParentID.V]; ok && len(entry.Path) < len(byID); parent, ok = byID[parent.ParentID.V] {
+		entry.Path = append(entry.Path, parent.Title)
+	}
+	slices.Reverse(entry.Path)
+
+	return entry, nil
+}
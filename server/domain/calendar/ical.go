@@ -0,0 +1,142 @@
+package calendar
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// uidDomain makes the UIDs of tasks globally unique, as RFC 5545 asks.
+	uidDomain = "ubiquitest"
+	// maxLineLength is the longest a line can be in octets, without the line
+	// break, before it has to be folded.
+	maxLineLength = 75
+	// dateTimeLayout is the layout of UTC date-times in iCalendar.
+	dateTimeLayout = "20060102T150405Z"
+)
+
+// Encode writes the calendar as iCalendar. Every entry is both a to-do, for
+// apps that have tasks, and an event at the due date, for the ones that only
+// show events. Both have UIDs derived from the ID of the task, so they are
+// updated in place when the feed is reloaded. The time the feed is generated
+// at is the stamp of all of them.
+func (c Calendar) Encode(w io.Writer, now time.Time) error {
+	var b icalBuilder
+	b.line("BEGIN", "VCALENDAR")
+	b.line("VERSION", "2.0")
+	b.line("PRODID", "-//"+uidDomain+"//Tasks//EN")
+	b.line("CALSCALE", "GREGORIAN")
+	b.line("X-WR-CALNAME", escapeText("Tasks of "+c.Username))
+
+	stamp := now.UTC().Format(dateTimeLayout)
+	for _, entry := range c.Entries {
+		description := ""
+		if len(entry.Path) > 0 {
+			description = "Part of " + strings.Join(entry.Path, " › ")
+		}
+
+		b.line("BEGIN", "VTODO")
+		b.line("UID", TaskUID(entry.TaskID))
+		b.line("DTSTAMP", stamp)
+		b.line("SUMMARY", escapeText(entry.Title))
+		if description != "" {
+			b.line("DESCRIPTION", escapeText(description))
+		}
+		b.line("DUE", entry.DueAt.UTC().Format(dateTimeLayout))
+		if entry.Completed {
+			b.line("STATUS", "COMPLETED")
+			b.line("PERCENT-COMPLETE", "100")
+			if !entry.CompletedAt.IsZero() {
+				b.line("COMPLETED", entry.CompletedAt.UTC().Format(dateTimeLayout))
+			}
+		} else {
+			b.line("STATUS", "NEEDS-ACTION")
+		}
+		if entry.ParentID != uuid.Nil {
+			b.line("RELATED-TO;RELTYPE=PARENT", TaskUID(entry.ParentID))
+		}
+		b.line("END", "VTODO")
+
+		// Events have no completion, so the title shows it.
+		summary := entry.Title
+		if entry.Completed {
+			summary = "✓ " + summary
+		}
+
+		b.line("BEGIN", "VEVENT")
+		b.line("UID", entry.TaskID.String()+"-due@"+uidDomain)
+		b.line("DTSTAMP", stamp)
+		b.line("DTSTART", entry.DueAt.UTC().Format(dateTimeLayout))
+		b.line("SUMMARY", escapeText(summary))
+		if description != "" {
+			b.line("DESCRIPTION", escapeText(description))
+		}
+		b.line("TRANSP", "TRANSPARENT")
+		b.line("END", "VEVENT")
+	}
+	b.line("END", "VCALENDAR")
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+
+	return nil
+}
+
+// TaskUID returns the UID of the to-do of a task.
+func TaskUID(id uuid.UUID) string {
+	return id.String() + "@" + uidDomain
+}
+
+// ParseTaskUID returns the ID of the task of a to-do UID.
+func ParseTaskUID(uid string) (uuid.UUID, error) {
+	return uuid.Parse(strings.TrimSuffix(uid, "@"+uidDomain))
+}
+
+// textEscaper escapes the characters that are special in TEXT values.
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// escapeText escapes a TEXT value and drops the control characters it can't
+// have.
+func escapeText(s string) string {
+	s = textEscaper.Replace(s)
+
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 && r != '\t' || r == 0x7f {
+			return -1
+		}
+
+		return r
+	}, s)
+}
+
+// icalBuilder writes content lines, folded so none is longer than
+// maxLineLength octets and ended with CRLF.
+type icalBuilder struct {
+	strings.Builder
+}
+
+func (b *icalBuilder) line(name string, value string) {
+	line := name + ":" + value
+	limit := maxLineLength
+	for len(line) > limit {
+		// Lines are folded between characters, never inside one.
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// The space that starts a folded line counts toward its length.
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
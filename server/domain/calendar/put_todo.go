@@ -0,0 +1,163 @@
+package calendar
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// Saved is what putting a to-do did to the tasks.
+type Saved struct {
+	Todo Todo
+	// Created is the zero task unless the to-do was created.
+	Created tasks.Task
+	// Updated is the zero operation unless the task was updated, which is
+	// also how a created to-do is completed.
+	Updated tasks.Operation
+}
+
+// PutTodo creates or changes a to-do of a board through tasks.Store and
+// tasks.Update, so it's validated the same way as any other task. Moving a
+// to-do to another parent is not synced, it's created under its parent, or
+// the board when the parent is not in it, and keeps it.
+type PutTodo struct {
+	taskRepo *storage.TaksRepository
+	store    *tasks.Store
+	update   *tasks.Update
+	rates    *money.Rates
+}
+
+func NewPutTodo(taskRepo *storage.TaksRepository, store *tasks.Store, update *tasks.Update, rates *money.Rates) *PutTodo {
+	return &PutTodo{taskRepo: taskRepo, store: store, update: update, rates: rates}
+}
+
+func (p *PutTodo) Run(change Todo, precondition Precondition, actorID uint) (Saved, error) {
+	change.Title = strings.TrimSpace(change.Title)
+	if change.Title == "" {
+		return Saved{}, fmt.Errorf("%w: title can't be empty", ErrInvalidTodo)
+	}
+
+	_, todos, err := boardTodos(p.taskRepo, change.BoardID)
+	if err != nil {
+		return Saved{}, err
+	}
+
+	existing, exists := findTodo(todos, change.ID)
+	if err := precondition.check(existing, exists); err != nil {
+		return Saved{}, err
+	}
+
+	// The tasks that were saved before an error are returned with it, a
+	// to-do that is created but can't be completed stays created.
+	var saved Saved
+	if exists {
+		saved, err = p.change(existing, change, precondition, actorID)
+	} else {
+		saved, err = p.create(change, todos, actorID)
+	}
+	if err != nil {
+		return saved, err
+	}
+
+	_, todos, err = boardTodos(p.taskRepo, change.BoardID)
+	if err != nil {
+		return saved, err
+	}
+
+	saved.Todo, _ = findTodo(todos, change.ID)
+
+	return saved, nil
+}
+
+func (p *PutTodo) create(change Todo, todos []Todo, actorID uint) (Saved, error) {
+	_, err := p.taskRepo.Find(change.ID.String())
+	if err == nil {
+		return Saved{}, ErrIDConflict
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return Saved{}, err
+	}
+
+	parentID := change.BoardID
+	if _, ok := findTodo(todos, change.ParentID); ok {
+		parentID = change.ParentID
+	}
+
+	task := tasks.Task{
+		ID:        change.ID,
+		Title:     change.Title,
+		CreatedBy: actorID,
+		ParentID:  parentID,
+		Cost:      money.New(0, p.rates.Base()),
+		DueAt:     change.DueAt,
+	}
+	if err := p.store.Run(task); err != nil {
+		return Saved{}, err
+	}
+
+	saved := Saved{Created: task}
+	if !change.Completed {
+		return saved, nil
+	}
+
+	// Tasks are created incomplete, so a completed to-do is completed right
+	// after it's created.
+	saved.Updated, err = p.update.Run(tasks.Change{ID: task.ID, Title: task.Title, Completed: true}, actorID)
+	if err != nil {
+		return saved, err
+	}
+
+	return saved, nil
+}
+
+func (p *PutTodo) change(existing Todo, change Todo, precondition Precondition, actorID uint) (Saved, error) {
+	if existing.Title == change.Title && existing.Completed == change.Completed && existing.DueAt.Equal(change.DueAt) {
+		return Saved{}, nil
+	}
+
+	// The to-do can change after the version was checked, so the update is
+	// only made if it still has the version it was checked with.
+	var expected *tasks.Expected
+	if precondition.Version != "" {
+		expected = &tasks.Expected{
+			ParentID:    existing.ParentID,
+			Title:       existing.Title,
+			Completed:   existing.Completed,
+			CompletedAt: existing.CompletedAt,
+			DueAt:       existing.DueAt,
+		}
+	}
+
+	op, err := p.update.Run(tasks.Change{
+		ID:        change.ID,
+		Title:     change.Title,
+		Completed: change.Completed,
+		DueAt:     &change.DueAt,
+		Expected:  expected,
+	}, actorID)
+	if errors.Is(err, tasks.ErrChanged) {
+		return Saved{}, fmt.Errorf("%w: %w", ErrVersionMismatch, err)
+	}
+	if err != nil {
+		return Saved{}, err
+	}
+
+	return Saved{Updated: op}, nil
+}
+
+func findTodo(todos []Todo, id uuid.UUID) (Todo, bool) {
+	for _, todo := range todos {
+		if todo.ID == id {
+			return todo, true
+		}
+	}
+
+	return Todo{}, false
+}
@@ -0,0 +1,104 @@
+package calendar
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zemzale/ubiquitest/domain/users"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// GetToken returns the token of the feed of a user, creating one the first
+// time.
+type GetToken struct {
+	tokenRepo *storage.CalendarTokenRepository
+	userRepo  *storage.UserRepository
+}
+
+func NewGetToken(tokenRepo *storage.CalendarTokenRepository, userRepo *storage.UserRepository) *GetToken {
+	return &GetToken{tokenRepo: tokenRepo, userRepo: userRepo}
+}
+
+func (g *GetToken) Run(userID uint) (string, error) {
+	token, err := g.tokenRepo.FindByUser(userID)
+	if err == nil {
+		return token.Token, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+
+	return createToken(g.tokenRepo, g.userRepo, userID)
+}
+
+// RotateToken replaces the token of the feed of a user, so the old URL stops
+// working, e.g. after it was shared by accident.
+type RotateToken struct {
+	tokenRepo *storage.CalendarTokenRepository
+	userRepo  *storage.UserRepository
+}
+
+func NewRotateToken(tokenRepo *storage.CalendarTokenRepository, userRepo *storage.UserRepository) *RotateToken {
+	return &RotateToken{tokenRepo: tokenRepo, userRepo: userRepo}
+}
+
+func (r *RotateToken) Run(userID uint) (string, error) {
+	return createToken(r.tokenRepo, r.userRepo, userID)
+}
+
+func createToken(tokenRepo *storage.CalendarTokenRepository, userRepo *storage.UserRepository, userID uint) (string, error) {
+	if _, err := userRepo.FindByID(userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrUnknownUser
+		}
+
+		return "", err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return "", err
+	}
+
+	token := storage.CalendarToken{UserID: userID, Token: secret, CreatedAt: time.Now().UTC()}
+	if err := tokenRepo.Save(token); err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+// Authenticate returns the user with the username and the token, apps that
+// sync over CalDAV send the token as the password.
+type Authenticate struct {
+	tokenRepo *storage.CalendarTokenRepository
+	userRepo  *storage.UserRepository
+}
+
+func NewAuthenticate(tokenRepo *storage.CalendarTokenRepository, userRepo *storage.UserRepository) *Authenticate {
+	return &Authenticate{tokenRepo: tokenRepo, userRepo: userRepo}
+}
+
+func (a *Authenticate) Run(username string, secret string) (users.User, error) {
+	token, err := a.tokenRepo.FindByToken(secret)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return users.User{}, ErrUnknownToken
+		}
+
+		return users.User{}, err
+	}
+
+	user, err := a.userRepo.FindByID(token.UserID)
+	if err != nil {
+		return users.User{}, err
+	}
+
+	if user.Username != username {
+		return users.User{}, ErrUnknownToken
+	}
+
+	return users.User{ID: user.ID, Username: user.Username}, nil
+}
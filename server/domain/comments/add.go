@@ -0,0 +1,59 @@
+package comments
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Add struct {
+	commentRepo *storage.CommentRepository
+	taskRepo    *storage.TaksRepository
+	userRepo    *storage.UserRepository
+
+	recordHistory *history.Record
+}
+
+func NewAdd(
+	commentRepo *storage.CommentRepository,
+	taskRepo *storage.TaksRepository,
+	userRepo *storage.UserRepository,
+	recordHistory *history.Record,
+) *Add {
+	return &Add{commentRepo: commentRepo, taskRepo: taskRepo, userRepo: userRepo, recordHistory: recordHistory}
+}
+
+func (a *Add) Run(taskID uuid.UUID, authorID uint, body string) (Comment, error) {
+	if err := validateBody(body); err != nil {
+		return Comment{}, err
+	}
+
+	if _, err := a.userRepo.Exists(authorID); err != nil {
+		return Comment{}, fmt.Errorf("user doesn't exist: %w", err)
+	}
+
+	if _, err := a.taskRepo.Find(taskID.String()); err != nil {
+		return Comment{}, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	now := time.Now().UTC()
+	comment := Comment{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		AuthorID:  authorID,
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := a.commentRepo.Create(mapCommentToDB(comment)); err != nil {
+		return Comment{}, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	recordComment(a.recordHistory, comment.TaskID, authorID, "", comment.Body)
+
+	return comment, nil
+}
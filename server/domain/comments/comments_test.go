@@ -0,0 +1,68 @@
+package comments
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestComments(t *testing.T) {
+	t.Parallel()
+
+	taskID := uuid.MustParse("0f7c1a3e-5b2d-4c6a-9e8f-1d2c3b4a5f60")
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'author'), (2, 'someone')")
+	require.NoError(t, err, "failed to insert users")
+
+	taskRepo := storage.NewTaskRepository(db)
+	commentRepo := storage.NewCommentRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	require.NoError(t, taskRepo.Create(storage.Task{ID: taskID.String(), Title: "Buy milk", CreatedBy: 1}))
+
+	add := NewAdd(commentRepo, taskRepo, storage.NewUserRepository(db), recordHistory)
+
+	_, err = add.Run(taskID, 1, "  ")
+	assert.ErrorIs(t, err, ErrInvalidComment)
+
+	_, err = add.Run(uuid.New(), 1, "hello")
+	assert.Error(t, err, "commenting on an unknown task must fail")
+
+	comment, err := add.Run(taskID, 1, "Get the oat milk")
+	require.NoError(t, err, "failed to add comment")
+
+	_, err = NewEdit(commentRepo, recordHistory).Run(taskID, comment.ID, 2, "Get the soy milk")
+	assert.ErrorIs(t, err, ErrNotAuthor)
+
+	edited, err := NewEdit(commentRepo, recordHistory).Run(taskID, comment.ID, 1, "Get the soy milk")
+	require.NoError(t, err, "author must be able to edit")
+	assert.Equal(t, comment.CreatedAt, edited.CreatedAt)
+	assert.False(t, edited.UpdatedAt.Before(comment.UpdatedAt))
+
+	list, err := NewList(commentRepo).Run(taskID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "Get the soy milk", list[0].Body)
+	assert.Equal(t, uint(1), list[0].AuthorID)
+
+	assert.ErrorIs(t, NewDelete(commentRepo, recordHistory).Run(taskID, comment.ID, 2), ErrNotAuthor)
+	assert.ErrorIs(t, NewDelete(commentRepo, recordHistory).Run(uuid.New(), comment.ID, 1), ErrNotFound, "the comment belongs to another task")
+	assert.ErrorIs(t, NewDelete(commentRepo, recordHistory).Run(taskID, uuid.New(), 1), ErrNotFound)
+	require.NoError(t, NewDelete(commentRepo, recordHistory).Run(taskID, comment.ID, 1))
+
+	list, err = NewList(commentRepo).Run(taskID)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
@@ -0,0 +1,34 @@
+package comments
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Delete struct {
+	commentRepo   *storage.CommentRepository
+	recordHistory *history.Record
+}
+
+func NewDelete(commentRepo *storage.CommentRepository, recordHistory *history.Record) *Delete {
+	return &Delete{commentRepo: commentRepo, recordHistory: recordHistory}
+}
+
+// Run deletes the comment. Only the author can delete it.
+func (d *Delete) Run(taskID uuid.UUID, commentID uuid.UUID, userID uint) error {
+	comment, err := findOwn(d.commentRepo, taskID, commentID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := d.commentRepo.Delete(comment.ID.String()); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	recordComment(d.recordHistory, comment.TaskID, userID, comment.Body, "")
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package comments
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Edit struct {
+	commentRepo   *storage.CommentRepository
+	recordHistory *history.Record
+}
+
+func NewEdit(commentRepo *storage.CommentRepository, recordHistory *history.Record) *Edit {
+	return &Edit{commentRepo: commentRepo, recordHistory: recordHistory}
+}
+
+// Run changes the body of the comment. Only the author can edit it.
+func (e *Edit) Run(taskID uuid.UUID, commentID uuid.UUID, userID uint, body string) (Comment, error) {
+	if err := validateBody(body); err != nil {
+		return Comment{}, err
+	}
+
+	comment, err := findOwn(e.commentRepo, taskID, commentID, userID)
+	if err != nil {
+		return Comment{}, err
+	}
+
+	oldBody := comment.Body
+	comment.Body = body
+	comment.UpdatedAt = time.Now().UTC()
+
+	if err := e.commentRepo.Update(mapCommentToDB(comment)); err != nil {
+		return Comment{}, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	recordComment(e.recordHistory, comment.TaskID, userID, oldBody, comment.Body)
+
+	return comment, nil
+}
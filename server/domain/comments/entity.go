@@ -0,0 +1,94 @@
+package comments
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+var (
+	// ErrInvalidComment is returned when the comment body is empty.
+	ErrInvalidComment = errors.New("invalid comment")
+	// ErrNotAuthor is returned when someone other than the author tries to
+	// change a comment.
+	ErrNotAuthor = errors.New("only the author can change the comment")
+	// ErrNotFound is returned when the comment doesn't exist or belongs to
+	// another task.
+	ErrNotFound = errors.New("comment not found")
+)
+
+type Comment struct {
+	ID        uuid.UUID
+	TaskID    uuid.UUID
+	AuthorID  uint
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func validateBody(body string) error {
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("%w: body can't be empty", ErrInvalidComment)
+	}
+
+	return nil
+}
+
+func mapCommentToDB(comment Comment) storage.Comment {
+	return storage.Comment{
+		ID:        comment.ID.String(),
+		TaskID:    comment.TaskID.String(),
+		AuthorID:  comment.AuthorID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt.UTC(),
+		UpdatedAt: comment.UpdatedAt.UTC(),
+	}
+}
+
+func mapCommentFromDB(record storage.Comment) Comment {
+	return Comment{
+		ID:        uuid.MustParse(record.ID),
+		TaskID:    uuid.MustParse(record.TaskID),
+		AuthorID:  record.AuthorID,
+		Body:      record.Body,
+		CreatedAt: record.CreatedAt.UTC(),
+		UpdatedAt: record.UpdatedAt.UTC(),
+	}
+}
+
+// findOwn returns the comment if it belongs to the task and was written by
+// the user.
+func findOwn(commentRepo *storage.CommentRepository, taskID uuid.UUID, commentID uuid.UUID, userID uint) (Comment, error) {
+	record, err := commentRepo.Find(commentID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return Comment{}, ErrNotFound
+	}
+	if err != nil {
+		return Comment{}, fmt.Errorf("failed to find comment: %w", err)
+	}
+
+	comment := mapCommentFromDB(record)
+	if comment.TaskID != taskID {
+		return Comment{}, fmt.Errorf("%w: it doesn't belong to task %s", ErrNotFound, taskID)
+	}
+
+	if comment.AuthorID != userID {
+		return Comment{}, ErrNotAuthor
+	}
+
+	return comment, nil
+}
+
+func recordComment(recordHistory *history.Record, taskID uuid.UUID, actorID uint, oldBody string, newBody string) {
+	change := history.Change{Field: history.FieldComment, OldValue: oldBody, NewValue: newBody}
+	if err := recordHistory.Run(taskID, actorID, change); err != nil {
+		log.Println("failed to record history of comment ", err)
+	}
+}
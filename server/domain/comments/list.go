@@ -0,0 +1,29 @@
+package comments
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type List struct {
+	commentRepo *storage.CommentRepository
+}
+
+func NewList(commentRepo *storage.CommentRepository) *List {
+	return &List{commentRepo: commentRepo}
+}
+
+// Run returns the comments of the task, oldest first.
+func (l *List) Run(taskID uuid.UUID) ([]Comment, error) {
+	records, err := l.commentRepo.ListByTask(taskID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	return lo.Map(records, func(record storage.Comment, _ int) Comment {
+		return mapCommentFromDB(record)
+	}), nil
+}
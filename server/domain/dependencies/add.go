@@ -0,0 +1,92 @@
+package dependencies
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Add struct {
+	dependencyRepo *storage.DependencyRepository
+	taskRepo       *storage.TaksRepository
+	recordHistory  *history.Record
+}
+
+func NewAdd(dependencyRepo *storage.DependencyRepository, taskRepo *storage.TaksRepository, recordHistory *history.Record) *Add {
+	return &Add{dependencyRepo: dependencyRepo, taskRepo: taskRepo, recordHistory: recordHistory}
+}
+
+// Run makes blockedBy block the task and returns the dependencies of the task.
+// added is false when blockedBy already blocked it.
+func (a *Add) Run(taskID uuid.UUID, blockedBy uuid.UUID, actorID uint) (dependencies Dependencies, added bool, err error) {
+	if taskID == blockedBy {
+		return Dependencies{}, false, ErrSelfDependency
+	}
+
+	for _, id := range []uuid.UUID{taskID, blockedBy} {
+		record, err := a.taskRepo.Find(id.String())
+		if err != nil {
+			return Dependencies{}, false, fmt.Errorf("failed to find task: %w", err)
+		}
+
+		if record.DeletedAt.Valid {
+			return Dependencies{}, false, tasks.ErrTrashed
+		}
+	}
+
+	graph, err := a.dependencyRepo.ListAllByTask()
+	if err != nil {
+		return Dependencies{}, false, fmt.Errorf("failed to list dependencies: %w", err)
+	}
+
+	if reaches(graph, blockedBy.String(), taskID.String()) {
+		return Dependencies{}, false, ErrCycle
+	}
+
+	added, err = a.dependencyRepo.Add(taskID.String(), blockedBy.String())
+	if err != nil {
+		return Dependencies{}, false, err
+	}
+
+	if added {
+		change := history.Change{Field: history.FieldBlockedBy, NewValue: blockedBy.String()}
+		if err := a.recordHistory.Run(taskID, actorID, change); err != nil {
+			log.Println("failed to record history of added dependency ", err)
+		}
+	}
+
+	dependencies, err = findDependencies(a.dependencyRepo, taskID)
+	if err != nil {
+		return Dependencies{}, false, err
+	}
+
+	return dependencies, added, nil
+}
+
+// reaches reports whether the task from is transitively blocked by the task
+// to, following the edges from each task to its blockers.
+func reaches(graph map[string][]string, from string, to string) bool {
+	visited := map[string]bool{from: true}
+	stack := []string{from}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, next := range graph[current] {
+			if next == to {
+				return true
+			}
+
+			if !visited[next] {
+				visited[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+package dependencies
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestDependencies(t *testing.T) {
+	t.Parallel()
+
+	var (
+		designID = uuid.MustParse("5b1c7e2a-8d34-4f6a-9b0e-1c2d3e4f5a01")
+		buildID  = uuid.MustParse("5b1c7e2a-8d34-4f6a-9b0e-1c2d3e4f5a02")
+		testID   = uuid.MustParse("5b1c7e2a-8d34-4f6a-9b0e-1c2d3e4f5a03")
+		shipID   = uuid.MustParse("5b1c7e2a-8d34-4f6a-9b0e-1c2d3e4f5a04")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+	require.NoError(t, err, "failed to insert user")
+
+	rates, err := money.NewRates("EUR", nil)
+	require.NoError(t, err)
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	dependencyRepo := storage.NewDependencyRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := tasks.NewUpdateParentCost(tasks.NewFindAllParents(taskRepo), taskRepo, rates)
+	store := tasks.NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, rates)
+	update := tasks.NewUpdate(
+		updateParentCost, taskRepo, dependencyRepo, tasks.NewCreateNextOccurrence(store, taskRepo, assigneeRepo, recordHistory), recordHistory, rates,
+	)
+	list := tasks.NewList(db, taskRepo, assigneeRepo, storage.NewLabelRepository(db), storage.NewAttachmentRepository(db), dependencyRepo, tasks.NewRollUp(taskRepo, tasks.DefaultMetrics(rates), true))
+
+	// Listed in reverse of the order they have to be done in.
+	for _, task := range []tasks.Task{
+		{ID: shipID, Title: "Ship", CreatedBy: 1},
+		{ID: testID, Title: "Test", CreatedBy: 1},
+		{ID: buildID, Title: "Build", CreatedBy: 1},
+		{ID: designID, Title: "Design", CreatedBy: 1},
+	} {
+		require.NoError(t, store.Run(task))
+	}
+
+	add := NewAdd(dependencyRepo, taskRepo, recordHistory)
+	remove := NewRemove(dependencyRepo, recordHistory)
+
+	dependencies, added, err := add.Run(buildID, designID, 1)
+	require.NoError(t, err)
+	assert.True(t, added)
+	assert.Equal(t, []uuid.UUID{designID}, dependencies.BlockedBy)
+	assert.True(t, dependencies.Blocked)
+
+	_, added, err = add.Run(buildID, designID, 1)
+	require.NoError(t, err, "adding twice must not fail")
+	assert.False(t, added)
+
+	_, _, err = add.Run(testID, buildID, 1)
+	require.NoError(t, err)
+	_, _, err = add.Run(shipID, testID, 1)
+	require.NoError(t, err)
+
+	_, _, err = add.Run(shipID, shipID, 1)
+	assert.ErrorIs(t, err, ErrSelfDependency)
+
+	_, _, err = add.Run(designID, shipID, 1)
+	assert.ErrorIs(t, err, ErrCycle, "design is transitively blocking ship")
+
+	_, _, err = add.Run(shipID, uuid.New(), 1)
+	assert.Error(t, err, "an unknown task can't block anything")
+
+	ordered, err := NewListOrdered(list).Run(tasks.ListFilter{})
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]uuid.UUID{designID, buildID, testID, shipID},
+		lo.Map(ordered, func(task tasks.Task, _ int) uuid.UUID { return task.ID }),
+	)
+	assert.Equal(t,
+		[]bool{false, true, true, true},
+		lo.Map(ordered, func(task tasks.Task, _ int) bool { return task.Blocked }),
+	)
+
+	_, err = update.Run(tasks.Change{ID: buildID, Title: "Build", Completed: true}, 1)
+	assert.ErrorIs(t, err, tasks.ErrBlocked, "build can't be completed before design")
+
+	_, err = update.Run(tasks.Change{ID: designID, Title: "Design", Completed: true}, 1)
+	require.NoError(t, err)
+
+	dependents, err := NewListDependents(dependencyRepo).Run(designID)
+	require.NoError(t, err)
+	require.Len(t, dependents, 1)
+	assert.Equal(t, buildID, dependents[0].TaskID)
+	assert.False(t, dependents[0].Blocked, "completing design must unblock build")
+
+	_, err = update.Run(tasks.Change{ID: buildID, Title: "Build", Completed: true}, 1)
+	require.NoError(t, err)
+
+	dependencies, removed, err := remove.Run(shipID, testID, 1)
+	require.NoError(t, err)
+	assert.True(t, removed)
+	assert.Empty(t, dependencies.BlockedBy)
+	assert.False(t, dependencies.Blocked)
+
+	_, removed, err = remove.Run(shipID, testID, 1)
+	require.NoError(t, err)
+	assert.False(t, removed)
+
+	_, err = update.Run(tasks.Change{ID: shipID, Title: "Ship", Completed: true}, 1)
+	require.NoError(t, err, "ship is no longer blocked by test")
+
+	// Build is blocked by design and blocks test, purging it removes both.
+	_, err = taskRepo.Purge([]string{buildID.String()})
+	require.NoError(t, err)
+
+	var left int
+	require.NoError(t, db.Get(&left, "SELECT COUNT(*) FROM task_dependencies WHERE task_id = ? OR blocked_by = ?", buildID, buildID))
+	assert.Zero(t, left, "purging a task must remove its dependencies")
+}
@@ -0,0 +1,37 @@
+package dependencies
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type ListDependents struct {
+	dependencyRepo *storage.DependencyRepository
+}
+
+func NewListDependents(dependencyRepo *storage.DependencyRepository) *ListDependents {
+	return &ListDependents{dependencyRepo: dependencyRepo}
+}
+
+// Run returns the dependencies of every task the given task blocks, used to
+// let clients know a task got blocked or unblocked when its blocker changed.
+func (l *ListDependents) Run(blockedBy uuid.UUID) ([]Dependencies, error) {
+	taskIDs, err := l.dependencyRepo.ListDependents(blockedBy.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dependent tasks: %w", err)
+	}
+
+	dependents := make([]Dependencies, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		dependencies, err := findDependencies(l.dependencyRepo, uuid.MustParse(taskID))
+		if err != nil {
+			return nil, err
+		}
+
+		dependents = append(dependents, dependencies)
+	}
+
+	return dependents, nil
+}
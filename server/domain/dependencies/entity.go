@@ -0,0 +1,44 @@
+package dependencies
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+var (
+	// ErrSelfDependency is returned when a task would block itself.
+	ErrSelfDependency = errors.New("task can't block itself")
+	// ErrCycle is returned when adding a dependency would make the tasks block
+	// each other.
+	ErrCycle = errors.New("dependency would create a cycle")
+)
+
+// Dependencies are the tasks blocking a task.
+type Dependencies struct {
+	TaskID    uuid.UUID
+	BlockedBy []uuid.UUID
+	// Blocked is true while any of the tasks in BlockedBy is incomplete.
+	Blocked bool
+}
+
+func findDependencies(dependencyRepo *storage.DependencyRepository, taskID uuid.UUID) (Dependencies, error) {
+	blockedBy, err := dependencyRepo.ListByTask(taskID.String())
+	if err != nil {
+		return Dependencies{}, fmt.Errorf("failed to list dependencies: %w", err)
+	}
+
+	incomplete, err := dependencyRepo.ListIncompleteBlockers(taskID.String())
+	if err != nil {
+		return Dependencies{}, fmt.Errorf("failed to list incomplete blockers: %w", err)
+	}
+
+	return Dependencies{
+		TaskID:    taskID,
+		BlockedBy: lo.Map(blockedBy, func(id string, _ int) uuid.UUID { return uuid.MustParse(id) }),
+		Blocked:   len(incomplete) > 0,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package dependencies
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+type ListOrdered struct {
+	taskList *tasks.List
+}
+
+func NewListOrdered(taskList *tasks.List) *ListOrdered {
+	return &ListOrdered{taskList: taskList}
+}
+
+// Run returns the tasks ordered so that every task comes after the tasks
+// blocking it. Tasks that don't depend on each other keep the order of the
+// list. Blockers that are not in the list, like archived tasks, are ignored.
+func (l *ListOrdered) Run(filter tasks.ListFilter) ([]tasks.Task, error) {
+	list, err := l.taskList.Run(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	index := make(map[uuid.UUID]int, len(list))
+	for i, task := range list {
+		index[task.ID] = i
+	}
+
+	// Kahn's algorithm, always taking the earliest ready task in list order.
+	waitingOn := make([]int, len(list))
+	blocks := make([][]int, len(list))
+	for i, task := range list {
+		for _, blockerID := range task.BlockedBy {
+			blocker, ok := index[blockerID]
+			if !ok {
+				continue
+			}
+
+			waitingOn[i]++
+			blocks[blocker] = append(blocks[blocker], i)
+		}
+	}
+
+	ordered := make([]tasks.Task, 0, len(list))
+	done := make([]bool, len(list))
+	for len(ordered) < len(list) {
+		next := -1
+		for i := range list {
+			if !done[i] && waitingOn[i] == 0 {
+				next = i
+				break
+			}
+		}
+
+		if next == -1 {
+			return nil, ErrCycle
+		}
+
+		done[next] = true
+		ordered = append(ordered, list[next])
+		for _, dependent := range blocks[next] {
+			waitingOn[dependent]--
+		}
+	}
+
+	return ordered, nil
+}
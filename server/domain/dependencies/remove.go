@@ -0,0 +1,41 @@
+package dependencies
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Remove struct {
+	dependencyRepo *storage.DependencyRepository
+	recordHistory  *history.Record
+}
+
+func NewRemove(dependencyRepo *storage.DependencyRepository, recordHistory *history.Record) *Remove {
+	return &Remove{dependencyRepo: dependencyRepo, recordHistory: recordHistory}
+}
+
+// Run stops blockedBy from blocking the task and returns the remaining
+// dependencies of the task. removed is false when blockedBy didn't block it.
+func (r *Remove) Run(taskID uuid.UUID, blockedBy uuid.UUID, actorID uint) (dependencies Dependencies, removed bool, err error) {
+	removed, err = r.dependencyRepo.Remove(taskID.String(), blockedBy.String())
+	if err != nil {
+		return Dependencies{}, false, err
+	}
+
+	if removed {
+		change := history.Change{Field: history.FieldBlockedBy, OldValue: blockedBy.String()}
+		if err := r.recordHistory.Run(taskID, actorID, change); err != nil {
+			log.Println("failed to record history of removed dependency ", err)
+		}
+	}
+
+	dependencies, err = findDependencies(r.dependencyRepo, taskID)
+	if err != nil {
+		return Dependencies{}, false, err
+	}
+
+	return dependencies, removed, nil
+}
@@ -0,0 +1,62 @@
+package history
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// Fields that are recorded in the history. Besides the task columns there are
+// the relations, where the old value is empty when something was added and
+// the new value is empty when it was removed.
+const (
+	FieldCreated               = "created"
+	FieldTitle                 = "title"
+	FieldCompleted             = "completed"
+	FieldCost                  = "cost"
+	FieldBudget                = "budget"
+	FieldDueAt                 = "due_at"
+	FieldRecurrenceRule        = "recurrence_rule"
+	FieldRecurrenceTimezone    = "recurrence_timezone"
+	FieldRecurrenceCopySubtree = "recurrence_copy_subtree"
+	FieldAssignee              = "assignee"
+	FieldLabel                 = "label"
+	FieldComment               = "comment"
+	FieldAttachment            = "attachment"
+	FieldDeletedAt             = "deleted_at"
+	FieldArchivedAt            = "archived_at"
+	FieldBlockedBy             = "blocked_by"
+	FieldTimeEntry             = "time_entry"
+	FieldPosition              = "position"
+)
+
+// Entry is a single change of a single field. ActorID is 0 when it's not known
+// who made the change.
+type Entry struct {
+	ID        int64
+	TaskID    uuid.UUID
+	ActorID   uint
+	Field     string
+	OldValue  string
+	NewValue  string
+	CreatedAt time.Time
+}
+
+type Change struct {
+	Field    string
+	OldValue string
+	NewValue string
+}
+
+func mapEntryFromDB(record storage.HistoryEntry) Entry {
+	return Entry{
+		ID:        record.ID,
+		TaskID:    uuid.MustParse(record.TaskID),
+		ActorID:   record.ActorID,
+		Field:     record.Field,
+		OldValue:  record.OldValue,
+		NewValue:  record.NewValue,
+		CreatedAt: record.CreatedAt.UTC(),
+	}
+}
@@ -0,0 +1,61 @@
+package history
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+const (
+	defaultActivityLimit = 50
+	maxActivityLimit     = 200
+)
+
+type ListByTask struct {
+	historyRepo *storage.HistoryRepository
+}
+
+func NewListByTask(historyRepo *storage.HistoryRepository) *ListByTask {
+	return &ListByTask{historyRepo: historyRepo}
+}
+
+// Run returns the history of the task, oldest first.
+func (l *ListByTask) Run(taskID uuid.UUID) ([]Entry, error) {
+	records, err := l.historyRepo.ListByTask(taskID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list history: %w", err)
+	}
+
+	return lo.Map(records, func(record storage.HistoryEntry, _ int) Entry {
+		return mapEntryFromDB(record)
+	}), nil
+}
+
+type ListActivity struct {
+	historyRepo *storage.HistoryRepository
+}
+
+func NewListActivity(historyRepo *storage.HistoryRepository) *ListActivity {
+	return &ListActivity{historyRepo: historyRepo}
+}
+
+// Run returns the latest changes of all tasks, newest first. A limit of 0
+// uses the default page size. beforeID is the ID of the last entry of the
+// previous page, or 0 for the first page.
+func (l *ListActivity) Run(limit int, beforeID int64) ([]Entry, error) {
+	if limit <= 0 {
+		limit = defaultActivityLimit
+	}
+	limit = min(limit, maxActivityLimit)
+
+	records, err := l.historyRepo.ListRecent(limit, beforeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list activity: %w", err)
+	}
+
+	return lo.Map(records, func(record storage.HistoryEntry, _ int) Entry {
+		return mapEntryFromDB(record)
+	}), nil
+}
@@ -0,0 +1,58 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestListActivity(t *testing.T) {
+	t.Parallel()
+
+	taskID := uuid.MustParse("5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9")
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	historyRepo := storage.NewHistoryRepository(db)
+	record := NewRecord(historyRepo)
+
+	require.NoError(t, record.Run(taskID, 1,
+		Change{Field: FieldCreated, NewValue: "Buy milk"},
+		Change{Field: FieldCost, OldValue: "0", NewValue: "0"},
+	))
+	require.NoError(t, record.Run(taskID, 2, Change{Field: FieldTitle, OldValue: "Buy milk", NewValue: "Buy oat milk"}))
+	require.NoError(t, record.Run(taskID, 1, Change{Field: FieldCost, OldValue: "0", NewValue: "3"}))
+
+	entries, err := NewListByTask(historyRepo).Run(taskID)
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{FieldCreated, FieldTitle, FieldCost},
+		lo.Map(entries, func(e Entry, _ int) string { return e.Field }),
+		"unchanged fields must not be recorded and the task history must be oldest first",
+	)
+	assert.Equal(t, uint(2), entries[1].ActorID)
+	assert.Equal(t, "Buy milk", entries[1].OldValue)
+
+	listActivity := NewListActivity(historyRepo)
+
+	firstPage, err := listActivity.Run(2, 0)
+	require.NoError(t, err)
+	require.Len(t, firstPage, 2)
+	assert.Equal(t, FieldCost, firstPage[0].Field, "activity must be newest first")
+
+	secondPage, err := listActivity.Run(2, firstPage[1].ID)
+	require.NoError(t, err)
+	require.Len(t, secondPage, 1)
+	assert.Equal(t, FieldCreated, secondPage[0].Field)
+}
@@ -0,0 +1,41 @@
+package history
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Record struct {
+	historyRepo *storage.HistoryRepository
+	now         func() time.Time
+}
+
+func NewRecord(historyRepo *storage.HistoryRepository) *Record {
+	return &Record{historyRepo: historyRepo, now: time.Now}
+}
+
+// Run appends the changes to the history of the task. Changes where the value
+// stayed the same are skipped.
+func (r *Record) Run(taskID uuid.UUID, actorID uint, changes ...Change) error {
+	now := r.now().UTC()
+	entries := lo.FilterMap(changes, func(change Change, _ int) (storage.HistoryEntry, bool) {
+		return storage.HistoryEntry{
+			TaskID:    taskID.String(),
+			ActorID:   actorID,
+			Field:     change.Field,
+			OldValue:  change.OldValue,
+			NewValue:  change.NewValue,
+			CreatedAt: now,
+		}, change.OldValue != change.NewValue
+	})
+
+	if err := r.historyRepo.Create(entries); err != nil {
+		return fmt.Errorf("failed to record history: %w", err)
+	}
+
+	return nil
+}
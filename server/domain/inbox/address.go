@@ -0,0 +1,103 @@
+package inbox
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// tokenSize is the number of random bytes in a token. The token is hex
+// encoded, mail servers don't always keep the case of the local part.
+const tokenSize = 16
+
+// Address is the email address of a user that creates top level tasks.
+type Address struct {
+	Token   string
+	Address string
+}
+
+// GetAddress returns the email address of a user, creating their token the
+// first time.
+type GetAddress struct {
+	tokenRepo *storage.InboxTokenRepository
+	userRepo  *storage.UserRepository
+	domain    string
+}
+
+func NewGetAddress(tokenRepo *storage.InboxTokenRepository, userRepo *storage.UserRepository, domain string) *GetAddress {
+	return &GetAddress{tokenRepo: tokenRepo, userRepo: userRepo, domain: domain}
+}
+
+func (g *GetAddress) Run(userID uint) (Address, error) {
+	user, err := findUser(g.userRepo, userID)
+	if err != nil {
+		return Address{}, err
+	}
+
+	token, err := g.tokenRepo.FindByUser(userID)
+	if err == nil {
+		return newAddress(user.Username, token.Token, g.domain), nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return Address{}, err
+	}
+
+	return createToken(g.tokenRepo, user, g.domain)
+}
+
+// RotateAddress replaces the token in the email address of a user, so the old
+// address stops working, e.g. after it started getting spam.
+type RotateAddress struct {
+	tokenRepo *storage.InboxTokenRepository
+	userRepo  *storage.UserRepository
+	domain    string
+}
+
+func NewRotateAddress(tokenRepo *storage.InboxTokenRepository, userRepo *storage.UserRepository, domain string) *RotateAddress {
+	return &RotateAddress{tokenRepo: tokenRepo, userRepo: userRepo, domain: domain}
+}
+
+func (r *RotateAddress) Run(userID uint) (Address, error) {
+	user, err := findUser(r.userRepo, userID)
+	if err != nil {
+		return Address{}, err
+	}
+
+	return createToken(r.tokenRepo, user, r.domain)
+}
+
+func findUser(userRepo *storage.UserRepository, userID uint) (storage.User, error) {
+	user, err := userRepo.FindByID(userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.User{}, ErrUnknownUser
+	}
+	if err != nil {
+		return storage.User{}, err
+	}
+
+	return user, nil
+}
+
+func createToken(tokenRepo *storage.InboxTokenRepository, user storage.User, domain string) (Address, error) {
+	secret := make([]byte, tokenSize)
+	if _, err := rand.Read(secret); err != nil {
+		return Address{}, fmt.Errorf("failed to generate inbox token: %w", err)
+	}
+
+	token := storage.InboxToken{UserID: user.ID, Token: hex.EncodeToString(secret), CreatedAt: time.Now().UTC()}
+	if err := tokenRepo.Save(token); err != nil {
+		return Address{}, err
+	}
+
+	return newAddress(user.Username, token.Token, domain), nil
+}
+
+func newAddress(username string, token string, domain string) Address {
+	return Address{Token: token, Address: username + "+" + token + "@" + domain}
+}
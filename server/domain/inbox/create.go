@@ -0,0 +1,69 @@
+package inbox
+
+import (
+	"bytes"
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/attachments"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// messageFilename is the name of the attached email.
+const messageFilename = "message.eml"
+
+type Create struct {
+	store  *tasks.Store
+	upload *attachments.Upload
+	rates  *money.Rates
+}
+
+func NewCreate(store *tasks.Store, upload *attachments.Upload, rates *money.Rates) *Create {
+	return &Create{store: store, upload: upload, rates: rates}
+}
+
+// Run creates the task of the email with its subtasks in the board of the
+// recipient, and attaches the email to it. It returns the created tasks,
+// parents first, which are also returned with an error when only some of them
+// could be created.
+func (c *Create) Run(ctx context.Context, recipient Recipient, raw []byte) ([]tasks.Task, error) {
+	draft, err := Parse(raw, c.rates.Base())
+	if err != nil {
+		return nil, err
+	}
+
+	task := newTask(draft, recipient.BoardID, recipient.User.ID)
+	if err := c.store.Run(task); err != nil {
+		return nil, err
+	}
+
+	created := []tasks.Task{task}
+	for _, subtaskDraft := range draft.Subtasks {
+		subtask := newTask(subtaskDraft, task.ID, recipient.User.ID)
+		if err := c.store.Run(subtask); err != nil {
+			return created, err
+		}
+
+		created = append(created, subtask)
+	}
+
+	// The tasks are already there, losing the original email isn't worth
+	// failing the delivery over.
+	if _, err := c.upload.Run(ctx, task.ID, recipient.User.ID, messageFilename, bytes.NewReader(raw)); err != nil {
+		log.Println("failed to attach email to task ", err)
+	}
+
+	return created, nil
+}
+
+func newTask(draft Draft, parentID uuid.UUID, createdBy uint) tasks.Task {
+	return tasks.Task{
+		ID:        uuid.New(),
+		Title:     draft.Title,
+		CreatedBy: createdBy,
+		ParentID:  parentID,
+		Cost:      draft.Cost,
+	}
+}
@@ -0,0 +1,41 @@
+// Package inbox turns emails into tasks. Every user has an address, with their
+// username and a secret token as the local part, that creates top level tasks,
+// e.g. alice+3f9c0a1b2d4e5f60718293a4b5c6d7e8@tasks.example.com, and one for
+// every board, with the ID of the board after another "+". Anyone can send to
+// the address, so the token keeps others from guessing it.
+package inbox
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/users"
+)
+
+var (
+	// ErrUnknownRecipient is returned when the address doesn't belong to a
+	// user, or to a board that exists and is not in the trash or the archive.
+	ErrUnknownRecipient = errors.New("unknown recipient")
+	// ErrInvalidMessage is returned when the email can't be read or there is
+	// no task in it.
+	ErrInvalidMessage = errors.New("invalid message")
+	// ErrUnknownUser is returned when getting the address of a user that
+	// doesn't exist.
+	ErrUnknownUser = errors.New("unknown user")
+)
+
+// Recipient is the user an email was sent to and the board the tasks are
+// created in, uuid.Nil for the top level.
+type Recipient struct {
+	User    users.User
+	BoardID uuid.UUID
+}
+
+// Draft is a task read from an email. The subtasks have no subtasks of their
+// own.
+type Draft struct {
+	Title    string
+	Cost     money.Money
+	Subtasks []Draft
+}
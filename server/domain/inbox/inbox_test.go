@@ -0,0 +1,237 @@
+package inbox
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/blob"
+	"github.com/zemzale/ubiquitest/domain/attachments"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+const boardID = "5e4d3c2b-1a09-4f8e-9d7c-6b5a4f3e2d01"
+
+func email(lines ...string) []byte {
+	return []byte(strings.Join(lines, "\r\n"))
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		give    []byte
+		want    Draft
+		wantErr error
+	}{
+		{
+			name: "plain text",
+			give: email(
+				"From: bob@example.com",
+				"Subject: Fwd: RE: Renovate the kitchen $1200",
+				"",
+				"Hi, here's the list:",
+				"- Buy tiles $300.50",
+				"  -   Call the plumber",
+				"-no space, not a task",
+				"--",
+				"Bob",
+			),
+			want: Draft{
+				Title: "Renovate the kitchen",
+				Cost:  money.New(120000, "EUR"),
+				Subtasks: []Draft{
+					{Title: "Buy tiles", Cost: money.New(30050, "EUR")},
+					{Title: "Call the plumber", Cost: money.New(0, "EUR")},
+				},
+			},
+		},
+		{
+			name: "multipart",
+			give: email(
+				"Subject: =?UTF-8?Q?Caf=C3=A9_order?=",
+				`Content-Type: multipart/alternative; boundary="b1"`,
+				"",
+				"--b1",
+				"Content-Type: text/plain; charset=utf-8",
+				"Content-Transfer-Encoding: base64",
+				"",
+				"LSBFc3ByZXNzbyAkMwotIENyb2lzc2FudA==",
+				"--b1",
+				"Content-Type: text/html",
+				"",
+				"<ul><li>Espresso</li></ul>",
+				"--b1--",
+			),
+			want: Draft{
+				Title: "Café order",
+				Cost:  money.New(0, "EUR"),
+				Subtasks: []Draft{
+					{Title: "Espresso", Cost: money.New(300, "EUR")},
+					{Title: "Croissant", Cost: money.New(0, "EUR")},
+				},
+			},
+		},
+		{
+			name: "quoted printable",
+			give: email(
+				"Subject: Groceries",
+				"Content-Type: text/plain",
+				"Content-Transfer-Encoding: quoted-printable",
+				"",
+				"- Milk =",
+				"$2",
+			),
+			want: Draft{
+				Title:    "Groceries",
+				Cost:     money.New(0, "EUR"),
+				Subtasks: []Draft{{Title: "Milk", Cost: money.New(200, "EUR")}},
+			},
+		},
+		{
+			name:    "no subject",
+			give:    email("Subject: Fwd:", "", "- Something"),
+			wantErr: ErrInvalidMessage,
+		},
+		{
+			name: "rounded cost",
+			give: email("Subject: Stamps $1.234 each", ""),
+			want: Draft{Title: "Stamps each", Cost: money.New(123, "EUR")},
+		},
+		{
+			name:    "not an email",
+			give:    []byte("no headers here"),
+			wantErr: ErrInvalidMessage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(tt.give, "EUR")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolveCreate(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'alice'), (2, 'bob')")
+	require.NoError(t, err, "failed to insert users")
+
+	rates, err := money.NewRates("EUR", nil)
+	require.NoError(t, err)
+
+	taskRepo := storage.NewTaskRepository(db)
+	top := sql.Null[string]{V: uuid.Nil.String(), Valid: true}
+	require.NoError(t, taskRepo.Create(storage.Task{ID: boardID, Title: "House", CreatedBy: 1, ParentID: top, Currency: "EUR"}))
+
+	userRepo := storage.NewUserRepository(db)
+	tokenRepo := storage.NewInboxTokenRepository(db)
+	getAddress := NewGetAddress(tokenRepo, userRepo, "tasks.example.com")
+	bob, err := getAddress.Run(2)
+	require.NoError(t, err)
+	again, err := getAddress.Run(2)
+	require.NoError(t, err)
+	assert.Equal(t, bob, again, "the address stays the same until it's rotated")
+	alice, err := getAddress.Run(1)
+	require.NoError(t, err)
+	assert.NotEqual(t, bob.Token, alice.Token)
+
+	_, err = getAddress.Run(3)
+	assert.ErrorIs(t, err, ErrUnknownUser)
+
+	resolve := NewResolve(userRepo, taskRepo, tokenRepo, "tasks.example.com")
+	recipient, err := resolve.Run("<bob+" + strings.ToUpper(bob.Token) + "+" + boardID + "@Tasks.Example.com>")
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), recipient.User.ID)
+	assert.Equal(t, uuid.MustParse(boardID), recipient.BoardID)
+
+	recipient, err = resolve.Run(alice.Address)
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, recipient.BoardID, "the address without a board is for the top level")
+
+	rotated, err := NewRotateAddress(tokenRepo, userRepo, "tasks.example.com").Run(1)
+	require.NoError(t, err)
+	assert.NotEqual(t, alice.Token, rotated.Token)
+	_, err = resolve.Run(rotated.Address)
+	require.NoError(t, err)
+
+	for _, address := range []string{
+		"alice@tasks.example.com",
+		"bob+" + boardID + "@tasks.example.com",
+		alice.Address,
+		"bob+" + rotated.Token + "@tasks.example.com",
+		"alice+" + strings.Repeat("0", 32) + "@tasks.example.com",
+		"carol+" + bob.Token + "@tasks.example.com",
+		"alice+" + rotated.Token + "@example.com",
+		"alice+" + rotated.Token + "+nope@tasks.example.com",
+		"alice+" + rotated.Token + "+" + uuid.NewString() + "@tasks.example.com",
+		"alice",
+	} {
+		_, err := resolve.Run(address)
+		assert.ErrorIs(t, err, ErrUnknownRecipient, address)
+	}
+
+	blobStore, err := blob.NewLocal(t.TempDir())
+	require.NoError(t, err)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := tasks.NewUpdateParentCost(tasks.NewFindAllParents(taskRepo), taskRepo, rates)
+	store := tasks.NewStore(updateParentCost, taskRepo, userRepo, storage.NewAssigneeRepository(db), recordHistory, rates)
+	attachmentRepo := storage.NewAttachmentRepository(db)
+	upload := attachments.NewUpload(
+		attachmentRepo, taskRepo, blobStore, attachments.Limits{MaxSize: 1 << 20, AllowedTypes: []string{"text/plain"}}, recordHistory,
+	)
+	create := NewCreate(store, upload, rates)
+
+	raw := email("Subject: Paint the walls $100", "", "- Buy paint $40", "- Paint")
+	created, err := create.Run(context.Background(), Recipient{User: recipient.User, BoardID: uuid.MustParse(boardID)}, raw)
+	require.NoError(t, err, "failed to create tasks from email")
+	require.Len(t, created, 3)
+	assert.Equal(t, uuid.MustParse(boardID), created[0].ParentID)
+	assert.Equal(t, uint(1), created[0].CreatedBy)
+	assert.Equal(t, created[0].ID, created[1].ParentID)
+	assert.Equal(t, created[0].ID, created[2].ParentID)
+
+	task, err := taskRepo.Find(created[0].ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Paint the walls", task.Title)
+	assert.Equal(t, int64(14000), task.TotalCost, "the cost of the subtasks is added to the task")
+
+	board, err := taskRepo.Find(boardID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(14000), board.TotalCost)
+
+	attached, err := attachmentRepo.ListAllByTask()
+	require.NoError(t, err)
+	require.Len(t, attached[created[0].ID.String()], 1, "the email is attached")
+	assert.Equal(t, "message.eml", attached[created[0].ID.String()][0].Filename)
+	assert.Equal(t, int64(len(raw)), attached[created[0].ID.String()][0].Size)
+
+	_, err = create.Run(context.Background(), recipient, email("Subject: ", ""))
+	assert.ErrorIs(t, err, ErrInvalidMessage)
+}
@@ -0,0 +1,165 @@
+package inbox
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"regexp"
+	"strings"
+
+	"github.com/zemzale/ubiquitest/domain/money"
+)
+
+// maxSubtasks is the most subtasks an email can have.
+const maxSubtasks = 100
+
+var (
+	// costPattern matches a cost like $12 or $12.50 on its own.
+	costPattern = regexp.MustCompile(`(?:^|\s)\$(\d+(?:\.\d+)?)(?:\s|$)`)
+	// subtaskPattern matches the lines of a list, which become subtasks.
+	subtaskPattern = regexp.MustCompile(`^-\s+(.+)$`)
+	// replyPrefix matches what mail clients put in front of the subject of
+	// replies and forwards.
+	replyPrefix = regexp.MustCompile(`(?i)^\s*(re|fwd?|aw|wg)\s*:\s*`)
+)
+
+// Parse reads the task from an email. The subject is the title of the task and
+// the lines of the plain text body starting with "-" are its subtasks, the
+// rest of the body is ignored. A $12 in the subject or in a subtask is its
+// cost in the currency, and is taken out of the title.
+func Parse(raw []byte, currency string) (Draft, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return Draft{}, fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		subject = msg.Header.Get("Subject")
+	}
+	for replyPrefix.MatchString(subject) {
+		subject = replyPrefix.ReplaceAllString(subject, "")
+	}
+
+	draft, err := parseDraft(subject, currency)
+	if err != nil {
+		return Draft{}, err
+	}
+
+	if draft.Title == "" {
+		return Draft{}, fmt.Errorf("%w: the subject is empty", ErrInvalidMessage)
+	}
+
+	body, err := textBody(textproto.MIMEHeader(msg.Header), msg.Body)
+	if err != nil {
+		return Draft{}, fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		match := subtaskPattern.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if match == nil {
+			continue
+		}
+
+		subtask, err := parseDraft(match[1], currency)
+		if err != nil {
+			return Draft{}, err
+		}
+
+		if subtask.Title == "" {
+			continue
+		}
+
+		if len(draft.Subtasks) == maxSubtasks {
+			return Draft{}, fmt.Errorf("%w: more than %d subtasks", ErrInvalidMessage, maxSubtasks)
+		}
+
+		draft.Subtasks = append(draft.Subtasks, subtask)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Draft{}, fmt.Errorf("%w: %w", ErrInvalidMessage, err)
+	}
+
+	return draft, nil
+}
+
+func parseDraft(line string, currency string) (Draft, error) {
+	draft := Draft{Cost: money.New(0, currency)}
+	if match := costPattern.FindStringSubmatch(line); match != nil {
+		cost, err := money.ParseDecimal(match[1], currency)
+		if err != nil {
+			return Draft{}, fmt.Errorf("%w: invalid cost %q: %w", ErrInvalidMessage, match[1], err)
+		}
+
+		draft.Cost = cost
+		line = strings.Replace(line, "$"+match[1], "", 1)
+	}
+
+	draft.Title = strings.Join(strings.Fields(line), " ")
+
+	return draft, nil
+}
+
+// textBody returns the first plain text part of the body, or nothing when
+// there is none. Charsets other than UTF-8 are not converted.
+func textBody(header textproto.MIMEHeader, body io.Reader) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		// A missing or broken Content-Type means plain text.
+		mediaType = "text/plain"
+	}
+
+	switch strings.ToLower(header.Get("Content-Transfer-Encoding")) {
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	}
+
+	if mediaType == "text/plain" {
+		content, err := io.ReadAll(body)
+		if err != nil {
+			return "", fmt.Errorf("failed to read body: %w", err)
+		}
+
+		return string(content), nil
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return "", nil
+	}
+
+	parts := multipart.NewReader(body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read part: %w", err)
+		}
+
+		// Attachments are left out, even when they are text.
+		if disposition, _, _ := mime.ParseMediaType(part.Header.Get("Content-Disposition")); disposition == "attachment" {
+			continue
+		}
+
+		text, err := textBody(part.Header, part)
+		if err != nil {
+			return "", err
+		}
+
+		if text != "" {
+			return text, nil
+		}
+	}
+}
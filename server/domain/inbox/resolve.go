@@ -0,0 +1,93 @@
+package inbox
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/users"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Resolve struct {
+	userRepo  *storage.UserRepository
+	taskRepo  *storage.TaksRepository
+	tokenRepo *storage.InboxTokenRepository
+	domain    string
+}
+
+func NewResolve(
+	userRepo *storage.UserRepository,
+	taskRepo *storage.TaksRepository,
+	tokenRepo *storage.InboxTokenRepository,
+	domain string,
+) *Resolve {
+	return &Resolve{userRepo: userRepo, taskRepo: taskRepo, tokenRepo: tokenRepo, domain: domain}
+}
+
+// Run returns who the address belongs to. Addresses on other domains, or
+// without the current token of the user, are unknown.
+func (r *Resolve) Run(address string) (Recipient, error) {
+	local, domain, ok := cutLast(strings.Trim(strings.TrimSpace(address), "<>"), "@")
+	if !ok || !strings.EqualFold(domain, r.domain) {
+		return Recipient{}, fmt.Errorf("%w: %s", ErrUnknownRecipient, address)
+	}
+
+	username, rest, ok := strings.Cut(local, "+")
+	if !ok {
+		return Recipient{}, fmt.Errorf("%w: %s", ErrUnknownRecipient, address)
+	}
+
+	secret, board, hasBoard := strings.Cut(rest, "+")
+	token, err := r.tokenRepo.FindByToken(strings.ToLower(secret))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Recipient{}, fmt.Errorf("%w: %s", ErrUnknownRecipient, address)
+	}
+	if err != nil {
+		return Recipient{}, err
+	}
+
+	user, err := r.userRepo.FindByID(token.UserID)
+	if err != nil {
+		return Recipient{}, err
+	}
+
+	if !strings.EqualFold(user.Username, username) {
+		return Recipient{}, fmt.Errorf("%w: %s", ErrUnknownRecipient, address)
+	}
+
+	recipient := Recipient{User: users.User{ID: user.ID, Username: user.Username}}
+	if !hasBoard {
+		return recipient, nil
+	}
+
+	if recipient.BoardID, err = uuid.Parse(board); err != nil {
+		return Recipient{}, fmt.Errorf("%w: %s", ErrUnknownRecipient, address)
+	}
+
+	record, err := r.taskRepo.Find(recipient.BoardID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return Recipient{}, fmt.Errorf("%w: %s", ErrUnknownRecipient, address)
+	}
+	if err != nil {
+		return Recipient{}, err
+	}
+
+	isBoard := !record.ParentID.Valid || record.ParentID.V == uuid.Nil.String()
+	if !isBoard || record.DeletedAt.Valid || record.ArchivedAt.Valid {
+		return Recipient{}, fmt.Errorf("%w: %s", ErrUnknownRecipient, address)
+	}
+
+	return recipient, nil
+}
+
+func cutLast(s string, sep string) (string, string, bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+
+	return s[:i], s[i+len(sep):], true
+}
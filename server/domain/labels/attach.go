@@ -0,0 +1,106 @@
+package labels
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Attach struct {
+	labelRepo     *storage.LabelRepository
+	taskRepo      *storage.TaksRepository
+	recordHistory *history.Record
+}
+
+func NewAttach(labelRepo *storage.LabelRepository, taskRepo *storage.TaksRepository, recordHistory *history.Record) *Attach {
+	return &Attach{labelRepo: labelRepo, taskRepo: taskRepo, recordHistory: recordHistory}
+}
+
+// Run adds the label to the task and returns all the labels of the task.
+// attached is false when the task already had the label. The task has to be on
+// the board of the label.
+func (a *Attach) Run(taskID uuid.UUID, labelID uuid.UUID, actorID uint) (labelIDs []uuid.UUID, attached bool, err error) {
+	rootID, err := a.taskRepo.FindRootID(taskID.String())
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	label, err := a.labelRepo.Find(labelID.String())
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find label: %w", err)
+	}
+
+	if label.BoardID != rootID {
+		return nil, false, ErrOtherBoard
+	}
+
+	attached, err = a.labelRepo.Attach(taskID.String(), labelID.String())
+	if err != nil {
+		return nil, false, err
+	}
+
+	if attached {
+		change := history.Change{Field: history.FieldLabel, NewValue: labelID.String()}
+		if err := a.recordHistory.Run(taskID, actorID, change); err != nil {
+			log.Println("failed to record history of attached label ", err)
+		}
+	}
+
+	labelIDs, err = listTaskLabels(a.labelRepo, taskID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return labelIDs, attached, nil
+}
+
+type Detach struct {
+	labelRepo     *storage.LabelRepository
+	recordHistory *history.Record
+}
+
+func NewDetach(labelRepo *storage.LabelRepository, recordHistory *history.Record) *Detach {
+	return &Detach{labelRepo: labelRepo, recordHistory: recordHistory}
+}
+
+// Run removes the label from the task and returns the remaining labels of the
+// task. detached is false when the task didn't have the label.
+func (d *Detach) Run(taskID uuid.UUID, labelID uuid.UUID, actorID uint) (labelIDs []uuid.UUID, detached bool, err error) {
+	detached, err = d.labelRepo.Detach(taskID.String(), labelID.String())
+	if err != nil {
+		return nil, false, err
+	}
+
+	if detached {
+		recordDetached(d.recordHistory, taskID, labelID, actorID)
+	}
+
+	labelIDs, err = listTaskLabels(d.labelRepo, taskID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return labelIDs, detached, nil
+}
+
+func listTaskLabels(labelRepo *storage.LabelRepository, taskID uuid.UUID) ([]uuid.UUID, error) {
+	records, err := labelRepo.ListByTask(taskID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task labels: %w", err)
+	}
+
+	return lo.Map(records, func(id string, _ int) uuid.UUID {
+		return uuid.MustParse(id)
+	}), nil
+}
+
+func recordDetached(recordHistory *history.Record, taskID uuid.UUID, labelID uuid.UUID, actorID uint) {
+	change := history.Change{Field: history.FieldLabel, OldValue: labelID.String()}
+	if err := recordHistory.Run(taskID, actorID, change); err != nil {
+		log.Println("failed to record history of detached label ", err)
+	}
+}
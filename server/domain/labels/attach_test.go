@@ -0,0 +1,83 @@
+package labels
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestAttach(t *testing.T) {
+	t.Parallel()
+
+	var (
+		boardID      = uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a50")
+		taskID       = uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a51")
+		otherBoardID = uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a52")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	taskRepo := storage.NewTaskRepository(db)
+	labelRepo := storage.NewLabelRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	for _, task := range []storage.Task{
+		{ID: boardID.String(), Title: "Household", CreatedBy: 1},
+		{ID: taskID.String(), Title: "Buy milk", CreatedBy: 1, ParentID: sql.Null[string]{V: boardID.String(), Valid: true}},
+		{ID: otherBoardID.String(), Title: "Garden", CreatedBy: 1},
+	} {
+		require.NoError(t, taskRepo.Create(task))
+	}
+
+	create := NewCreate(labelRepo, taskRepo)
+	label, err := create.Run(boardID, "groceries", "#22c55e")
+	require.NoError(t, err, "failed to create label")
+	otherLabel, err := create.Run(otherBoardID, "groceries", "#22c55e")
+	require.NoError(t, err, "failed to create label on another board")
+
+	attach := NewAttach(labelRepo, taskRepo, recordHistory)
+
+	labelIDs, attached, err := attach.Run(taskID, label.ID, 1)
+	require.NoError(t, err, "failed to attach label")
+	assert.True(t, attached)
+	assert.Equal(t, []uuid.UUID{label.ID}, labelIDs)
+
+	_, attached, err = attach.Run(taskID, label.ID, 1)
+	require.NoError(t, err, "attaching twice must not fail")
+	assert.False(t, attached)
+
+	_, _, err = attach.Run(taskID, uuid.New(), 1)
+	assert.Error(t, err, "attaching an unknown label must fail")
+
+	_, _, err = attach.Run(taskID, otherLabel.ID, 1)
+	assert.ErrorIs(t, err, ErrOtherBoard)
+
+	boardLabels, err := NewList(labelRepo).Run(boardID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{label.ID}, lo.Map(boardLabels, func(l Label, _ int) uuid.UUID { return l.ID }))
+
+	chores, err := create.Run(boardID, "chores", "#f97316")
+	require.NoError(t, err)
+	_, err = NewUpdate(labelRepo).Run(Label{ID: chores.ID, Name: "groceries", Color: "#f97316"})
+	assert.ErrorIs(t, err, ErrDuplicateName)
+	require.NoError(t, NewDelete(labelRepo, recordHistory).Run(chores.ID, 1))
+
+	require.NoError(t, NewDelete(labelRepo, recordHistory).Run(label.ID, 1), "failed to delete label")
+
+	labelIDs, detached, err := NewDetach(labelRepo, recordHistory).Run(taskID, label.ID, 1)
+	require.NoError(t, err)
+	assert.False(t, detached, "deleting the label must detach it from the task")
+	assert.Empty(t, labelIDs)
+}
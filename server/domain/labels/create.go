@@ -0,0 +1,60 @@
+package labels
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Create struct {
+	labelRepo *storage.LabelRepository
+	taskRepo  *storage.TaksRepository
+}
+
+func NewCreate(labelRepo *storage.LabelRepository, taskRepo *storage.TaksRepository) *Create {
+	return &Create{labelRepo: labelRepo, taskRepo: taskRepo}
+}
+
+// Run creates a label on the board. The board is a top level task.
+func (c *Create) Run(boardID uuid.UUID, name string, color string) (Label, error) {
+	label := Label{ID: uuid.New(), BoardID: boardID, Name: name, Color: color}
+	if err := label.validate(); err != nil {
+		return Label{}, err
+	}
+
+	if err := c.checkBoard(boardID); err != nil {
+		return Label{}, err
+	}
+
+	record := mapLabelToDB(label)
+	if err := checkUniqueName(c.labelRepo, record); err != nil {
+		return Label{}, err
+	}
+
+	// The unique constraint still refuses a label with the same name created
+	// at the same time.
+	if err := c.labelRepo.Create(record); err != nil {
+		return Label{}, fmt.Errorf("failed to create label: %w", err)
+	}
+
+	return mapLabelFromDB(record), nil
+}
+
+func (c *Create) checkBoard(boardID uuid.UUID) error {
+	rootID, err := c.taskRepo.FindRootID(boardID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: board doesn't exist", ErrInvalidLabel)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rootID != boardID.String() {
+		return fmt.Errorf("%w: board must be a top level task", ErrInvalidLabel)
+	}
+
+	return nil
+}
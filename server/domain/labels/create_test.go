@@ -0,0 +1,116 @@
+package labels
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		boardID      = uuid.MustParse("0b6e2d1c-5a4f-4e3b-9c8d-7f6a5b4c3d01")
+		otherBoardID = uuid.MustParse("0b6e2d1c-5a4f-4e3b-9c8d-7f6a5b4c3d02")
+		subtaskID    = uuid.MustParse("0b6e2d1c-5a4f-4e3b-9c8d-7f6a5b4c3d03")
+	)
+
+	tests := []struct {
+		name        string
+		giveBoardID uuid.UUID
+		giveName    string
+		giveColor   string
+		want        Label
+		wantErr     error
+	}{
+		{
+			name:        "create label",
+			giveBoardID: boardID,
+			giveName:    " groceries ",
+			giveColor:   "#22C55E",
+			want:        Label{BoardID: boardID, Name: "groceries", Color: "#22c55e"},
+		},
+		{
+			name:        "empty name",
+			giveBoardID: boardID,
+			giveName:    " ",
+			giveColor:   "#22c55e",
+			wantErr:     ErrInvalidLabel,
+		},
+		{
+			name:        "invalid color",
+			giveBoardID: boardID,
+			giveName:    "groceries",
+			giveColor:   "green",
+			wantErr:     ErrInvalidLabel,
+		},
+		{
+			name:        "duplicate name on the board",
+			giveBoardID: boardID,
+			giveName:    "chores",
+			giveColor:   "#22c55e",
+			wantErr:     ErrDuplicateName,
+		},
+		{
+			name:        "same name on another board",
+			giveBoardID: otherBoardID,
+			giveName:    "chores",
+			giveColor:   "#22c55e",
+			want:        Label{BoardID: otherBoardID, Name: "chores", Color: "#22c55e"},
+		},
+		{
+			name:        "board is not a top level task",
+			giveBoardID: subtaskID,
+			giveName:    "groceries",
+			giveColor:   "#22c55e",
+			wantErr:     ErrInvalidLabel,
+		},
+		{
+			name:        "unknown board",
+			giveBoardID: uuid.New(),
+			giveName:    "groceries",
+			giveColor:   "#22c55e",
+			wantErr:     ErrInvalidLabel,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := sqlx.Open("sqlite3", ":memory:")
+			require.NoError(t, err, "failed to open database")
+			t.Cleanup(func() {
+				db.Close()
+			})
+			require.NoError(t, storage.CreateDB(db))
+
+			taskRepo := storage.NewTaskRepository(db)
+			for _, task := range []storage.Task{
+				{ID: boardID.String(), Title: "Household", CreatedBy: 1},
+				{ID: otherBoardID.String(), Title: "Garden", CreatedBy: 1},
+				{ID: subtaskID.String(), Title: "Kitchen", CreatedBy: 1, ParentID: sql.Null[string]{V: boardID.String(), Valid: true}},
+			} {
+				require.NoError(t, taskRepo.Create(task))
+			}
+
+			create := NewCreate(storage.NewLabelRepository(db), taskRepo)
+			_, err = create.Run(boardID, "chores", "#f97316")
+			require.NoError(t, err, "failed to create existing label")
+
+			got, err := create.Run(tt.giveBoardID, tt.giveName, tt.giveColor)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err, "failed to create label")
+
+			assert.Equal(t, tt.want.BoardID, got.BoardID)
+			assert.Equal(t, tt.want.Name, got.Name)
+			assert.Equal(t, tt.want.Color, got.Color)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package labels
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Delete struct {
+	labelRepo     *storage.LabelRepository
+	recordHistory *history.Record
+}
+
+func NewDelete(labelRepo *storage.LabelRepository, recordHistory *history.Record) *Delete {
+	return &Delete{labelRepo: labelRepo, recordHistory: recordHistory}
+}
+
+// Run deletes the label and removes it from every task that had it.
+func (d *Delete) Run(id uuid.UUID, actorID uint) error {
+	taskIDs, err := d.labelRepo.ListTasks(id.String())
+	if err != nil {
+		return fmt.Errorf("failed to list tasks of label: %w", err)
+	}
+
+	if err := d.labelRepo.Delete(id.String()); err != nil {
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+
+	for _, taskID := range taskIDs {
+		recordDetached(d.recordHistory, uuid.MustParse(taskID), id, actorID)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package labels
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Label struct {
+	ID uuid.UUID
+	// BoardID is the top level task the label belongs to. The label can only
+	// be attached to the tasks of that board.
+	BoardID uuid.UUID
+	Name    string
+	Color   string
+}
+
+var (
+	// ErrInvalidLabel is returned when the label name, color or board is not
+	// valid.
+	ErrInvalidLabel = errors.New("invalid label")
+	// ErrDuplicateName is returned when the board already has a label with
+	// the same name.
+	ErrDuplicateName = errors.New("board already has a label with this name")
+	// ErrOtherBoard is returned when attaching a label to a task that is not
+	// on the board of the label.
+	ErrOtherBoard = errors.New("label belongs to another board")
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func (l Label) validate() error {
+	if strings.TrimSpace(l.Name) == "" {
+		return fmt.Errorf("%w: name can't be empty", ErrInvalidLabel)
+	}
+
+	if !colorPattern.MatchString(l.Color) {
+		return fmt.Errorf("%w: color must be a hex color like #ff0000", ErrInvalidLabel)
+	}
+
+	return nil
+}
+
+// checkUniqueName returns ErrDuplicateName when another label of the board
+// has the same name.
+func checkUniqueName(labelRepo *storage.LabelRepository, record storage.Label) error {
+	existing, err := labelRepo.FindByName(record.BoardID, record.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if existing.ID != record.ID {
+		return fmt.Errorf("%w: %s", ErrDuplicateName, record.Name)
+	}
+
+	return nil
+}
+
+func mapLabelToDB(label Label) storage.Label {
+	return storage.Label{
+		ID:      label.ID.String(),
+		BoardID: label.BoardID.String(),
+		Name:    strings.TrimSpace(label.Name),
+		Color:   strings.ToLower(label.Color),
+	}
+}
+
+func mapLabelFromDB(record storage.Label) Label {
+	return Label{
+		ID:      uuid.MustParse(record.ID),
+		BoardID: uuid.MustParse(record.BoardID),
+		Name:    record.Name,
+		Color:   record.Color,
+	}
+}
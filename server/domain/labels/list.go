@@ -0,0 +1,30 @@
+package labels
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type List struct {
+	labelRepo *storage.LabelRepository
+}
+
+func NewList(labelRepo *storage.LabelRepository) *List {
+	return &List{labelRepo: labelRepo}
+}
+
+// Run returns the labels of the board, or the labels of all the boards when
+// the board ID is uuid.Nil.
+func (l *List) Run(boardID uuid.UUID) ([]Label, error) {
+	records, err := l.labelRepo.List(lo.Ternary(boardID == uuid.Nil, "", boardID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	return lo.Map(records, func(record storage.Label, _ int) Label {
+		return mapLabelFromDB(record)
+	}), nil
+}
@@ -0,0 +1,40 @@
+package labels
+
+import (
+	"fmt"
+
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Update struct {
+	labelRepo *storage.LabelRepository
+}
+
+func NewUpdate(labelRepo *storage.LabelRepository) *Update {
+	return &Update{labelRepo: labelRepo}
+}
+
+// Run changes the name and the color of the label. The label stays on its
+// board.
+func (u *Update) Run(label Label) (Label, error) {
+	if err := label.validate(); err != nil {
+		return Label{}, err
+	}
+
+	existing, err := u.labelRepo.Find(label.ID.String())
+	if err != nil {
+		return Label{}, fmt.Errorf("failed to find label: %w", err)
+	}
+
+	record := mapLabelToDB(label)
+	record.BoardID = existing.BoardID
+	if err := checkUniqueName(u.labelRepo, record); err != nil {
+		return Label{}, err
+	}
+
+	if err := u.labelRepo.Update(record); err != nil {
+		return Label{}, fmt.Errorf("failed to update label: %w", err)
+	}
+
+	return mapLabelFromDB(record), nil
+}
@@ -0,0 +1,146 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrUnknownCurrency is returned for anything that is not an ISO 4217
+	// currency code known to the server.
+	ErrUnknownCurrency = errors.New("unknown currency")
+	// ErrCurrencyMismatch is returned when amounts in different currencies
+	// have to be added up and there is no rate to convert between them.
+	ErrCurrencyMismatch = errors.New("amounts are in different currencies")
+)
+
+// Money is an amount in the minor units of its currency, e.g. cents for EUR,
+// so it's exact and can be negative.
+type Money struct {
+	Amount int64
+	// Currency is an ISO 4217 code, e.g. "EUR".
+	Currency string
+}
+
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+// String formats the amount in major units with the currency, e.g.
+// "12.50 EUR".
+func (m Money) String() string {
+	exponent, err := Exponent(m.Currency)
+	if err != nil || exponent == 0 {
+		return strings.TrimSpace(strconv.FormatInt(m.Amount, 10) + " " + m.Currency)
+	}
+
+	sign, amount := "", m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	unit := pow10(exponent)
+
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/unit, exponent, amount%unit, m.Currency)
+}
+
+// Parse reads an amount formatted by String, e.g. "12.50 EUR".
+func Parse(s string) (Money, error) {
+	amount, currency, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return Money{}, fmt.Errorf("invalid amount %q: missing currency", s)
+	}
+
+	exponent, err := Exponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	major, minor, _ := strings.Cut(amount, ".")
+	if len(minor) != exponent {
+		return Money{}, fmt.Errorf("invalid amount %q: expected %d decimals", s, exponent)
+	}
+
+	negative := strings.HasPrefix(major, "-")
+	units, err := strconv.ParseInt(strings.TrimPrefix(major, "-")+minor, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q: %w", s, err)
+	}
+
+	if negative {
+		units = -units
+	}
+
+	return New(units, currency), nil
+}
+
+// ParseDecimal reads an amount in major units without the currency, e.g.
+// "12.5", rounded half away from zero to the minor units of the currency.
+func ParseDecimal(amount string, currency string) (Money, error) {
+	exponent, err := Exponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok || strings.Contains(amount, "/") {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	value.Mul(value, new(big.Rat).SetInt64(pow10(exponent)))
+
+	return New(round(value), currency), nil
+}
+
+// Exponent returns the number of minor unit digits of the currency, e.g. 2
+// for EUR and 0 for JPY.
+func Exponent(currency string) (int, error) {
+	exponent, ok := currencies[currency]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownCurrency, currency)
+	}
+
+	return exponent, nil
+}
+
+// MinorUnits returns how many minor units make a major one, e.g. 100 for EUR.
+func MinorUnits(currency string) (int64, error) {
+	exponent, err := Exponent(currency)
+	if err != nil {
+		return 0, err
+	}
+
+	return pow10(exponent), nil
+}
+
+func pow10(exponent int) int64 {
+	result := int64(1)
+	for range exponent {
+		result *= 10
+	}
+
+	return result
+}
+
+// currencies are the ISO 4217 codes in use with their minor unit digits.
+var currencies = map[string]int{
+	"AED": 2, "ARS": 2, "AUD": 2, "BDT": 2, "BGN": 2, "BHD": 3, "BIF": 0, "BRL": 2,
+	"CAD": 2, "CHF": 2, "CLP": 0, "CNY": 2, "COP": 2, "CZK": 2, "DJF": 0, "DKK": 2,
+	"EGP": 2, "EUR": 2, "GBP": 2, "GNF": 0, "HKD": 2, "HUF": 2, "IDR": 2, "ILS": 2,
+	"INR": 2, "IQD": 3, "ISK": 0, "JOD": 3, "JPY": 0, "KES": 2, "KMF": 0, "KRW": 0,
+	"KWD": 3, "LKR": 2, "LYD": 3, "MAD": 2, "MXN": 2, "MYR": 2, "NGN": 2, "NOK": 2,
+	"NZD": 2, "OMR": 3, "PEN": 2, "PHP": 2, "PKR": 2, "PLN": 2, "PYG": 0, "QAR": 2,
+	"RON": 2, "RSD": 2, "RWF": 0, "SAR": 2, "SEK": 2, "SGD": 2, "THB": 2, "TND": 3,
+	"TRY": 2, "TWD": 2, "UAH": 2, "UGX": 0, "USD": 2, "UYU": 2, "VND": 0, "VUV": 0,
+	"XAF": 0, "XOF": 0, "XPF": 0, "ZAR": 2,
+}
@@ -0,0 +1,127 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		give Money
+		want string
+	}{
+		{give: New(1250, "EUR"), want: "12.50 EUR"},
+		{give: New(-5, "EUR"), want: "-0.05 EUR"},
+		{give: New(1500, "JPY"), want: "1500 JPY"},
+		{give: New(1001, "KWD"), want: "1.001 KWD"},
+		{give: New(0, "USD"), want: "0.00 USD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.give.String())
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, want := range []Money{New(1250, "EUR"), New(-5, "EUR"), New(1500, "JPY"), New(1001, "KWD"), New(0, "USD")} {
+		got, err := Parse(want.String())
+		require.NoError(t, err, want.String())
+		assert.Equal(t, want, got)
+	}
+
+	for _, give := range []string{"12.50", "12.5 EUR", "12 EUR", "1.00 XYZ", "a.bc EUR"} {
+		_, err := Parse(give)
+		assert.Error(t, err, give)
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		give     string
+		currency string
+		want     Money
+	}{
+		{give: "12.5", currency: "EUR", want: New(1250, "EUR")},
+		{give: " 3 ", currency: "USD", want: New(300, "USD")},
+		{give: "0.005", currency: "EUR", want: New(1, "EUR")},
+		{give: "-0.005", currency: "EUR", want: New(-1, "EUR")},
+		{give: "1500", currency: "JPY", want: New(1500, "JPY")},
+	}
+	for _, tt := range tests {
+		got, err := ParseDecimal(tt.give, tt.currency)
+		require.NoError(t, err, tt.give)
+		assert.Equal(t, tt.want, got, tt.give)
+	}
+
+	for _, give := range []string{"", "abc", "1/3"} {
+		_, err := ParseDecimal(give, "EUR")
+		assert.Error(t, err, give)
+	}
+
+	_, err := ParseDecimal("1", "XYZ")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+}
+
+func TestRates(t *testing.T) {
+	t.Parallel()
+
+	rates, err := NewRates("eur", map[string]string{"USD": "0.9", "jpy": "0.006"})
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", rates.Base())
+
+	tests := []struct {
+		name    string
+		give    Money
+		to      string
+		want    Money
+		wantErr error
+	}{
+		{name: "same currency", give: New(1250, "EUR"), to: "EUR", want: New(1250, "EUR")},
+		{name: "to base", give: New(1000, "USD"), to: "EUR", want: New(900, "EUR")},
+		{name: "from base", give: New(900, "EUR"), to: "USD", want: New(1000, "USD")},
+		{name: "different exponents", give: New(1000, "JPY"), to: "EUR", want: New(600, "EUR")},
+		{name: "rounds half away from zero", give: New(5, "USD"), to: "EUR", want: New(5, "EUR")},
+		{name: "negative is symmetric", give: New(-5, "USD"), to: "EUR", want: New(-5, "EUR")},
+		{name: "between two rates", give: New(100, "USD"), to: "JPY", want: New(150, "JPY")},
+		{name: "no rate", give: New(100, "GBP"), to: "EUR", wantErr: ErrCurrencyMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := rates.Convert(tt.give, tt.to)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	sum, err := rates.Add(New(100, "EUR"), New(-1000, "USD"))
+	require.NoError(t, err)
+	assert.Equal(t, New(-800, "EUR"), sum)
+
+	normalized, err := rates.Normalize(Money{Amount: 3})
+	require.NoError(t, err)
+	assert.Equal(t, New(3, "EUR"), normalized, "missing currency must fall back to the base one")
+
+	_, err = rates.Normalize(New(3, "ABC"))
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+
+	_, err = NewRates("EUR", map[string]string{"USD": "-1"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,116 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Rates convert amounts between currencies. Every rate is the value of one
+// major unit of the currency in the base currency, which is also the currency
+// of amounts that don't have one.
+type Rates struct {
+	base  string
+	rates map[string]*big.Rat
+}
+
+// NewRates parses the rates, which are decimal strings keyed by the currency,
+// e.g. {"USD": "0.92"}. Without a rate amounts in that currency can't be added
+// to amounts in any other.
+func NewRates(base string, rates map[string]string) (*Rates, error) {
+	base = strings.ToUpper(base)
+	if _, err := Exponent(base); err != nil {
+		return nil, fmt.Errorf("invalid base currency: %w", err)
+	}
+
+	parsed := map[string]*big.Rat{base: big.NewRat(1, 1)}
+	for currency, value := range rates {
+		currency = strings.ToUpper(currency)
+		if _, err := Exponent(currency); err != nil {
+			return nil, fmt.Errorf("invalid rate: %w", err)
+		}
+
+		rate, ok := new(big.Rat).SetString(value)
+		if !ok || rate.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid rate %q for %s", value, currency)
+		}
+
+		if currency != base {
+			parsed[currency] = rate
+		}
+	}
+
+	return &Rates{base: base, rates: parsed}, nil
+}
+
+func (r *Rates) Base() string {
+	return r.base
+}
+
+// Normalize upper cases the currency of the amount, falls back to the base
+// currency when it's missing and checks that it's known.
+func (r *Rates) Normalize(m Money) (Money, error) {
+	m.Currency = strings.ToUpper(strings.TrimSpace(m.Currency))
+	if m.Currency == "" {
+		m.Currency = r.base
+	}
+
+	if _, err := Exponent(m.Currency); err != nil {
+		return Money{}, err
+	}
+
+	return m, nil
+}
+
+// Convert returns the amount in the given currency, rounded half away from
+// zero, so converting a negative amount gives exactly the negated result.
+func (r *Rates) Convert(m Money, currency string) (Money, error) {
+	if m.Currency == currency {
+		return m, nil
+	}
+
+	from, okFrom := r.rates[m.Currency]
+	to, okTo := r.rates[currency]
+	if !okFrom || !okTo {
+		return Money{}, fmt.Errorf("%w: no rate from %s to %s", ErrCurrencyMismatch, m.Currency, currency)
+	}
+
+	fromExponent, err := Exponent(m.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	toExponent, err := Exponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	amount := new(big.Rat).SetInt64(m.Amount)
+	amount.Mul(amount, from)
+	amount.Quo(amount, to)
+	amount.Mul(amount, new(big.Rat).SetFrac64(pow10(toExponent), pow10(fromExponent)))
+
+	return Money{Amount: round(amount), Currency: currency}, nil
+}
+
+// Add returns the sum in the currency of a.
+func (r *Rates) Add(a Money, b Money) (Money, error) {
+	converted, err := r.Convert(b, a.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: a.Amount + converted.Amount, Currency: a.Currency}, nil
+}
+
+func round(amount *big.Rat) int64 {
+	abs := new(big.Rat).Abs(amount)
+	// Adding a half and truncating rounds half away from zero.
+	abs.Add(abs, big.NewRat(1, 2))
+	rounded := new(big.Int).Quo(abs.Num(), abs.Denom()).Int64()
+	if amount.Sign() < 0 {
+		return -rounded
+	}
+
+	return rounded
+}
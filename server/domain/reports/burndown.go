@@ -0,0 +1,99 @@
+package reports
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// BurndownPoint is the work that was still open at the end of a bucket.
+type BurndownPoint struct {
+	At        time.Time
+	OpenTasks int
+	// OpenCost is the own cost of all the open tasks in the base currency.
+	OpenCost money.Money
+}
+
+// pastState is the state of a task at some point in the past.
+type pastState struct {
+	created   bool
+	completed bool
+	trashed   bool
+	cost      money.Money
+}
+
+func (s pastState) open() bool {
+	return s.created && !s.completed && !s.trashed
+}
+
+// burndown reconstructs the open tasks at the end of every bucket. It starts
+// from the tasks as they are now and undoes the changes in the history from
+// the newest one back. Tasks without a created entry in the history are
+// counted as if they always existed, purged tasks are not counted at all.
+func burndown(
+	rates *money.Rates,
+	tasks []*storage.Task,
+	entries []storage.HistoryEntry,
+	bucket Bucket,
+	starts []time.Time,
+) ([]BurndownPoint, error) {
+	states := make(map[string]*pastState, len(tasks))
+	for _, task := range tasks {
+		states[task.ID] = &pastState{
+			created:   true,
+			completed: task.Completed,
+			trashed:   task.DeletedAt.Valid,
+			cost:      money.New(task.Cost, task.Currency),
+		}
+	}
+
+	points := make([]BurndownPoint, len(starts))
+	next := len(entries) - 1
+	for i := len(starts) - 1; i >= 0; i-- {
+		at := bucket.next(starts[i])
+		for ; next >= 0 && !entries[next].CreatedAt.Before(at); next-- {
+			if state, ok := states[entries[next].TaskID]; ok {
+				state.undo(entries[next])
+			}
+		}
+
+		point := BurndownPoint{At: at, OpenCost: money.New(0, rates.Base())}
+		for id, state := range states {
+			if !state.open() {
+				continue
+			}
+
+			cost, err := rates.Convert(state.cost, rates.Base())
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert cost of task %s: %w", id, err)
+			}
+
+			point.OpenTasks++
+			point.OpenCost.Amount += cost.Amount
+		}
+		points[i] = point
+	}
+
+	return points, nil
+}
+
+// undo puts the state back to how it was before the change.
+func (s *pastState) undo(entry storage.HistoryEntry) {
+	switch entry.Field {
+	case history.FieldCreated:
+		s.created = false
+	case history.FieldCompleted:
+		s.completed = entry.OldValue == "true"
+	case history.FieldDeletedAt:
+		s.trashed = entry.OldValue != ""
+	case history.FieldCost:
+		// Costs recorded before they had a currency can't be parsed, the
+		// task keeps the cost it has then.
+		if cost, err := money.Parse(entry.OldValue); err == nil {
+			s.cost = cost
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package reports
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// CompletionReport is how many tasks were created and completed in every
+// bucket of the period, with the burndown of the open cost over the same
+// buckets.
+type CompletionReport struct {
+	// Buckets are the starts of the buckets, the counts of the groups and the
+	// burndown are in the same order.
+	Buckets  []time.Time
+	Groups   []CompletionGroup
+	Burndown []BurndownPoint
+}
+
+// CompletionGroup has the created and completed tasks of the group. Tasks
+// aren't created by anyone completing them, so grouped by the completer
+// Created is always 0.
+type CompletionGroup struct {
+	Group
+	Created   []int
+	Completed []int
+}
+
+type Completion struct {
+	taskRepo    *storage.TaksRepository
+	userRepo    *storage.UserRepository
+	historyRepo *storage.HistoryRepository
+	rates       *money.Rates
+}
+
+func NewCompletion(
+	taskRepo *storage.TaksRepository,
+	userRepo *storage.UserRepository,
+	historyRepo *storage.HistoryRepository,
+	rates *money.Rates,
+) *Completion {
+	return &Completion{taskRepo: taskRepo, userRepo: userRepo, historyRepo: historyRepo, rates: rates}
+}
+
+// Run returns the completion report of the period, with the groups in the
+// order of their keys. The counts leave out tasks in the trash, the burndown
+// has them until they were put there.
+func (c *Completion) Run(period Period, groupBy GroupBy) (CompletionReport, error) {
+	starts, err := period.starts()
+	if err != nil {
+		return CompletionReport{}, err
+	}
+
+	tasks, err := c.taskRepo.ListAll()
+	if err != nil {
+		return CompletionReport{}, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	grouper, err := newGrouper(groupBy, tasks, c.userRepo)
+	if err != nil {
+		return CompletionReport{}, err
+	}
+
+	entries, err := c.historyRepo.ListByFields(
+		history.FieldCreated, history.FieldCompleted, history.FieldCost, history.FieldDeletedAt,
+	)
+	if err != nil {
+		return CompletionReport{}, fmt.Errorf("failed to list history: %w", err)
+	}
+
+	report := CompletionReport{Buckets: starts, Groups: make([]CompletionGroup, 0)}
+	end := period.Bucket.next(starts[len(starts)-1])
+	groups := map[string]int{}
+	count := func(task *storage.Task, at time.Time, completed bool) error {
+		bucket := bucketIndex(starts, end, at)
+		key := grouper.key(task)
+		if bucket < 0 || key == "" {
+			return nil
+		}
+
+		i, ok := groups[key]
+		if !ok {
+			group, err := grouper.group(key)
+			if err != nil {
+				return err
+			}
+
+			i = len(report.Groups)
+			groups[key] = i
+			report.Groups = append(report.Groups, CompletionGroup{
+				Group:     group,
+				Created:   make([]int, len(starts)),
+				Completed: make([]int, len(starts)),
+			})
+		}
+
+		if completed {
+			report.Groups[i].Completed[bucket]++
+		} else {
+			report.Groups[i].Created[bucket]++
+		}
+
+		return nil
+	}
+
+	createdAt := map[string]time.Time{}
+	for _, entry := range entries {
+		if entry.Field == history.FieldCreated {
+			createdAt[entry.TaskID] = entry.CreatedAt
+		}
+	}
+
+	for _, task := range tasks {
+		if task.DeletedAt.Valid {
+			continue
+		}
+
+		if at, ok := createdAt[task.ID]; ok && groupBy != GroupByCompleter {
+			if err := count(task, at, false); err != nil {
+				return CompletionReport{}, err
+			}
+		}
+
+		if task.Completed && task.CompletedAt.Valid {
+			if err := count(task, task.CompletedAt.V, true); err != nil {
+				return CompletionReport{}, err
+			}
+		}
+	}
+
+	slices.SortFunc(report.Groups, func(a, b CompletionGroup) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	report.Burndown, err = burndown(c.rates, tasks, entries, period.Bucket, starts)
+	if err != nil {
+		return CompletionReport{}, err
+	}
+
+	return report, nil
+}
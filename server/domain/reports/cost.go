@@ -0,0 +1,114 @@
+package reports
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// CostReport is how much was spent in every bucket of the period, in the
+// base currency. A task is spent when it's completed, so only the own cost of
+// completed tasks is counted, in the bucket it was completed in.
+type CostReport struct {
+	Currency string
+	// Buckets are the starts of the buckets, the costs of the groups are in
+	// the same order.
+	Buckets []time.Time
+	Groups  []CostGroup
+	Total   money.Money
+}
+
+type CostGroup struct {
+	Group
+	Costs []money.Money
+	Total money.Money
+}
+
+type Cost struct {
+	taskRepo *storage.TaksRepository
+	userRepo *storage.UserRepository
+	rates    *money.Rates
+}
+
+func NewCost(taskRepo *storage.TaksRepository, userRepo *storage.UserRepository, rates *money.Rates) *Cost {
+	return &Cost{taskRepo: taskRepo, userRepo: userRepo, rates: rates}
+}
+
+// Run returns the cost report of the period, with the groups that spent the
+// most first. Tasks in the trash are left out.
+func (c *Cost) Run(period Period, groupBy GroupBy) (CostReport, error) {
+	starts, err := period.starts()
+	if err != nil {
+		return CostReport{}, err
+	}
+
+	tasks, err := c.taskRepo.ListNotTrashed()
+	if err != nil {
+		return CostReport{}, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	grouper, err := newGrouper(groupBy, tasks, c.userRepo)
+	if err != nil {
+		return CostReport{}, err
+	}
+
+	report := CostReport{
+		Currency: c.rates.Base(),
+		Buckets:  starts,
+		Groups:   make([]CostGroup, 0),
+		Total:    money.New(0, c.rates.Base()),
+	}
+	end := period.Bucket.next(starts[len(starts)-1])
+	groups := map[string]int{}
+	for _, task := range tasks {
+		if !task.Completed || !task.CompletedAt.Valid {
+			continue
+		}
+
+		bucket := bucketIndex(starts, end, task.CompletedAt.V)
+		key := grouper.key(task)
+		if bucket < 0 || key == "" {
+			continue
+		}
+
+		cost, err := ownCost(c.rates, task)
+		if err != nil {
+			return CostReport{}, err
+		}
+
+		i, ok := groups[key]
+		if !ok {
+			group, err := grouper.group(key)
+			if err != nil {
+				return CostReport{}, err
+			}
+
+			i = len(report.Groups)
+			groups[key] = i
+			report.Groups = append(report.Groups, newCostGroup(group, len(starts), c.rates.Base()))
+		}
+
+		report.Groups[i].Costs[bucket].Amount += cost.Amount
+		report.Groups[i].Total.Amount += cost.Amount
+		report.Total.Amount += cost.Amount
+	}
+
+	slices.SortFunc(report.Groups, func(a, b CostGroup) int {
+		return cmp.Or(cmp.Compare(b.Total.Amount, a.Total.Amount), cmp.Compare(a.Key, b.Key))
+	})
+
+	return report, nil
+}
+
+func newCostGroup(group Group, buckets int, currency string) CostGroup {
+	costs := make([]money.Money, buckets)
+	for i := range costs {
+		costs[i] = money.New(0, currency)
+	}
+
+	return CostGroup{Group: group, Costs: costs, Total: money.New(0, currency)}
+}
@@ -0,0 +1,206 @@
+package reports
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// maxBuckets keeps a report from a wide period with small buckets in check.
+const maxBuckets = 1000
+
+var (
+	// ErrInvalidPeriod is returned when the period ends before it starts or
+	// has too many buckets.
+	ErrInvalidPeriod = errors.New("invalid period")
+	// ErrInvalidBucket is returned for an unknown bucket size.
+	ErrInvalidBucket = errors.New("invalid bucket")
+	// ErrInvalidGroup is returned for an unknown grouping.
+	ErrInvalidGroup = errors.New("invalid group")
+)
+
+// Bucket is the length of time every point of a report covers. Buckets are in
+// UTC and weeks start on Monday.
+type Bucket string
+
+const (
+	BucketDay   Bucket = "day"
+	BucketWeek  Bucket = "week"
+	BucketMonth Bucket = "month"
+)
+
+// start returns the start of the bucket the time falls into.
+func (b Bucket) start(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	switch b {
+	case BucketWeek:
+		weekday := (int(t.UTC().Weekday()) + 6) % 7
+		return time.Date(year, month, day-weekday, 0, 0, 0, 0, time.UTC)
+	case BucketMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// next returns the start of the bucket after the one starting at t.
+func (b Bucket) next(t time.Time) time.Time {
+	switch b {
+	case BucketWeek:
+		return t.AddDate(0, 0, 7)
+	case BucketMonth:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+// GroupBy is what the tasks of a report are grouped by.
+type GroupBy string
+
+const (
+	// GroupByCreator groups the tasks by the user that created them.
+	GroupByCreator GroupBy = "creator"
+	// GroupByCompleter groups the tasks by the user that completed them.
+	GroupByCompleter GroupBy = "completer"
+	// GroupByRoot groups the tasks by the top level task they are under,
+	// which for a top level task is the task itself.
+	GroupByRoot GroupBy = "root"
+)
+
+// Period is the time a report covers, split into buckets. The first bucket
+// starts at the start of the bucket From falls into, the last one is the one
+// To falls into.
+type Period struct {
+	From   time.Time
+	To     time.Time
+	Bucket Bucket
+}
+
+// starts returns the start of every bucket of the period.
+func (p Period) starts() ([]time.Time, error) {
+	switch p.Bucket {
+	case BucketDay, BucketWeek, BucketMonth:
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidBucket, p.Bucket)
+	}
+
+	if !p.To.After(p.From) {
+		return nil, fmt.Errorf("%w: has to end after it starts", ErrInvalidPeriod)
+	}
+
+	starts := make([]time.Time, 0)
+	for start := p.Bucket.start(p.From); start.Before(p.To); start = p.Bucket.next(start) {
+		if len(starts) == maxBuckets {
+			return nil, fmt.Errorf("%w: more than %d buckets", ErrInvalidPeriod, maxBuckets)
+		}
+		starts = append(starts, start)
+	}
+
+	return starts, nil
+}
+
+// bucketIndex returns the index of the bucket the time falls into, or -1
+// when it's outside of the period.
+func bucketIndex(starts []time.Time, end time.Time, t time.Time) int {
+	if t.Before(starts[0]) || !t.Before(end) {
+		return -1
+	}
+
+	return sort.Search(len(starts), func(i int) bool { return starts[i].After(t) }) - 1
+}
+
+// Group is a group of tasks in a report. Key is the ID of the user or the
+// root task and Name their username or title.
+type Group struct {
+	Key  string
+	Name string
+}
+
+// grouper finds the group of every task, looking up the names of the groups
+// only once.
+type grouper struct {
+	groupBy  GroupBy
+	tasks    map[string]*storage.Task
+	userRepo *storage.UserRepository
+	names    map[string]string
+}
+
+func newGrouper(groupBy GroupBy, tasks []*storage.Task, userRepo *storage.UserRepository) (*grouper, error) {
+	switch groupBy {
+	case GroupByCreator, GroupByCompleter, GroupByRoot:
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidGroup, groupBy)
+	}
+
+	byID := make(map[string]*storage.Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
+	}
+
+	return &grouper{groupBy: groupBy, tasks: byID, userRepo: userRepo, names: map[string]string{}}, nil
+}
+
+// key returns the key of the group the task is in, or an empty string when
+// it's in none, like an incomplete task grouped by who completed it.
+func (g *grouper) key(task *storage.Task) string {
+	switch g.groupBy {
+	case GroupByCompleter:
+		if !task.CompletedBy.Valid {
+			return ""
+		}
+		return strconv.FormatUint(uint64(task.CompletedBy.V), 10)
+	case GroupByRoot:
+		for task.ParentID.Valid && task.ParentID.V != uuid.Nil.String() {
+			parent, ok := g.tasks[task.ParentID.V]
+			if !ok {
+				break
+			}
+			task = parent
+		}
+		return task.ID
+	default:
+		return strconv.FormatUint(uint64(task.CreatedBy), 10)
+	}
+}
+
+func (g *grouper) group(key string) (Group, error) {
+	if name, ok := g.names[key]; ok {
+		return Group{Key: key, Name: name}, nil
+	}
+
+	var name string
+	if g.groupBy == GroupByRoot {
+		name = g.tasks[key].Title
+	} else {
+		userID, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return Group{}, fmt.Errorf("invalid user id %q: %w", key, err)
+		}
+
+		user, err := g.userRepo.FindByID(uint(userID))
+		if err != nil {
+			return Group{}, fmt.Errorf("failed to find user: %w", err)
+		}
+		name = user.Username
+	}
+	g.names[key] = name
+
+	return Group{Key: key, Name: name}, nil
+}
+
+// ownCost returns the own cost of the task in the base currency.
+func ownCost(rates *money.Rates, task *storage.Task) (money.Money, error) {
+	cost, err := rates.Convert(money.New(task.Cost, task.Currency), rates.Base())
+	if err != nil {
+		return money.Money{}, fmt.Errorf("failed to convert cost of task %s: %w", task.ID, err)
+	}
+
+	return cost, nil
+}
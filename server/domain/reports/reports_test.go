@@ -0,0 +1,227 @@
+package reports
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+const (
+	rootID    = "5d1c2b3a-4e5f-4a6b-8c7d-9e0f1a2b3c01"
+	paintID   = "5d1c2b3a-4e5f-4a6b-8c7d-9e0f1a2b3c02"
+	tilesID   = "5d1c2b3a-4e5f-4a6b-8c7d-9e0f1a2b3c03"
+	gardenID  = "5d1c2b3a-4e5f-4a6b-8c7d-9e0f1a2b3c04"
+	trashedID = "5d1c2b3a-4e5f-4a6b-8c7d-9e0f1a2b3c05"
+)
+
+func day(d int, hour int) time.Time {
+	return time.Date(2025, 3, d, hour, 0, 0, 0, time.UTC)
+}
+
+// newTestDB creates the tasks of a renovation in March 2025, with weekly
+// buckets starting on Feb 24, Mar 3, 10, 17, 24 and 31:
+//
+//	renovate (alice)
+//	├── paint (alice, 10.00 EUR, completed by bob on Mar 3)
+//	└── tiles (bob, 20.00 USD, completed by alice on Mar 12)
+//	garden (bob, 2.00 EUR raised to 5.00 EUR on Mar 20)
+//	trashed (alice, 1.00 EUR, put in the trash on Mar 25)
+func newTestDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'alice'), (2, 'bob')")
+	require.NoError(t, err, "failed to insert users")
+
+	taskRepo := storage.NewTaskRepository(db)
+	top := sql.Null[string]{V: "00000000-0000-0000-0000-000000000000", Valid: true}
+	under := sql.Null[string]{V: rootID, Valid: true}
+	for _, task := range []storage.Task{
+		{ID: rootID, Title: "Renovate", CreatedBy: 1, ParentID: top, Currency: "EUR"},
+		{ID: paintID, Title: "Paint", CreatedBy: 1, ParentID: under, Cost: 1000, Currency: "EUR"},
+		{ID: tilesID, Title: "Tiles", CreatedBy: 2, ParentID: under, Cost: 2000, Currency: "USD"},
+		{ID: gardenID, Title: "Garden", CreatedBy: 2, ParentID: top, Cost: 500, Currency: "EUR"},
+		{ID: trashedID, Title: "Trashed", CreatedBy: 1, ParentID: top, Cost: 100, Currency: "EUR"},
+	} {
+		require.NoError(t, taskRepo.Create(task))
+	}
+
+	complete := "UPDATE tasks SET completed = true, completed_by = ?, completed_at = ? WHERE id = ?"
+	_, err = db.Exec(complete, 2, day(3, 10), paintID)
+	require.NoError(t, err)
+	_, err = db.Exec(complete, 1, day(12, 10), tilesID)
+	require.NoError(t, err)
+	_, err = taskRepo.SoftDeleteSubtree(trashedID, day(25, 10))
+	require.NoError(t, err)
+
+	entries := []storage.HistoryEntry{
+		{TaskID: rootID, ActorID: 1, Field: history.FieldCreated, NewValue: "Renovate", CreatedAt: day(1, 9)},
+		{TaskID: paintID, ActorID: 1, Field: history.FieldCreated, NewValue: "Paint", CreatedAt: day(1, 9)},
+		{TaskID: tilesID, ActorID: 2, Field: history.FieldCreated, NewValue: "Tiles", CreatedAt: day(1, 9)},
+		{TaskID: gardenID, ActorID: 2, Field: history.FieldCreated, NewValue: "Garden", CreatedAt: day(1, 9)},
+		{TaskID: trashedID, ActorID: 1, Field: history.FieldCreated, NewValue: "Trashed", CreatedAt: day(1, 9)},
+		{TaskID: paintID, ActorID: 2, Field: history.FieldCompleted, OldValue: "false", NewValue: "true", CreatedAt: day(3, 10)},
+		{TaskID: tilesID, ActorID: 1, Field: history.FieldCompleted, OldValue: "false", NewValue: "true", CreatedAt: day(12, 10)},
+		{TaskID: gardenID, ActorID: 2, Field: history.FieldCost, OldValue: "2.00 EUR", NewValue: "5.00 EUR", CreatedAt: day(20, 10)},
+		{TaskID: trashedID, ActorID: 1, Field: history.FieldDeletedAt, NewValue: day(25, 10).Format(time.RFC3339), CreatedAt: day(25, 10)},
+	}
+	require.NoError(t, storage.NewHistoryRepository(db).Create(entries))
+
+	return db
+}
+
+func testRates(t *testing.T) *money.Rates {
+	t.Helper()
+
+	rates, err := money.NewRates("EUR", map[string]string{"USD": "0.5"})
+	require.NoError(t, err, "failed to create rates")
+
+	return rates
+}
+
+func TestCost(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	cost := NewCost(storage.NewTaskRepository(db), storage.NewUserRepository(db), testRates(t))
+	march := Period{From: day(1, 0), To: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), Bucket: BucketWeek}
+
+	eur := func(amounts ...int64) []money.Money {
+		costs := make([]money.Money, len(amounts))
+		for i, amount := range amounts {
+			costs[i] = money.New(amount, "EUR")
+		}
+		return costs
+	}
+
+	tests := []struct {
+		name    string
+		period  Period
+		groupBy GroupBy
+		want    []CostGroup
+		wantErr error
+	}{
+		{
+			name:    "by creator",
+			period:  march,
+			groupBy: GroupByCreator,
+			want: []CostGroup{
+				{Group: Group{Key: "1", Name: "alice"}, Costs: eur(0, 1000, 0, 0, 0, 0), Total: money.New(1000, "EUR")},
+				{Group: Group{Key: "2", Name: "bob"}, Costs: eur(0, 0, 1000, 0, 0, 0), Total: money.New(1000, "EUR")},
+			},
+		},
+		{
+			name:    "by completer",
+			period:  march,
+			groupBy: GroupByCompleter,
+			want: []CostGroup{
+				{Group: Group{Key: "1", Name: "alice"}, Costs: eur(0, 0, 1000, 0, 0, 0), Total: money.New(1000, "EUR")},
+				{Group: Group{Key: "2", Name: "bob"}, Costs: eur(0, 1000, 0, 0, 0, 0), Total: money.New(1000, "EUR")},
+			},
+		},
+		{
+			name:    "by root in months",
+			period:  Period{From: day(1, 0), To: day(31, 0), Bucket: BucketMonth},
+			groupBy: GroupByRoot,
+			want: []CostGroup{
+				{Group: Group{Key: rootID, Name: "Renovate"}, Costs: eur(2000), Total: money.New(2000, "EUR")},
+			},
+		},
+		{
+			name:    "completed outside of the period",
+			period:  Period{From: day(4, 0), To: day(12, 0), Bucket: BucketDay},
+			groupBy: GroupByCreator,
+			want:    []CostGroup{},
+		},
+		{
+			name:    "unknown bucket",
+			period:  Period{From: day(1, 0), To: day(31, 0), Bucket: "year"},
+			groupBy: GroupByCreator,
+			wantErr: ErrInvalidBucket,
+		},
+		{
+			name:    "unknown group",
+			period:  march,
+			groupBy: "label",
+			wantErr: ErrInvalidGroup,
+		},
+		{
+			name:    "ends before it starts",
+			period:  Period{From: day(31, 0), To: day(1, 0), Bucket: BucketDay},
+			groupBy: GroupByCreator,
+			wantErr: ErrInvalidPeriod,
+		},
+		{
+			name:    "too many buckets",
+			period:  Period{From: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), To: day(1, 0), Bucket: BucketDay},
+			groupBy: GroupByCreator,
+			wantErr: ErrInvalidPeriod,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			report, err := cost.Run(tt.period, tt.groupBy)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "EUR", report.Currency)
+			assert.Equal(t, tt.want, report.Groups)
+		})
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	completion := NewCompletion(
+		storage.NewTaskRepository(db),
+		storage.NewUserRepository(db),
+		storage.NewHistoryRepository(db),
+		testRates(t),
+	)
+
+	report, err := completion.Run(Period{From: day(1, 0), To: day(31, 12), Bucket: BucketWeek}, GroupByCreator)
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2025, 2, 24, 0, 0, 0, 0, time.UTC), report.Buckets[0], "weeks start on Monday")
+	assert.Equal(t, []CompletionGroup{
+		{Group: Group{Key: "1", Name: "alice"}, Created: []int{2, 0, 0, 0, 0, 0}, Completed: []int{0, 1, 0, 0, 0, 0}},
+		{Group: Group{Key: "2", Name: "bob"}, Created: []int{2, 0, 0, 0, 0, 0}, Completed: []int{0, 0, 1, 0, 0, 0}},
+	}, report.Groups, "the trashed task is left out")
+
+	assert.Equal(t, []BurndownPoint{
+		{At: day(3, 0), OpenTasks: 5, OpenCost: money.New(1000+1000+200+100, "EUR")},
+		{At: day(10, 0), OpenTasks: 4, OpenCost: money.New(1000+200+100, "EUR")},
+		{At: day(17, 0), OpenTasks: 3, OpenCost: money.New(200+100, "EUR")},
+		{At: day(24, 0), OpenTasks: 3, OpenCost: money.New(500+100, "EUR")},
+		{At: day(31, 0), OpenTasks: 2, OpenCost: money.New(500, "EUR")},
+		{At: time.Date(2025, 4, 7, 0, 0, 0, 0, time.UTC), OpenTasks: 2, OpenCost: money.New(500, "EUR")},
+	}, report.Burndown)
+
+	report, err = completion.Run(Period{From: day(1, 0), To: day(31, 0), Bucket: BucketMonth}, GroupByCompleter)
+	require.NoError(t, err)
+	assert.Equal(t, []CompletionGroup{
+		{Group: Group{Key: "1", Name: "alice"}, Created: []int{0}, Completed: []int{1}},
+		{Group: Group{Key: "2", Name: "bob"}, Created: []int{0}, Completed: []int{1}},
+	}, report.Groups)
+}
@@ -0,0 +1,314 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+var (
+	// ErrArchived is returned when archiving or deleting an archived task.
+	ErrArchived = errors.New("task is archived")
+	// ErrNotArchived is returned when unarchiving a task that is not archived.
+	ErrNotArchived = errors.New("task is not archived")
+	// ErrParentArchived is returned when unarchiving a task whose parent is
+	// still archived, the parent has to be unarchived first.
+	ErrParentArchived = errors.New("parent of the task is archived")
+)
+
+// Archived is a task that was archived together with its subtasks.
+type Archived struct {
+	Task Task
+	// IDs are the task and all of its subtasks that were archived.
+	IDs []uuid.UUID
+}
+
+type Archive struct {
+	updateParentCost *UpdateParentCost
+	taskRepo         *storage.TaksRepository
+	recordHistory    *history.Record
+	// rollUpArchived keeps the cost and logged time of archived tasks in their
+	// ancestors.
+	rollUpArchived bool
+}
+
+func NewArchive(
+	updateParentCost *UpdateParentCost,
+	taskRepo *storage.TaksRepository,
+	recordHistory *history.Record,
+	rollUpArchived bool,
+) *Archive {
+	return &Archive{
+		updateParentCost: updateParentCost,
+		taskRepo:         taskRepo,
+		recordHistory:    recordHistory,
+		rollUpArchived:   rollUpArchived,
+	}
+}
+
+// Run archives the task and all of its subtasks.
+func (a *Archive) Run(id uuid.UUID, actorID uint) (Archived, error) {
+	record, err := a.taskRepo.Find(id.String())
+	if err != nil {
+		return Archived{}, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	if record.DeletedAt.Valid {
+		return Archived{}, ErrTrashed
+	}
+
+	if record.ArchivedAt.Valid {
+		return Archived{}, ErrArchived
+	}
+
+	archived, err := a.archive([]*storage.Task{record}, actorID)
+	if err != nil {
+		return Archived{}, err
+	}
+
+	return archived[0], nil
+}
+
+// archive archives the subtrees of the tasks, none of which may be in the
+// subtree of another.
+func (a *Archive) archive(records []*storage.Task, actorID uint) ([]Archived, error) {
+	now := time.Now().UTC()
+	archived := make([]Archived, 0, len(records))
+	for _, record := range records {
+		ids, err := a.taskRepo.ArchiveSubtree(record.ID, now)
+		if err != nil {
+			return archived, fmt.Errorf("failed to archive task: %w", err)
+		}
+
+		task := mapNewTaskFromDB(*record)
+		task.ArchivedAt = now
+		if !a.rollUpArchived {
+			if err := a.updateParentCost.Subtract(task.ParentID, task.Cost); err != nil {
+				return archived, fmt.Errorf("failed to update parent cost: %w", err)
+			}
+
+			if err := a.taskRepo.AddLoggedTime(task.ParentID.String(), -record.TotalLogged); err != nil {
+				return archived, fmt.Errorf("failed to update parent logged time: %w", err)
+			}
+		}
+
+		archivedIDs := lo.Map(ids, func(id string, _ int) uuid.UUID { return uuid.MustParse(id) })
+		for _, archivedID := range archivedIDs {
+			change := history.Change{Field: history.FieldArchivedAt, NewValue: now.Format(time.RFC3339)}
+			if err := a.recordHistory.Run(archivedID, actorID, change); err != nil {
+				log.Println("failed to record history of archived task ", err)
+			}
+		}
+
+		archived = append(archived, Archived{Task: task, IDs: archivedIDs})
+	}
+
+	return archived, nil
+}
+
+type Unarchive struct {
+	updateParentCost *UpdateParentCost
+	taskRepo         *storage.TaksRepository
+	assigneeRepo     *storage.AssigneeRepository
+	recordHistory    *history.Record
+	rollUpArchived   bool
+}
+
+func NewUnarchive(
+	updateParentCost *UpdateParentCost,
+	taskRepo *storage.TaksRepository,
+	assigneeRepo *storage.AssigneeRepository,
+	recordHistory *history.Record,
+	rollUpArchived bool,
+) *Unarchive {
+	return &Unarchive{
+		updateParentCost: updateParentCost,
+		taskRepo:         taskRepo,
+		assigneeRepo:     assigneeRepo,
+		recordHistory:    recordHistory,
+		rollUpArchived:   rollUpArchived,
+	}
+}
+
+// Run unarchives the task and the subtasks that were archived together with
+// it and returns them, the task first.
+func (u *Unarchive) Run(id uuid.UUID, actorID uint) ([]Task, error) {
+	record, err := u.taskRepo.Find(id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	if record.DeletedAt.Valid {
+		return nil, ErrTrashed
+	}
+
+	if !record.ArchivedAt.Valid {
+		return nil, ErrNotArchived
+	}
+
+	task := mapNewTaskFromDB(*record)
+	if task.ParentID != uuid.Nil {
+		if err := u.taskRepo.CheckIfParentExists(task.ParentID.String()); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrParentArchived, err)
+		}
+	}
+
+	ids, err := u.taskRepo.UnarchiveSubtree(record.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unarchive task: %w", err)
+	}
+
+	if !u.rollUpArchived {
+		if err := u.updateParentCost.Run(task.ParentID, task.Cost); err != nil {
+			return nil, fmt.Errorf("failed to update parent cost: %w", err)
+		}
+
+		if err := u.taskRepo.AddLoggedTime(task.ParentID.String(), record.TotalLogged); err != nil {
+			return nil, fmt.Errorf("failed to update parent logged time: %w", err)
+		}
+	}
+
+	unarchived := make([]Task, 0, len(ids))
+	for _, unarchivedID := range ids {
+		unarchivedTask, err := findWithAssignees(u.taskRepo, u.assigneeRepo, uuid.MustParse(unarchivedID))
+		if err != nil {
+			return nil, err
+		}
+
+		change := history.Change{Field: history.FieldArchivedAt, OldValue: task.ArchivedAt.Format(time.RFC3339)}
+		if err := u.recordHistory.Run(unarchivedTask.ID, actorID, change); err != nil {
+			log.Println("failed to record history of unarchived task ", err)
+		}
+
+		unarchived = append(unarchived, unarchivedTask)
+	}
+
+	return unarchived, nil
+}
+
+type ArchiveCompleted struct {
+	archive  *Archive
+	taskRepo *storage.TaksRepository
+}
+
+func NewArchiveCompleted(archive *Archive, taskRepo *storage.TaksRepository) *ArchiveCompleted {
+	return &ArchiveCompleted{archive: archive, taskRepo: taskRepo}
+}
+
+// Run archives the subtrees of the tasks that were completed before the given
+// time. Subtrees that still have incomplete tasks are left alone, so work that
+// isn't done doesn't disappear from the boards. A completed task under another
+// one that is archived now goes with the subtree of its ancestor.
+func (a *ArchiveCompleted) Run(before time.Time) ([]Archived, error) {
+	records, err := a.taskRepo.ListCompletedBefore(before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list completed tasks: %w", err)
+	}
+
+	done := make(map[string]bool, len(records))
+	for _, record := range records {
+		incomplete, err := a.taskRepo.HasIncompleteSubtasks(record.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check subtasks: %w", err)
+		}
+		done[record.ID] = !incomplete
+	}
+
+	roots := make([]*storage.Task, 0, len(records))
+	for _, record := range records {
+		if !done[record.ID] || a.hasDoneAncestor(record, done) {
+			continue
+		}
+		roots = append(roots, record)
+	}
+
+	return a.archive.archive(roots, 0)
+}
+
+// hasDoneAncestor reports whether any ancestor of the task is archived now
+// together with its subtree.
+func (a *ArchiveCompleted) hasDoneAncestor(record *storage.Task, done map[string]bool) bool {
+	parentID := record.ParentID
+	for parentID.Valid && parentID.V != uuid.Nil.String() {
+		if done[parentID.V] {
+			return true
+		}
+
+		parent, err := a.taskRepo.Find(parentID.V)
+		if err != nil {
+			return false
+		}
+		parentID = parent.ParentID
+	}
+
+	return false
+}
+
+// Archiver runs ArchiveCompleted periodically for everything that has been
+// completed for longer than the configured time and delivers what it archived.
+type Archiver struct {
+	archiveCompleted *ArchiveCompleted
+	after            time.Duration
+	pollInterval     time.Duration
+
+	archived chan Archived
+}
+
+// NewArchiver creates an archiver, if after is zero nothing is archived
+// automatically.
+func NewArchiver(archiveCompleted *ArchiveCompleted, after time.Duration, pollInterval time.Duration) *Archiver {
+	return &Archiver{
+		archiveCompleted: archiveCompleted,
+		after:            after,
+		pollInterval:     pollInterval,
+		archived:         make(chan Archived),
+	}
+}
+
+// Archived returns the channel the archived subtrees are delivered on. Run
+// blocks until they are received.
+func (a *Archiver) Archived() <-chan Archived {
+	return a.archived
+}
+
+// Run blocks until the context is cancelled.
+func (a *Archiver) Run(ctx context.Context) {
+	if a.after == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(a.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		a.fire(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *Archiver) fire(ctx context.Context) {
+	archived, err := a.archiveCompleted.Run(time.Now().Add(-a.after))
+	if err != nil {
+		log.Println("failed to archive completed tasks ", err)
+	}
+
+	for _, subtree := range archived {
+		select {
+		case <-ctx.Done():
+			return
+		case a.archived <- subtree:
+		}
+	}
+}
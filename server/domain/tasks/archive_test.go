@@ -0,0 +1,178 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestArchive(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID       = uuid.MustParse("3c2b1a09-8f7e-4d6c-9b5a-493827160501")
+		parentID     = uuid.MustParse("3c2b1a09-8f7e-4d6c-9b5a-493827160502")
+		childID      = uuid.MustParse("3c2b1a09-8f7e-4d6c-9b5a-493827160503")
+		recentlyDone = uuid.MustParse("3c2b1a09-8f7e-4d6c-9b5a-493827160504")
+	)
+
+	tests := []struct {
+		name           string
+		rollUpArchived bool
+		// rootCost is the total cost of the root while the subtree is archived.
+		rootCost int64
+	}{
+		{name: "archived cost is rolled up", rollUpArchived: true, rootCost: 15},
+		{name: "archived cost is left out", rollUpArchived: false, rootCost: 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, err := sqlx.Open("sqlite3", ":memory:")
+			require.NoError(t, err, "failed to open database")
+			t.Cleanup(func() {
+				db.Close()
+			})
+			require.NoError(t, storage.CreateDB(db))
+
+			_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+			require.NoError(t, err, "failed to insert user")
+
+			taskRepo := storage.NewTaskRepository(db)
+			assigneeRepo := storage.NewAssigneeRepository(db)
+			recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+			updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+			store := NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, testRates(t))
+			update := NewUpdate(updateParentCost, taskRepo, storage.NewDependencyRepository(db), NewCreateNextOccurrence(store, taskRepo, assigneeRepo, recordHistory), recordHistory, testRates(t))
+			list := NewList(db, taskRepo, assigneeRepo, storage.NewLabelRepository(db), storage.NewAttachmentRepository(db), storage.NewDependencyRepository(db), NewRollUp(taskRepo, DefaultMetrics(testRates(t)), tt.rollUpArchived))
+			archive := NewArchive(updateParentCost, taskRepo, recordHistory, tt.rollUpArchived)
+			unarchive := NewUnarchive(updateParentCost, taskRepo, assigneeRepo, recordHistory, tt.rollUpArchived)
+
+			// root(1) -> parent(2) -> child(4), root -> recentlyDone(8)
+			for _, task := range []Task{
+				{ID: rootID, Title: "Renovate", CreatedBy: 1, Cost: money.New(1, "EUR")},
+				{ID: parentID, Title: "Paint", CreatedBy: 1, ParentID: rootID, Cost: money.New(2, "EUR")},
+				{ID: childID, Title: "Buy paint", CreatedBy: 1, ParentID: parentID, Cost: money.New(4, "EUR")},
+				{ID: recentlyDone, Title: "Tiles", CreatedBy: 1, ParentID: rootID, Cost: money.New(8, "EUR")},
+			} {
+				require.NoError(t, store.Run(task))
+			}
+
+			for _, id := range []uuid.UUID{parentID, childID, recentlyDone} {
+				_, err := update.Run(Change{ID: id, Title: "Done", Completed: true}, 1)
+				require.NoError(t, err)
+			}
+			_, err = db.Exec("UPDATE tasks SET completed_at = ? WHERE id IN (?, ?)",
+				time.Now().Add(-48*time.Hour).UTC(), parentID.String(), childID.String())
+			require.NoError(t, err)
+
+			totalCost := func(id uuid.UUID) int64 {
+				record, err := taskRepo.Find(id.String())
+				require.NoError(t, err)
+
+				return record.TotalCost
+			}
+			listed := func(archived bool) []uuid.UUID {
+				taskList, err := list.Run(ListFilter{Archived: archived})
+				require.NoError(t, err)
+
+				return lo.Map(taskList, func(task Task, _ int) uuid.UUID { return task.ID })
+			}
+
+			archived, err := NewArchiveCompleted(archive, taskRepo).Run(time.Now().Add(-24 * time.Hour))
+			require.NoError(t, err)
+			require.Len(t, archived, 1, "the completed child goes with its completed parent")
+			assert.Equal(t, parentID, archived[0].Task.ID)
+			assert.ElementsMatch(t, []uuid.UUID{parentID, childID}, archived[0].IDs)
+
+			assert.ElementsMatch(t, []uuid.UUID{rootID, recentlyDone}, listed(false))
+			assert.ElementsMatch(t, []uuid.UUID{parentID, childID}, listed(true))
+			assert.Equal(t, tt.rootCost, totalCost(rootID))
+
+			rollups, err := NewRollUp(taskRepo, DefaultMetrics(testRates(t)), tt.rollUpArchived).Run()
+			require.NoError(t, err)
+			assert.Equal(t, money.New(tt.rootCost, "EUR"), rollups[rootID].Cost)
+			assert.Equal(t, money.New(6, "EUR"), rollups[parentID].Cost, "the archived subtree still rolls up on its own")
+
+			_, _, err = NewDelete(updateParentCost, taskRepo, recordHistory).Run(parentID, 1)
+			assert.ErrorIs(t, err, ErrArchived)
+
+			_, err = unarchive.Run(childID, 1)
+			assert.ErrorIs(t, err, ErrParentArchived)
+
+			_, err = archive.Run(recentlyDone, 1)
+			require.NoError(t, err)
+			_, err = unarchive.Run(recentlyDone, 1)
+			require.NoError(t, err)
+
+			unarchived, err := unarchive.Run(parentID, 1)
+			require.NoError(t, err)
+			assert.Equal(t, []uuid.UUID{parentID, childID}, lo.Map(unarchived, func(task Task, _ int) uuid.UUID { return task.ID }))
+			assert.Equal(t, int64(15), totalCost(rootID))
+			assert.Empty(t, listed(true))
+		})
+	}
+}
+
+func TestArchiveCompletedKeepsOpenWork(t *testing.T) {
+	t.Parallel()
+
+	var (
+		parentID    = uuid.MustParse("3c2b1a09-8f7e-4d6c-9b5a-493827160511")
+		openID      = uuid.MustParse("3c2b1a09-8f7e-4d6c-9b5a-493827160512")
+		doneID      = uuid.MustParse("3c2b1a09-8f7e-4d6c-9b5a-493827160513")
+		doneChildID = uuid.MustParse("3c2b1a09-8f7e-4d6c-9b5a-493827160514")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+	require.NoError(t, err, "failed to insert user")
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, testRates(t))
+	archive := NewArchive(updateParentCost, taskRepo, recordHistory, true)
+
+	// parent(done) -> open, parent -> done(done) -> doneChild(done)
+	for _, task := range []Task{
+		{ID: parentID, Title: "Renovate", CreatedBy: 1, Completed: true},
+		{ID: openID, Title: "Paint", CreatedBy: 1, ParentID: parentID},
+		{ID: doneID, Title: "Tiles", CreatedBy: 1, ParentID: parentID, Completed: true},
+		{ID: doneChildID, Title: "Grout", CreatedBy: 1, ParentID: doneID, Completed: true},
+	} {
+		require.NoError(t, store.Run(task))
+	}
+	_, err = db.Exec("UPDATE tasks SET completed_at = ? WHERE completed = true", time.Now().Add(-48*time.Hour).UTC())
+	require.NoError(t, err)
+
+	archived, err := NewArchiveCompleted(archive, taskRepo).Run(time.Now().Add(-24 * time.Hour))
+	require.NoError(t, err)
+	require.Len(t, archived, 1, "the parent with an incomplete subtask must not be archived")
+	assert.Equal(t, doneID, archived[0].Task.ID)
+	assert.ElementsMatch(t, []uuid.UUID{doneID, doneChildID}, archived[0].IDs)
+
+	for _, id := range []uuid.UUID{parentID, openID} {
+		record, err := taskRepo.Find(id.String())
+		require.NoError(t, err)
+		assert.False(t, record.ArchivedAt.Valid)
+	}
+}
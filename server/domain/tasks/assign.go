@@ -0,0 +1,119 @@
+package tasks
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Assign struct {
+	taskRepo      *storage.TaksRepository
+	assigneeRepo  *storage.AssigneeRepository
+	userRepo      *storage.UserRepository
+	recordHistory *history.Record
+}
+
+func NewAssign(
+	taskRepo *storage.TaksRepository,
+	assigneeRepo *storage.AssigneeRepository,
+	userRepo *storage.UserRepository,
+	recordHistory *history.Record,
+) *Assign {
+	return &Assign{taskRepo: taskRepo, assigneeRepo: assigneeRepo, userRepo: userRepo, recordHistory: recordHistory}
+}
+
+// Run assigns the user to the task and returns the task with its assignees.
+// assigned is false when the user was already assigned, so callers don't
+// notify them twice.
+func (a *Assign) Run(taskID uuid.UUID, userID uint, assignedBy uint) (task Task, assigned bool, err error) {
+	if _, err := a.userRepo.Exists(userID); err != nil {
+		return Task{}, false, fmt.Errorf("user doesn't exist: %w", err)
+	}
+
+	if _, err := a.taskRepo.Find(taskID.String()); err != nil {
+		return Task{}, false, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	assigned, err = a.assigneeRepo.Create(storage.Assignee{
+		TaskID:     taskID.String(),
+		UserID:     userID,
+		AssignedBy: assignedBy,
+		AssignedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return Task{}, false, fmt.Errorf("failed to assign user: %w", err)
+	}
+
+	if assigned {
+		change := history.Change{Field: history.FieldAssignee, NewValue: strconv.FormatUint(uint64(userID), 10)}
+		if err := a.recordHistory.Run(taskID, assignedBy, change); err != nil {
+			log.Println("failed to record history of assignment ", err)
+		}
+	}
+
+	task, err = findWithAssignees(a.taskRepo, a.assigneeRepo, taskID)
+	if err != nil {
+		return Task{}, false, err
+	}
+
+	return task, assigned, nil
+}
+
+type Unassign struct {
+	taskRepo      *storage.TaksRepository
+	assigneeRepo  *storage.AssigneeRepository
+	recordHistory *history.Record
+}
+
+func NewUnassign(
+	taskRepo *storage.TaksRepository,
+	assigneeRepo *storage.AssigneeRepository,
+	recordHistory *history.Record,
+) *Unassign {
+	return &Unassign{taskRepo: taskRepo, assigneeRepo: assigneeRepo, recordHistory: recordHistory}
+}
+
+// Run unassigns the user from the task and returns the task with its
+// remaining assignees. unassigned is false when the user wasn't assigned.
+func (u *Unassign) Run(taskID uuid.UUID, userID uint, unassignedBy uint) (task Task, unassigned bool, err error) {
+	unassigned, err = u.assigneeRepo.Delete(taskID.String(), userID)
+	if err != nil {
+		return Task{}, false, fmt.Errorf("failed to unassign user: %w", err)
+	}
+
+	if unassigned {
+		change := history.Change{Field: history.FieldAssignee, OldValue: strconv.FormatUint(uint64(userID), 10)}
+		if err := u.recordHistory.Run(taskID, unassignedBy, change); err != nil {
+			log.Println("failed to record history of unassignment ", err)
+		}
+	}
+
+	task, err = findWithAssignees(u.taskRepo, u.assigneeRepo, taskID)
+	if err != nil {
+		return Task{}, false, err
+	}
+
+	return task, unassigned, nil
+}
+
+func findWithAssignees(taskRepo *storage.TaksRepository, assigneeRepo *storage.AssigneeRepository, taskID uuid.UUID) (Task, error) {
+	record, err := taskRepo.Find(taskID.String())
+	if err != nil {
+		return Task{}, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	assignees, err := assigneeRepo.ListByTask(record.ID)
+	if err != nil {
+		return Task{}, fmt.Errorf("failed to list assignees: %w", err)
+	}
+
+	task := mapNewTaskFromDB(*record)
+	task.Assignees = assignees
+
+	return task, nil
+}
@@ -0,0 +1,69 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestAssign(t *testing.T) {
+	t.Parallel()
+
+	var (
+		taskID  = uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a51")
+		otherID = uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a52")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	for _, username := range []string{"alice", "bob"} {
+		_, err = db.Exec("INSERT INTO users (username) VALUES (?)", username)
+		require.NoError(t, err, "failed to insert user")
+	}
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	userRepo := storage.NewUserRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	store := NewStore(NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t)), taskRepo, userRepo, assigneeRepo, recordHistory, testRates(t))
+
+	require.NoError(t, store.Run(Task{ID: taskID, Title: "Mow the lawn", CreatedBy: 1}))
+	require.NoError(t, store.Run(Task{ID: otherID, Title: "Water the plants", CreatedBy: 1}))
+
+	assign := NewAssign(taskRepo, assigneeRepo, userRepo, recordHistory)
+
+	task, assigned, err := assign.Run(taskID, 2, 1)
+	require.NoError(t, err, "failed to assign")
+	assert.True(t, assigned)
+	assert.Equal(t, []uint{2}, task.Assignees)
+	assert.Equal(t, []uint{1, 2}, task.Recipients())
+
+	_, assigned, err = assign.Run(taskID, 2, 1)
+	require.NoError(t, err, "assigning twice must not fail")
+	assert.False(t, assigned, "assigning twice must not be reported as a new assignment")
+
+	_, _, err = assign.Run(taskID, 42, 1)
+	assert.Error(t, err, "assigning an unknown user must fail")
+
+	list := NewList(db, taskRepo, assigneeRepo, storage.NewLabelRepository(db), storage.NewAttachmentRepository(db), storage.NewDependencyRepository(db), NewRollUp(taskRepo, DefaultMetrics(testRates(t)), true))
+	assignedToBob, err := list.Run(ListFilter{AssigneeID: 2})
+	require.NoError(t, err, "failed to list tasks")
+	require.Len(t, assignedToBob, 1)
+	assert.Equal(t, taskID, assignedToBob[0].ID)
+
+	task, unassigned, err := NewUnassign(taskRepo, assigneeRepo, recordHistory).Run(taskID, 2, 1)
+	require.NoError(t, err, "failed to unassign")
+	assert.True(t, unassigned)
+	assert.Empty(t, task.Assignees)
+}
@@ -0,0 +1,47 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zemzale/ubiquitest/domain/money"
+)
+
+// ErrNegativeBudget is returned when a task is stored or updated with a budget
+// below zero.
+var ErrNegativeBudget = errors.New("budget can't be negative")
+
+// BudgetExceeded is delivered when a cost added to a subtask pushes the total
+// cost of the task over its budget. The task has the new total cost.
+type BudgetExceeded struct {
+	Task Task
+}
+
+// budgetIn converts the budget to the currency of the task. A budget without a
+// currency is in the currency of the task.
+func budgetIn(rates *money.Rates, budget money.Money, currency string) (money.Money, error) {
+	if budget.Amount < 0 {
+		return money.Money{}, ErrNegativeBudget
+	}
+
+	if budget.IsZero() {
+		return money.New(0, currency), nil
+	}
+
+	if strings.TrimSpace(budget.Currency) == "" {
+		budget.Currency = currency
+	}
+
+	budget, err := rates.Normalize(budget)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	converted, err := rates.Convert(budget, currency)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("failed to convert budget: %w", err)
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,171 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestBudgetExceeded(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID   = uuid.MustParse("6e2d3c4b-5a6f-4b7c-9d8e-0f1a2b3c4d01")
+		parentID = uuid.MustParse("6e2d3c4b-5a6f-4b7c-9d8e-0f1a2b3c4d02")
+		paintID  = uuid.MustParse("6e2d3c4b-5a6f-4b7c-9d8e-0f1a2b3c4d03")
+		tilesID  = uuid.MustParse("6e2d3c4b-5a6f-4b7c-9d8e-0f1a2b3c4d04")
+		groutID  = uuid.MustParse("6e2d3c4b-5a6f-4b7c-9d8e-0f1a2b3c4d05")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+	require.NoError(t, err, "failed to insert user")
+
+	taskRepo := storage.NewTaskRepository(db)
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(
+		updateParentCost,
+		taskRepo,
+		storage.NewUserRepository(db),
+		storage.NewAssigneeRepository(db),
+		history.NewRecord(storage.NewHistoryRepository(db)),
+		testRates(t),
+	)
+
+	exceeded := func() []uuid.UUID {
+		ids := make([]uuid.UUID, 0)
+		for {
+			select {
+			case alert := <-updateParentCost.BudgetExceeded():
+				ids = append(ids, alert.Task.ID)
+			default:
+				return ids
+			}
+		}
+	}
+
+	// root(budget 10) -> parent(budget 20) -> paint, tiles, grout
+	require.NoError(t, store.Run(Task{ID: rootID, Title: "Renovate", CreatedBy: 1, Budget: money.New(10, "EUR")}))
+	require.NoError(t, store.Run(Task{ID: parentID, Title: "Bathroom", CreatedBy: 1, ParentID: rootID, Budget: money.New(20, "")}))
+
+	require.NoError(t, store.Run(Task{ID: paintID, Title: "Paint", CreatedBy: 1, ParentID: parentID, Cost: money.New(10, "EUR")}))
+	assert.Empty(t, exceeded(), "reaching the budget must not exceed it")
+
+	require.NoError(t, store.Run(Task{ID: tilesID, Title: "Tiles", CreatedBy: 1, ParentID: parentID, Cost: money.New(5, "EUR")}))
+	assert.Equal(t, []uuid.UUID{rootID}, exceeded())
+
+	require.NoError(t, store.Run(Task{ID: groutID, Title: "Grout", CreatedBy: 1, ParentID: parentID, Cost: money.New(6, "EUR")}))
+	assert.Equal(t, []uuid.UUID{parentID}, exceeded(), "the root is already over budget")
+
+	record, err := taskRepo.Find(rootID.String())
+	require.NoError(t, err)
+	root := mapNewTaskFromDB(*record)
+	assert.True(t, root.OverBudget())
+	assert.Equal(t, money.New(-11, "EUR"), root.RemainingBudget())
+
+	record, err = taskRepo.Find(parentID.String())
+	require.NoError(t, err)
+	assert.Equal(t, money.New(20, "EUR"), mapNewTaskFromDB(*record).Budget, "budget without currency is in the one of the task")
+}
+
+func TestStoreNegativeBudget(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+	require.NoError(t, err, "failed to insert user")
+
+	taskRepo := storage.NewTaskRepository(db)
+	store := NewStore(
+		NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t)),
+		taskRepo,
+		storage.NewUserRepository(db),
+		storage.NewAssigneeRepository(db),
+		history.NewRecord(storage.NewHistoryRepository(db)),
+		testRates(t),
+	)
+
+	err = store.Run(Task{
+		ID:        uuid.MustParse("6e2d3c4b-5a6f-4b7c-9d8e-0f1a2b3c4d06"),
+		Title:     "Renovate",
+		CreatedBy: 1,
+		Budget:    money.New(-1, "EUR"),
+	})
+	assert.ErrorIs(t, err, ErrNegativeBudget)
+}
+
+func TestBudgetExceededByUpdate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID  = uuid.MustParse("6e2d3c4b-5a6f-4b7c-9d8e-0f1a2b3c4d07")
+		paintID = uuid.MustParse("6e2d3c4b-5a6f-4b7c-9d8e-0f1a2b3c4d08")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+	require.NoError(t, err, "failed to insert user")
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, testRates(t))
+	update := NewUpdate(updateParentCost, taskRepo, storage.NewDependencyRepository(db), NewCreateNextOccurrence(store, taskRepo, assigneeRepo, recordHistory), recordHistory, testRates(t))
+
+	exceeded := func() []uuid.UUID {
+		ids := make([]uuid.UUID, 0)
+		for {
+			select {
+			case alert := <-updateParentCost.BudgetExceeded():
+				ids = append(ids, alert.Task.ID)
+			default:
+				return ids
+			}
+		}
+	}
+
+	require.NoError(t, store.Run(Task{ID: rootID, Title: "Renovate", CreatedBy: 1, Budget: money.New(20, "EUR")}))
+	require.NoError(t, store.Run(Task{ID: paintID, Title: "Paint", CreatedBy: 1, ParentID: rootID, Cost: money.New(10, "EUR")}))
+	assert.Empty(t, exceeded())
+
+	_, err = update.Run(Change{ID: paintID, Title: "Paint", Cost: lo.ToPtr(money.New(25, "EUR"))}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{rootID}, exceeded(), "raising the cost over the budget must exceed it")
+
+	_, err = update.Run(Change{ID: paintID, Title: "Paint", Cost: lo.ToPtr(money.New(30, "EUR"))}, 1)
+	require.NoError(t, err)
+	assert.Empty(t, exceeded(), "the root is already over budget")
+
+	_, err = update.Run(Change{ID: paintID, Title: "Paint", Cost: lo.ToPtr(money.New(5, "EUR"))}, 1)
+	require.NoError(t, err)
+	_, err = update.Run(Change{ID: paintID, Title: "Paint", Cost: lo.ToPtr(money.New(21, "EUR"))}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{rootID}, exceeded(), "going over the budget again must exceed it again")
+}
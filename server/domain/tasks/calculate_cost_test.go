@@ -5,6 +5,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/money"
 )
 
 func TestCalculateCost(t *testing.T) {
@@ -17,10 +19,14 @@ func TestCalculateCost(t *testing.T) {
 		childID3 = uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a54")
 	)
 
+	rates, err := money.NewRates("EUR", map[string]string{"USD": "0.5"})
+	require.NoError(t, err, "failed to create rates")
+
 	tests := []struct {
-		name string
-		give []Task
-		want []Task
+		name    string
+		give    []Task
+		want    []Task
+		wantErr error
 	}{
 		{
 			name: "calculate cost",
@@ -29,27 +35,28 @@ func TestCalculateCost(t *testing.T) {
 					ID:        parentID,
 					Title:     "Create a new task",
 					CreatedBy: 1,
+					Cost:      money.New(0, "EUR"),
 				},
 				{
 					ID:        childID1,
 					Title:     "Create a new task",
 					CreatedBy: 1,
 					ParentID:  parentID,
-					Cost:      10,
+					Cost:      money.New(10, "EUR"),
 				},
 				{
 					ID:        childID2,
 					Title:     "Create a new task",
 					CreatedBy: 1,
 					ParentID:  parentID,
-					Cost:      10,
+					Cost:      money.New(10, "EUR"),
 				},
 				{
 					ID:        childID3,
 					Title:     "Create a new task",
 					CreatedBy: 1,
 					ParentID:  childID2,
-					Cost:      33,
+					Cost:      money.New(33, "EUR"),
 				},
 			},
 			want: []Task{
@@ -57,36 +64,62 @@ func TestCalculateCost(t *testing.T) {
 					ID:        parentID,
 					Title:     "Create a new task",
 					CreatedBy: 1,
-					Cost:      10 + 10 + 33,
+					Cost:      money.New(10+10+33, "EUR"),
 				},
 				{
 					ID:        childID1,
 					Title:     "Create a new task",
 					CreatedBy: 1,
 					ParentID:  parentID,
-					Cost:      10,
+					Cost:      money.New(10, "EUR"),
 				},
 				{
 					ID:        childID2,
 					Title:     "Create a new task",
 					CreatedBy: 1,
 					ParentID:  parentID,
-					Cost:      10 + 33,
+					Cost:      money.New(10+33, "EUR"),
 				},
 				{
 					ID:        childID3,
 					Title:     "Create a new task",
 					CreatedBy: 1,
 					ParentID:  childID2,
-					Cost:      33,
+					Cost:      money.New(33, "EUR"),
 				},
 			},
 		},
+		{
+			name: "convert cost of subtask in another currency",
+			give: []Task{
+				{ID: parentID, Title: "Trip", CreatedBy: 1, Cost: money.New(100, "EUR")},
+				{ID: childID1, Title: "Tickets", CreatedBy: 1, ParentID: parentID, Cost: money.New(1000, "USD")},
+			},
+			want: []Task{
+				{ID: parentID, Title: "Trip", CreatedBy: 1, Cost: money.New(600, "EUR")},
+				{ID: childID1, Title: "Tickets", CreatedBy: 1, ParentID: parentID, Cost: money.New(1000, "USD")},
+			},
+		},
+		{
+			name: "refuse subtask in currency without rate",
+			give: []Task{
+				{ID: parentID, Title: "Trip", CreatedBy: 1, Cost: money.New(100, "EUR")},
+				{ID: childID1, Title: "Hotel", CreatedBy: 1, ParentID: parentID, Cost: money.New(1000, "GBP")},
+			},
+			wantErr: money.ErrCurrencyMismatch,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			action := NewCalculateCost()
-			assert.ElementsMatch(t, tt.want, action.Run(tt.give))
+			action := NewCalculateCost(rates)
+			got, err := action.Run(tt.give)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, got)
 		})
 	}
 }
@@ -0,0 +1,144 @@
+package tasks
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type CreateNextOccurrence struct {
+	store         *Store
+	taskRepo      *storage.TaksRepository
+	assigneeRepo  *storage.AssigneeRepository
+	recordHistory *history.Record
+	now           func() time.Time
+}
+
+func NewCreateNextOccurrence(
+	store *Store,
+	taskRepo *storage.TaksRepository,
+	assigneeRepo *storage.AssigneeRepository,
+	recordHistory *history.Record,
+) *CreateNextOccurrence {
+	return &CreateNextOccurrence{
+		store:         store,
+		taskRepo:      taskRepo,
+		assigneeRepo:  assigneeRepo,
+		recordHistory: recordHistory,
+		now:           time.Now,
+	}
+}
+
+// Run creates the next occurrence of a completed recurring task and returns
+// the created tasks, the next occurrence first followed by its copied
+// subtasks. The recurrence moves over to the next occurrence, so completing
+// the same task again doesn't create another copy. actorID is the user that
+// completed the task.
+func (c *CreateNextOccurrence) Run(taskID uuid.UUID, actorID uint) ([]Task, error) {
+	record, err := c.taskRepo.Find(taskID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	completed, err := findWithAssignees(c.taskRepo, c.assigneeRepo, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if completed.Recurrence.IsZero() || completed.DueAt.IsZero() {
+		return nil, nil
+	}
+
+	nextDueAt, nextRecurrence, ok, err := nextOccurrence(completed.Recurrence, completed.DueAt, c.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate next occurrence: %w", err)
+	}
+
+	if err := c.taskRepo.ClearRecurrence(record.ID); err != nil {
+		return nil, fmt.Errorf("failed to clear recurrence: %w", err)
+	}
+
+	err = c.recordHistory.Run(completed.ID, actorID, recurrenceChanges(completed.Recurrence, Recurrence{})...)
+	if err != nil {
+		log.Println("failed to record history of cleared recurrence ", err)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	next := Task{
+		ID:         uuid.New(),
+		Title:      completed.Title,
+		CreatedBy:  completed.CreatedBy,
+		ParentID:   completed.ParentID,
+		Cost:       money.New(record.Cost, record.Currency),
+		DueAt:      nextDueAt,
+		Recurrence: nextRecurrence,
+		Assignees:  completed.Assignees,
+	}
+	if err := c.store.Run(next); err != nil {
+		return nil, fmt.Errorf("failed to store next occurrence: %w", err)
+	}
+
+	created := []Task{next}
+	if !completed.Recurrence.CopySubtree {
+		return created, nil
+	}
+
+	subtasks, err := c.copySubtree(completed.ID, next.ID, nextDueAt.Sub(completed.DueAt))
+	if err != nil {
+		return created, fmt.Errorf("failed to copy subtasks: %w", err)
+	}
+
+	return append(created, subtasks...), nil
+}
+
+// copySubtree copies the subtasks of from under to, shifting their due dates
+// by shift. Parents are stored before their children, so the cost roll-up in
+// Store adds up the same way it did for the original subtree.
+func (c *CreateNextOccurrence) copySubtree(from uuid.UUID, to uuid.UUID, shift time.Duration) ([]Task, error) {
+	children, err := c.taskRepo.ListChildren(from.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list children: %w", err)
+	}
+
+	created := make([]Task, 0, len(children))
+	for _, child := range children {
+		original, err := findWithAssignees(c.taskRepo, c.assigneeRepo, uuid.MustParse(child.ID))
+		if err != nil {
+			return created, err
+		}
+
+		task := Task{
+			ID:         uuid.New(),
+			Title:      original.Title,
+			CreatedBy:  original.CreatedBy,
+			ParentID:   to,
+			Cost:       money.New(child.Cost, child.Currency),
+			Recurrence: original.Recurrence,
+			Assignees:  original.Assignees,
+		}
+		if !original.DueAt.IsZero() {
+			task.DueAt = original.DueAt.Add(shift)
+		}
+
+		if err := c.store.Run(task); err != nil {
+			return created, fmt.Errorf("failed to store subtask copy: %w", err)
+		}
+		created = append(created, task)
+
+		subtasks, err := c.copySubtree(original.ID, task.ID, shift)
+		if err != nil {
+			return created, err
+		}
+		created = append(created, subtasks...)
+	}
+
+	return created, nil
+}
@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestCreateNextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	var (
+		parentID = uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a51")
+		choreID  = uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a52")
+		childID  = uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a53")
+		due      = time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "user")
+	require.NoError(t, err, "failed to insert user")
+
+	taskRepo := storage.NewTaskRepository(db)
+	store := NewStore(NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t)), taskRepo, storage.NewUserRepository(db), storage.NewAssigneeRepository(db), history.NewRecord(storage.NewHistoryRepository(db)), testRates(t))
+
+	for _, task := range []Task{
+		{ID: parentID, Title: "Household", CreatedBy: 1},
+		{
+			ID: choreID, Title: "Clean the kitchen", CreatedBy: 1, ParentID: parentID, Cost: money.New(5, "EUR"), DueAt: due,
+			Recurrence: Recurrence{Rule: "FREQ=WEEKLY", CopySubtree: true},
+		},
+		{ID: childID, Title: "Buy detergent", CreatedBy: 1, ParentID: choreID, Cost: money.New(10, "EUR"), DueAt: due.Add(-time.Hour)},
+	} {
+		require.NoError(t, store.Run(task), "failed to store task")
+	}
+
+	action := NewCreateNextOccurrence(store, taskRepo, storage.NewAssigneeRepository(db), history.NewRecord(storage.NewHistoryRepository(db)))
+	action.now = func() time.Time { return due }
+
+	created, err := action.Run(choreID, 1)
+	require.NoError(t, err, "failed to create next occurrence")
+	require.Len(t, created, 2)
+
+	next, subtask := created[0], created[1]
+	assert.Equal(t, "Clean the kitchen", next.Title)
+	assert.Equal(t, parentID, next.ParentID)
+	assert.True(t, due.AddDate(0, 0, 7).Equal(next.DueAt))
+	assert.Equal(t, "FREQ=WEEKLY", next.Recurrence.Rule)
+
+	assert.Equal(t, next.ID, subtask.ParentID)
+	assert.True(t, due.AddDate(0, 0, 7).Add(-time.Hour).Equal(subtask.DueAt))
+
+	nextRecord, err := taskRepo.Find(next.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), nextRecord.TotalCost, "next occurrence must include the copied subtask cost")
+
+	parentRecord, err := taskRepo.Find(parentID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), parentRecord.TotalCost, "parent must include both occurrences")
+
+	// The recurrence moved to the next occurrence, so running it again for
+	// the completed task must not create another copy.
+	created, err = action.Run(choreID, 1)
+	require.NoError(t, err)
+	assert.Empty(t, created)
+}
@@ -0,0 +1,213 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type ReminderKind string
+
+const (
+	ReminderKindDueSoon ReminderKind = "due_soon"
+	ReminderKindOverdue ReminderKind = "overdue"
+)
+
+type Reminder struct {
+	Task Task
+	Kind ReminderKind
+	// Lead is how long before the due date the reminder was scheduled. It's
+	// always zero for overdue reminders.
+	Lead time.Duration
+	// Recipients are the IDs of the users that should be notified.
+	Recipients []uint
+}
+
+// DueScheduler fires reminders for tasks that are about to be due or are
+// overdue. Nothing is kept in memory between runs, every tick recomputes the
+// pending reminders from the DB, so reminders survive server restarts and are
+// never sent twice for the same due date.
+type DueScheduler struct {
+	taskRepo     *storage.TaksRepository
+	reminderRepo *storage.ReminderRepository
+	assigneeRepo *storage.AssigneeRepository
+
+	leadTimes    []time.Duration
+	pollInterval time.Duration
+	now          func() time.Time
+
+	reminders chan Reminder
+}
+
+func NewDueScheduler(
+	taskRepo *storage.TaksRepository,
+	reminderRepo *storage.ReminderRepository,
+	assigneeRepo *storage.AssigneeRepository,
+	leadTimes []time.Duration,
+	pollInterval time.Duration,
+) *DueScheduler {
+	leadTimes = slices.Clone(leadTimes)
+	slices.Sort(leadTimes)
+
+	return &DueScheduler{
+		taskRepo:     taskRepo,
+		reminderRepo: reminderRepo,
+		assigneeRepo: assigneeRepo,
+		leadTimes:    leadTimes,
+		pollInterval: pollInterval,
+		now:          time.Now,
+		reminders:    make(chan Reminder),
+	}
+}
+
+// Reminders returns the channel the reminders are delivered on. Run blocks
+// until each reminder is received.
+func (d *DueScheduler) Reminders() <-chan Reminder {
+	return d.reminders
+}
+
+func (d *DueScheduler) Run(ctx context.Context) {
+	for {
+		next, err := d.fire(ctx)
+		if err != nil {
+			log.Println("failed to fire due reminders ", err)
+		}
+
+		// Poll even when we know the next reminder, since tasks can be created
+		// or their due dates moved in the meantime.
+		wait := d.pollInterval
+		if !next.IsZero() {
+			wait = min(wait, max(next.Sub(d.now()), 0))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// fire sends all the reminders that are due and returns the time when the
+// next one will be due, or zero time if there is nothing scheduled.
+func (d *DueScheduler) fire(ctx context.Context) (time.Time, error) {
+	taskRecords, err := d.taskRepo.ListWithDueDate()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to list tasks with due date: %w", err)
+	}
+
+	sentRecords, err := d.reminderRepo.ListSent()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to list sent reminders: %w", err)
+	}
+
+	sent := make(map[reminderKey]struct{}, len(sentRecords))
+	for _, r := range sentRecords {
+		sent[reminderKey{
+			taskID: uuid.MustParse(r.TaskID),
+			kind:   ReminderKind(r.Kind),
+			lead:   time.Duration(r.LeadSeconds) * time.Second,
+		}] = struct{}{}
+	}
+
+	assignees, err := d.assigneeRepo.ListAll()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to list assignees: %w", err)
+	}
+
+	tasks := lo.Map(taskRecords, func(t *storage.Task, _ int) Task {
+		task := mapNewTaskFromDB(*t)
+		task.Assignees = assignees[t.ID]
+		return task
+	})
+
+	now := d.now()
+	due, next := pendingReminders(tasks, sent, d.leadTimes, now)
+
+	for _, reminder := range due {
+		select {
+		case <-ctx.Done():
+			return time.Time{}, ctx.Err()
+		case d.reminders <- reminder:
+		}
+
+		err := d.reminderRepo.MarkSent(storage.Reminder{
+			TaskID:      reminder.Task.ID.String(),
+			Kind:        string(reminder.Kind),
+			LeadSeconds: int64(reminder.Lead / time.Second),
+			DueAt:       reminder.Task.DueAt,
+			SentAt:      now.UTC(),
+		})
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return next, nil
+}
+
+type reminderKey struct {
+	taskID uuid.UUID
+	kind   ReminderKind
+	lead   time.Duration
+}
+
+// pendingReminders returns the reminders that should be sent at now and the
+// time of the next one. If several lead times have passed at once, only the
+// closest one to the due date is sent, so a task created an hour before it's
+// due doesn't get both the "in a day" and the "in an hour" reminder.
+func pendingReminders(
+	tasks []Task, sent map[reminderKey]struct{}, leadTimes []time.Duration, now time.Time,
+) ([]Reminder, time.Time) {
+	due := make([]Reminder, 0)
+	var next time.Time
+	schedule := func(at time.Time) {
+		if next.IsZero() || at.Before(next) {
+			next = at
+		}
+	}
+
+	for _, task := range tasks {
+		if task.DueAt.IsZero() || task.Completed {
+			continue
+		}
+
+		if !now.Before(task.DueAt) {
+			key := reminderKey{taskID: task.ID, kind: ReminderKindOverdue}
+			if _, ok := sent[key]; !ok {
+				due = append(due, Reminder{Task: task, Kind: ReminderKindOverdue, Recipients: task.Recipients()})
+			}
+
+			continue
+		}
+
+		schedule(task.DueAt)
+
+		// Lead times are sorted, so the first one that has passed is the
+		// closest one to the due date.
+		for _, lead := range leadTimes {
+			fireAt := task.DueAt.Add(-lead)
+			if fireAt.After(now) {
+				schedule(fireAt)
+				continue
+			}
+
+			key := reminderKey{taskID: task.ID, kind: ReminderKindDueSoon, lead: lead}
+			if _, ok := sent[key]; !ok {
+				due = append(due, Reminder{Task: task, Kind: ReminderKindDueSoon, Lead: lead, Recipients: task.Recipients()})
+			}
+
+			break
+		}
+	}
+
+	return due, next
+}
@@ -0,0 +1,137 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestPendingReminders(t *testing.T) {
+	t.Parallel()
+
+	var (
+		taskID    = uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a51")
+		now       = time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+		leadTimes = []time.Duration{time.Hour, 24 * time.Hour}
+	)
+
+	tests := []struct {
+		name     string
+		giveTask Task
+		giveSent map[reminderKey]struct{}
+		want     []Reminder
+		wantNext time.Time
+	}{
+		{
+			name:     "nothing due yet",
+			giveTask: Task{ID: taskID, CreatedBy: 1, DueAt: now.Add(48 * time.Hour)},
+			want:     []Reminder{},
+			wantNext: now.Add(24 * time.Hour),
+		},
+		{
+			name:     "due soon",
+			giveTask: Task{ID: taskID, CreatedBy: 1, DueAt: now.Add(2 * time.Hour)},
+			want: []Reminder{
+				{
+					Task:       Task{ID: taskID, CreatedBy: 1, DueAt: now.Add(2 * time.Hour)},
+					Kind:       ReminderKindDueSoon,
+					Lead:       24 * time.Hour,
+					Recipients: []uint{1},
+				},
+			},
+			wantNext: now.Add(time.Hour),
+		},
+		{
+			name:     "only the closest passed lead time fires",
+			giveTask: Task{ID: taskID, CreatedBy: 1, DueAt: now.Add(30 * time.Minute)},
+			want: []Reminder{
+				{
+					Task:       Task{ID: taskID, CreatedBy: 1, DueAt: now.Add(30 * time.Minute)},
+					Kind:       ReminderKindDueSoon,
+					Lead:       time.Hour,
+					Recipients: []uint{1},
+				},
+			},
+			wantNext: now.Add(30 * time.Minute),
+		},
+		{
+			name:     "already sent",
+			giveTask: Task{ID: taskID, CreatedBy: 1, DueAt: now.Add(30 * time.Minute)},
+			giveSent: map[reminderKey]struct{}{
+				{taskID: taskID, kind: ReminderKindDueSoon, lead: time.Hour}: {},
+			},
+			want:     []Reminder{},
+			wantNext: now.Add(30 * time.Minute),
+		},
+		{
+			name:     "overdue",
+			giveTask: Task{ID: taskID, CreatedBy: 1, DueAt: now.Add(-time.Minute)},
+			want: []Reminder{
+				{
+					Task:       Task{ID: taskID, CreatedBy: 1, DueAt: now.Add(-time.Minute)},
+					Kind:       ReminderKindOverdue,
+					Recipients: []uint{1},
+				},
+			},
+		},
+		{
+			name:     "completed tasks are skipped",
+			giveTask: Task{ID: taskID, CreatedBy: 1, Completed: true, DueAt: now.Add(-time.Minute)},
+			want:     []Reminder{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotNext := pendingReminders([]Task{tt.giveTask}, tt.giveSent, leadTimes, now)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantNext, gotNext)
+		})
+	}
+}
+
+func TestDueSchedulerSurvivesRestart(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	taskRepo := storage.NewTaskRepository(db)
+	require.NoError(t, taskRepo.Create(mapNewTaskToDB(Task{
+		ID:        uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a51"),
+		Title:     "Pay the rent",
+		CreatedBy: 1,
+		DueAt:     now.Add(-time.Hour),
+	})))
+
+	newScheduler := func() *DueScheduler {
+		scheduler := NewDueScheduler(taskRepo, storage.NewReminderRepository(db), storage.NewAssigneeRepository(db), []time.Duration{time.Hour}, time.Minute)
+		scheduler.now = func() time.Time { return now }
+		return scheduler
+	}
+
+	received := make(chan Reminder, 1)
+	scheduler := newScheduler()
+	go func() { received <- <-scheduler.Reminders() }()
+
+	_, err = scheduler.fire(context.Background())
+	require.NoError(t, err, "failed to fire reminders")
+	assert.Equal(t, ReminderKindOverdue, (<-received).Kind)
+
+	// A fresh scheduler, like after a restart, must not send it again.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = newScheduler().fire(ctx)
+	assert.NoError(t, err, "reminder was sent again after restart")
+}
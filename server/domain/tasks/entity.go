@@ -2,8 +2,12 @@ package tasks
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/attachments"
+	"github.com/zemzale/ubiquitest/domain/money"
 	"github.com/zemzale/ubiquitest/storage"
 )
 
@@ -13,7 +17,55 @@ type Task struct {
 	CreatedBy uint
 	Completed bool
 	ParentID  uuid.UUID
-	Cost      uint
+	// Cost is the own cost of the task when it's stored or updated and the
+	// cost of the whole subtree when it's read back.
+	Cost money.Money
+	// Budget is in the currency of Cost, the zero amount when the task has no
+	// budget.
+	Budget money.Money
+	// DueAt is the zero time when the task has no due date.
+	DueAt time.Time
+	// Logged is the time logged on the whole subtree.
+	Logged     time.Duration
+	Recurrence Recurrence
+	// Assignees are the IDs of the users the task is assigned to.
+	Assignees []uint
+	Labels    []uuid.UUID
+	// Attachments only has the metadata, the content is in the blob store.
+	Attachments []attachments.Attachment
+	// DeletedAt is the zero time unless the task is in the trash.
+	DeletedAt time.Time
+	// ArchivedAt is the zero time unless the task is archived.
+	ArchivedAt time.Time
+	// BlockedBy are the IDs of the tasks that have to be completed first.
+	BlockedBy []uuid.UUID
+	// Blocked is true while any of the tasks in BlockedBy is incomplete.
+	Blocked bool
+	// Position orders the task among its siblings, it's set when the task is
+	// stored.
+	Position string
+	// Rollup is only set when the task is listed.
+	Rollup Rollup
+}
+
+func (t Task) HasBudget() bool {
+	return t.Budget.Amount > 0
+}
+
+// RemainingBudget returns how much of the budget is left after the cost of
+// the whole subtree, negative when it's over budget.
+func (t Task) RemainingBudget() money.Money {
+	return money.New(t.Budget.Amount-t.Cost.Amount, t.Cost.Currency)
+}
+
+func (t Task) OverBudget() bool {
+	return t.HasBudget() && t.Cost.Amount > t.Budget.Amount
+}
+
+// Recipients returns the users that should be notified about the task, its
+// creator and assignees.
+func (t Task) Recipients() []uint {
+	return lo.Uniq(append([]uint{t.CreatedBy}, t.Assignees...))
 }
 
 func mapNewTaskToDB(task Task) storage.Task {
@@ -28,8 +80,16 @@ func mapNewTaskToDB(task Task) storage.Task {
 		CreatedBy: task.CreatedBy,
 		Completed: task.Completed,
 		ParentID:  sql.Null[string]{V: parentID.String(), Valid: true},
-		Cost:      task.Cost,
-		TotalCost: task.Cost,
+		Cost:      task.Cost.Amount,
+		TotalCost: task.Cost.Amount,
+		Budget:    task.Budget.Amount,
+		Currency:  task.Cost.Currency,
+		DueAt:     mapTimeToDB(task.DueAt),
+
+		RecurrenceRule:        task.Recurrence.Rule,
+		RecurrenceTimezone:    task.Recurrence.Timezone,
+		RecurrenceCopySubtree: task.Recurrence.CopySubtree,
+		Position:              task.Position,
 	}
 }
 
@@ -40,11 +100,75 @@ func mapNewTaskFromDB(taskRecord storage.Task) Task {
 	}
 
 	return Task{
-		ID:        uuid.MustParse(taskRecord.ID),
-		Title:     taskRecord.Title,
-		CreatedBy: taskRecord.CreatedBy,
-		Completed: taskRecord.Completed,
-		ParentID:  parnetUUID,
-		Cost:      taskRecord.TotalCost,
+		ID:         uuid.MustParse(taskRecord.ID),
+		Title:      taskRecord.Title,
+		CreatedBy:  taskRecord.CreatedBy,
+		Completed:  taskRecord.Completed,
+		ParentID:   parnetUUID,
+		Cost:       money.New(taskRecord.TotalCost, taskRecord.Currency),
+		Budget:     money.New(taskRecord.Budget, taskRecord.Currency),
+		DueAt:      mapTimeFromDB(taskRecord.DueAt),
+		Logged:     time.Duration(taskRecord.TotalLogged) * time.Second,
+		DeletedAt:  mapTimeFromDB(taskRecord.DeletedAt),
+		ArchivedAt: mapTimeFromDB(taskRecord.ArchivedAt),
+		Position:   taskRecord.Position,
+		Recurrence: Recurrence{
+			Rule:        taskRecord.RecurrenceRule,
+			Timezone:    taskRecord.RecurrenceTimezone,
+			CopySubtree: taskRecord.RecurrenceCopySubtree,
+		},
 	}
 }
+
+// mapStoredTaskFromDB maps the task with its own cost instead of the rolled up
+// total, so it can be written back the way it was.
+func mapStoredTaskFromDB(taskRecord storage.Task) Task {
+	task := mapNewTaskFromDB(taskRecord)
+	task.Cost = money.New(taskRecord.Cost, taskRecord.Currency)
+
+	return task
+}
+
+// mapUpdatedTaskToDB returns the stored task with the editable fields of the
+// task and the given total cost.
+func mapUpdatedTaskToDB(record storage.Task, task Task, totalCost money.Money) storage.Task {
+	record.Title = task.Title
+	record.Completed = task.Completed
+	record.Cost = task.Cost.Amount
+	record.Currency = task.Cost.Currency
+	record.TotalCost = totalCost.Amount
+	record.Budget = task.Budget.Amount
+	record.DueAt = mapTimeToDB(task.DueAt)
+	record.RecurrenceRule = task.Recurrence.Rule
+	record.RecurrenceTimezone = task.Recurrence.Timezone
+	record.RecurrenceCopySubtree = task.Recurrence.CopySubtree
+
+	return record
+}
+
+// sameState reports whether the editable fields of the tasks are the same.
+func sameState(a Task, b Task) bool {
+	return a.Title == b.Title &&
+		a.Completed == b.Completed &&
+		a.Cost == b.Cost &&
+		a.Budget == b.Budget &&
+		a.DueAt.Equal(b.DueAt) &&
+		a.Recurrence == b.Recurrence
+}
+
+// mapTimeToDB maps the zero time to NULL.
+func mapTimeToDB(t time.Time) sql.Null[time.Time] {
+	if t.IsZero() {
+		return sql.Null[time.Time]{}
+	}
+
+	return sql.Null[time.Time]{V: t.UTC(), Valid: true}
+}
+
+func mapTimeFromDB(t sql.Null[time.Time]) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return t.V.UTC()
+}
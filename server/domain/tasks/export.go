@@ -0,0 +1,121 @@
+package tasks
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// ExportedTask is a task as it's exported and imported. Users are referred to
+// by their username, so a tree can be moved to another server.
+type ExportedTask struct {
+	ID uuid.UUID
+	// ParentID is uuid.Nil for the top level tasks.
+	ParentID uuid.UUID
+	Title    string
+	// Cost is the own cost of the task and TotalCost the one of its whole
+	// subtree, which is only informative and computed again on import.
+	Cost      money.Money
+	TotalCost money.Money
+	// Budget is in the currency of Cost, the zero amount when the task has no
+	// budget.
+	Budget      money.Money
+	DueAt       time.Time
+	Completed   bool
+	CompletedAt time.Time
+	ArchivedAt  time.Time
+	CreatedBy   string
+	// CompletedBy is empty unless the task is completed.
+	CompletedBy string
+}
+
+type Export struct {
+	taskRepo *storage.TaksRepository
+	userRepo *storage.UserRepository
+}
+
+func NewExport(taskRepo *storage.TaksRepository, userRepo *storage.UserRepository) *Export {
+	return &Export{taskRepo: taskRepo, userRepo: userRepo}
+}
+
+// Run returns all the tasks that are not in the trash, archived ones included.
+// Every task comes after its parent and siblings are in their order.
+func (e *Export) Run() ([]ExportedTask, error) {
+	records, err := e.taskRepo.ListNotTrashed()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	users, err := e.userRepo.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	usernames := make(map[uint]string, len(users))
+	for _, user := range users {
+		usernames[user.ID] = user.Username
+	}
+
+	byID := make(map[string]*storage.Task, len(records))
+	for _, record := range records {
+		byID[record.ID] = record
+	}
+
+	children := make(map[string][]*storage.Task)
+	roots := make([]*storage.Task, 0)
+	for _, record := range records {
+		if _, ok := byID[record.ParentID.V]; !ok {
+			roots = append(roots, record)
+			continue
+		}
+
+		children[record.ParentID.V] = append(children[record.ParentID.V], record)
+	}
+
+	exported := make([]ExportedTask, 0, len(records))
+	var walk func(siblings []*storage.Task)
+	walk = func(siblings []*storage.Task) {
+		slices.SortFunc(siblings, func(a, b *storage.Task) int {
+			return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.ID, b.ID))
+		})
+
+		for _, record := range siblings {
+			exported = append(exported, mapExportedTask(*record, byID, usernames))
+			walk(children[record.ID])
+		}
+	}
+	walk(roots)
+
+	return exported, nil
+}
+
+func mapExportedTask(record storage.Task, byID map[string]*storage.Task, usernames map[uint]string) ExportedTask {
+	task := mapNewTaskFromDB(record)
+	if _, ok := byID[record.ParentID.V]; !ok {
+		task.ParentID = uuid.Nil
+	}
+
+	exported := ExportedTask{
+		ID:          task.ID,
+		ParentID:    task.ParentID,
+		Title:       task.Title,
+		Cost:        money.New(record.Cost, record.Currency),
+		TotalCost:   task.Cost,
+		Budget:      task.Budget,
+		DueAt:       task.DueAt,
+		Completed:   task.Completed,
+		CompletedAt: mapTimeFromDB(record.CompletedAt),
+		ArchivedAt:  task.ArchivedAt,
+		CreatedBy:   usernames[record.CreatedBy],
+	}
+	if record.CompletedBy.Valid {
+		exported.CompletedBy = usernames[record.CompletedBy.V]
+	}
+
+	return exported
+}
@@ -0,0 +1,36 @@
+package tasks
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned when there is no task with the ID, or it's in the
+// trash.
+var ErrNotFound = errors.New("task not found")
+
+type Find struct {
+	list *List
+}
+
+func NewFind(list *List) *Find {
+	return &Find{list: list}
+}
+
+// Run returns the task with everything List returns for it, whether it's
+// archived or not.
+func (f *Find) Run(id uuid.UUID) (Task, error) {
+	for _, archived := range []bool{false, true} {
+		found, err := f.list.Run(ListFilter{ID: id, Archived: archived})
+		if err != nil {
+			return Task{}, err
+		}
+
+		if len(found) > 0 {
+			return found[0], nil
+		}
+	}
+
+	return Task{}, ErrNotFound
+}
@@ -0,0 +1,66 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID     = uuid.MustParse("5e4d3c2b-1a09-4f8e-9d7c-6b5a49382701")
+		archivedID = uuid.MustParse("5e4d3c2b-1a09-4f8e-9d7c-6b5a49382702")
+		trashedID  = uuid.MustParse("5e4d3c2b-1a09-4f8e-9d7c-6b5a49382703")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'alice'), (2, 'bob')")
+	require.NoError(t, err, "failed to insert users")
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, testRates(t))
+	list := NewList(db, taskRepo, assigneeRepo, storage.NewLabelRepository(db), storage.NewAttachmentRepository(db), storage.NewDependencyRepository(db), NewRollUp(taskRepo, DefaultMetrics(testRates(t)), true))
+	find := NewFind(list)
+
+	require.NoError(t, store.Run(Task{ID: rootID, Title: "Garden", CreatedBy: 1, Cost: money.New(3, "EUR"), Assignees: []uint{2}}))
+	require.NoError(t, store.Run(Task{ID: archivedID, Title: "Mow", CreatedBy: 1, ParentID: rootID}))
+	require.NoError(t, store.Run(Task{ID: trashedID, Title: "Rake", CreatedBy: 1, ParentID: rootID}))
+
+	_, err = NewArchive(updateParentCost, taskRepo, recordHistory, false).Run(archivedID, 1)
+	require.NoError(t, err)
+	_, _, err = NewDelete(updateParentCost, taskRepo, recordHistory).Run(trashedID, 1)
+	require.NoError(t, err)
+
+	root, err := find.Run(rootID)
+	require.NoError(t, err)
+	assert.Equal(t, "Garden", root.Title)
+	assert.Equal(t, []uint{2}, root.Assignees)
+
+	archived, err := find.Run(archivedID)
+	require.NoError(t, err)
+	assert.False(t, archived.ArchivedAt.IsZero())
+
+	_, err = find.Run(trashedID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = find.Run(uuid.New())
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,73 @@
+package tasks
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// updateChanges returns what the update changes compared to the stored task.
+// The cost is compared to the own cost of the task and not the rolled up one.
+func updateChanges(record storage.Task, task Task) []history.Change {
+	before := mapNewTaskFromDB(record)
+	changes := []history.Change{
+		{Field: history.FieldTitle, OldValue: before.Title, NewValue: task.Title},
+		{
+			Field:    history.FieldCompleted,
+			OldValue: strconv.FormatBool(before.Completed),
+			NewValue: strconv.FormatBool(task.Completed),
+		},
+	}
+
+	// Completing only touches the title and the completed flag.
+	if task.Completed {
+		return changes
+	}
+
+	changes = append(changes,
+		history.Change{
+			Field:    history.FieldCost,
+			OldValue: money.New(record.Cost, record.Currency).String(),
+			NewValue: task.Cost.String(),
+		},
+		history.Change{
+			Field:    history.FieldBudget,
+			OldValue: formatBudget(before.Budget),
+			NewValue: formatBudget(task.Budget),
+		},
+		history.Change{Field: history.FieldDueAt, OldValue: formatDueAt(before.DueAt), NewValue: formatDueAt(task.DueAt)},
+	)
+
+	return append(changes, recurrenceChanges(before.Recurrence, task.Recurrence)...)
+}
+
+func recurrenceChanges(before Recurrence, after Recurrence) []history.Change {
+	return []history.Change{
+		{Field: history.FieldRecurrenceRule, OldValue: before.Rule, NewValue: after.Rule},
+		{Field: history.FieldRecurrenceTimezone, OldValue: before.Timezone, NewValue: after.Timezone},
+		{
+			Field:    history.FieldRecurrenceCopySubtree,
+			OldValue: strconv.FormatBool(before.CopySubtree),
+			NewValue: strconv.FormatBool(after.CopySubtree),
+		},
+	}
+}
+
+func formatBudget(budget money.Money) string {
+	if budget.IsZero() {
+		return ""
+	}
+
+	return budget.String()
+}
+
+func formatDueAt(dueAt time.Time) string {
+	if dueAt.IsZero() {
+		return ""
+	}
+
+	return dueAt.UTC().Format(time.RFC3339)
+}
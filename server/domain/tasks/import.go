@@ -0,0 +1,459 @@
+package tasks
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// ErrInvalidImport is returned when the imported tasks don't make up a valid
+// tree, e.g. a task has no title or its parent is not imported with it.
+var ErrInvalidImport = errors.New("invalid import")
+
+// Imported are the tasks created by an import, parents before their children,
+// with the cost of their whole subtree.
+type Imported struct {
+	Tasks []Task
+	// IDs maps the IDs in the import to the IDs of the created tasks. They
+	// only differ when the ID was already taken.
+	IDs map[uuid.UUID]uuid.UUID
+}
+
+type Import struct {
+	updateParentCost *UpdateParentCost
+
+	taskRepo *storage.TaksRepository
+	userRepo *storage.UserRepository
+
+	recordHistory *history.Record
+	rates         *money.Rates
+	// rollUpArchived adds the cost of the imported tasks that are already
+	// archived to their ancestors that are not.
+	rollUpArchived bool
+}
+
+func NewImport(
+	updateParentCost *UpdateParentCost,
+	taskRepo *storage.TaksRepository,
+	userRepo *storage.UserRepository,
+	recordHistory *history.Record,
+	rates *money.Rates,
+	rollUpArchived bool,
+) *Import {
+	return &Import{
+		updateParentCost: updateParentCost,
+		taskRepo:         taskRepo,
+		userRepo:         userRepo,
+		recordHistory:    recordHistory,
+		rates:            rates,
+		rollUpArchived:   rollUpArchived,
+	}
+}
+
+// Run validates the tasks and creates all of them or none. The top level
+// tasks of the import are put under the parent, or at the top level when it's
+// uuid.Nil, after the tasks that are already there. Users are matched by
+// their username, tasks of unknown users are created by the one importing.
+func (i *Import) Run(exported []ExportedTask, parentID uuid.UUID, actorID uint) (Imported, error) {
+	prepared, err := i.prepare(exported, parentID, actorID)
+	if err != nil {
+		return Imported{}, err
+	}
+
+	updates, err := i.parentCostUpdates(prepared.roots, parentID)
+	if err != nil {
+		return Imported{}, fmt.Errorf("failed to update parent cost: %w", err)
+	}
+
+	totalCosts := make(map[string]int64, len(updates))
+	for _, update := range updates {
+		totalCosts[update.parent.ID.String()] = update.cost.Amount
+	}
+
+	if err := i.taskRepo.CreateMany(prepared.records, totalCosts); err != nil {
+		return Imported{}, fmt.Errorf("failed to create tasks: %w", err)
+	}
+
+	for _, update := range updates {
+		i.updateParentCost.checkBudget(update)
+	}
+
+	if len(prepared.lastPosition) > maxPositionLength {
+		if _, err := rebalance(i.taskRepo, parentID.String(), nil, 0); err != nil {
+			log.Println("failed to rebalance positions ", err)
+		}
+	}
+
+	for _, task := range prepared.Tasks {
+		change := history.Change{Field: history.FieldCreated, NewValue: task.Title}
+		if err := i.recordHistory.Run(task.ID, actorID, change); err != nil {
+			log.Println("failed to record history of imported task ", err)
+		}
+	}
+
+	return prepared.Imported, nil
+}
+
+// parentCostUpdates adds up the costs of the top level tasks for the parent
+// and each of its ancestors, so they can be updated together with creating
+// the tasks. The archived ones are left out unless they roll up.
+func (i *Import) parentCostUpdates(roots []Task, parentID uuid.UUID) ([]parentCostUpdate, error) {
+	var updates []parentCostUpdate
+	for _, root := range roots {
+		if !i.rollsUp(root) {
+			continue
+		}
+
+		rootUpdates, err := i.updateParentCost.convert(parentID, root.Cost)
+		if err != nil {
+			return nil, err
+		}
+
+		// Every root has the same ancestors, in the same order.
+		if len(updates) == 0 {
+			updates = rootUpdates
+			continue
+		}
+
+		for j, update := range rootUpdates {
+			updates[j].cost.Amount += update.cost.Amount
+		}
+	}
+
+	return updates, nil
+}
+
+// Preview validates the tasks the same way Run does and returns the tasks Run
+// would create, without creating anything.
+func (i *Import) Preview(exported []ExportedTask, parentID uuid.UUID, actorID uint) (Imported, error) {
+	prepared, err := i.prepare(exported, parentID, actorID)
+	if err != nil {
+		return Imported{}, err
+	}
+
+	return prepared.Imported, nil
+}
+
+// preparedImport has everything needed to create the imported tasks.
+type preparedImport struct {
+	Imported
+
+	records []storage.Task
+	// roots are the top level tasks with the cost of their whole subtree.
+	roots        []Task
+	lastPosition string
+}
+
+func (i *Import) prepare(exported []ExportedTask, parentID uuid.UUID, actorID uint) (preparedImport, error) {
+	if err := i.checkParent(parentID); err != nil {
+		return preparedImport{}, err
+	}
+
+	ids, err := i.remapIDs(exported)
+	if err != nil {
+		return preparedImport{}, err
+	}
+
+	users, err := i.userIDs()
+	if err != nil {
+		return preparedImport{}, err
+	}
+
+	imported := make([]Task, 0, len(exported))
+	records := make([]storage.Task, 0, len(exported))
+	for n, e := range exported {
+		task, record, err := i.mapImportedTask(e, ids, parentID, users, actorID)
+		if err != nil {
+			return preparedImport{}, fmt.Errorf("%w: task %d: %w", ErrInvalidImport, n+1, err)
+		}
+
+		imported = append(imported, task)
+		records = append(records, record)
+	}
+
+	depths, err := checkTree(imported, parentID)
+	if err != nil {
+		return preparedImport{}, err
+	}
+	sortByDepth(imported, records, depths)
+
+	roots, err := i.setTotalCosts(imported, records, parentID)
+	if err != nil {
+		return preparedImport{}, err
+	}
+
+	lastPosition, err := i.setPositions(records, parentID)
+	if err != nil {
+		return preparedImport{}, err
+	}
+
+	for n, task := range imported {
+		task.Cost = money.New(records[n].TotalCost, records[n].Currency)
+		task.Position = records[n].Position
+		imported[n] = task
+	}
+
+	return preparedImport{
+		Imported:     Imported{Tasks: imported, IDs: ids},
+		records:      records,
+		roots:        roots,
+		lastPosition: lastPosition,
+	}, nil
+}
+
+func (i *Import) checkParent(parentID uuid.UUID) error {
+	if parentID == uuid.Nil {
+		return nil
+	}
+
+	if err := i.taskRepo.CheckIfParentExists(parentID.String()); err != nil {
+		return fmt.Errorf("%w: parent %s doesn't exist: %w", ErrInvalidImport, parentID, err)
+	}
+
+	return nil
+}
+
+// remapIDs gives a new ID to every task whose ID is taken or missing.
+func (i *Import) remapIDs(exported []ExportedTask) (map[uuid.UUID]uuid.UUID, error) {
+	if len(exported) == 0 {
+		return nil, fmt.Errorf("%w: no tasks", ErrInvalidImport)
+	}
+
+	ids := make(map[uuid.UUID]uuid.UUID, len(exported))
+	for n, e := range exported {
+		if _, ok := ids[e.ID]; ok && e.ID != uuid.Nil {
+			return nil, fmt.Errorf("%w: task %d: duplicate id %s", ErrInvalidImport, n+1, e.ID)
+		}
+		ids[e.ID] = e.ID
+	}
+
+	existing, err := i.taskRepo.ExistingIDs(mapKeys(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check ids: %w", err)
+	}
+
+	for _, id := range existing {
+		ids[uuid.MustParse(id)] = uuid.New()
+	}
+	delete(ids, uuid.Nil)
+
+	return ids, nil
+}
+
+func mapKeys(ids map[uuid.UUID]uuid.UUID) []string {
+	keys := make([]string, 0, len(ids))
+	for id := range ids {
+		keys = append(keys, id.String())
+	}
+
+	return keys
+}
+
+func (i *Import) userIDs() (map[string]uint, error) {
+	users, err := i.userRepo.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	ids := make(map[string]uint, len(users))
+	for _, user := range users {
+		ids[user.Username] = user.ID
+	}
+
+	return ids, nil
+}
+
+func (i *Import) mapImportedTask(
+	e ExportedTask, ids map[uuid.UUID]uuid.UUID, parentID uuid.UUID, users map[string]uint, actorID uint,
+) (Task, storage.Task, error) {
+	if strings.TrimSpace(e.Title) == "" {
+		return Task{}, storage.Task{}, errors.New("title can't be empty")
+	}
+
+	id := ids[e.ID]
+	if e.ID == uuid.Nil {
+		id = uuid.New()
+	}
+
+	taskParentID := parentID
+	if e.ParentID != uuid.Nil {
+		mapped, ok := ids[e.ParentID]
+		if !ok {
+			return Task{}, storage.Task{}, fmt.Errorf("parent %s is not imported", e.ParentID)
+		}
+		taskParentID = mapped
+	}
+
+	cost, err := i.rates.Normalize(e.Cost)
+	if err != nil {
+		return Task{}, storage.Task{}, err
+	}
+
+	budget, err := budgetIn(i.rates, e.Budget, cost.Currency)
+	if err != nil {
+		return Task{}, storage.Task{}, err
+	}
+
+	userID := func(username string) uint {
+		if id, ok := users[username]; ok {
+			return id
+		}
+		return actorID
+	}
+
+	task := Task{
+		ID:         id,
+		Title:      e.Title,
+		CreatedBy:  userID(e.CreatedBy),
+		Completed:  e.Completed,
+		ParentID:   taskParentID,
+		Cost:       cost,
+		Budget:     budget,
+		DueAt:      e.DueAt,
+		ArchivedAt: e.ArchivedAt,
+	}
+
+	record := mapNewTaskToDB(task)
+	record.ArchivedAt = mapTimeToDB(e.ArchivedAt)
+	if e.Completed {
+		record.CompletedBy.V, record.CompletedBy.Valid = userID(e.CompletedBy), true
+		completedAt := e.CompletedAt
+		// Files that only mark tasks as done have them done at the import.
+		if completedAt.IsZero() {
+			completedAt = time.Now()
+		}
+		record.CompletedAt = mapTimeToDB(completedAt)
+	}
+
+	return task, record, nil
+}
+
+// checkTree fails if following the parents of any task leads back to it
+// instead of to the parent of the import. It returns how deep every task is
+// below the parent of the import.
+func checkTree(tasks []Task, parentID uuid.UUID) (map[uuid.UUID]int, error) {
+	parents := make(map[uuid.UUID]uuid.UUID, len(tasks))
+	for _, task := range tasks {
+		parents[task.ID] = task.ParentID
+	}
+
+	depths := make(map[uuid.UUID]int, len(tasks))
+	for _, task := range tasks {
+		depth := 0
+		for id := task.ParentID; id != parentID; id = parents[id] {
+			if id == task.ID || depth > len(tasks) {
+				return nil, fmt.Errorf("%w: task %s is its own ancestor", ErrInvalidImport, task.ID)
+			}
+			depth++
+		}
+		depths[task.ID] = depth
+	}
+
+	return depths, nil
+}
+
+// sortByDepth puts the parents before their children, keeping the order of
+// the tasks that are as deep.
+func sortByDepth(tasks []Task, records []storage.Task, depths map[uuid.UUID]int) {
+	order := make([]int, len(tasks))
+	for n := range order {
+		order[n] = n
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(depths[tasks[a].ID], depths[tasks[b].ID])
+	})
+
+	sortedTasks := make([]Task, len(tasks))
+	sortedRecords := make([]storage.Task, len(records))
+	for n, from := range order {
+		sortedTasks[n], sortedRecords[n] = tasks[from], records[from]
+	}
+	copy(tasks, sortedTasks)
+	copy(records, sortedRecords)
+}
+
+// setTotalCosts computes the total cost of every imported task from the own
+// costs of its subtree and returns the top level tasks with theirs. Archived
+// tasks are only in the totals of their ancestors that are not archived when
+// they roll up.
+func (i *Import) setTotalCosts(tasks []Task, records []storage.Task, parentID uuid.UUID) ([]Task, error) {
+	rolledUp := tasks
+	if !i.rollUpArchived {
+		rolledUp = detachArchived(tasks)
+	}
+
+	rollups, err := rollUp(rolledUp, []Metric{NewMetric[money.Money](CostSum{rates: i.rates})})
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]Task, 0)
+	for n, task := range tasks {
+		records[n].TotalCost = rollups[task.ID].Cost.Amount
+		if task.ParentID != parentID {
+			continue
+		}
+
+		task.Cost = rollups[task.ID].Cost
+		if i.rollsUp(task) {
+			if err := i.updateParentCost.Check(parentID, task.Cost); err != nil {
+				return nil, err
+			}
+		}
+		roots = append(roots, task)
+	}
+
+	return roots, nil
+}
+
+// rollsUp reports whether the cost of the top level task is added to the
+// parent of the import.
+func (i *Import) rollsUp(root Task) bool {
+	return i.rollUpArchived || root.ArchivedAt.IsZero()
+}
+
+// setPositions keeps the imported siblings in the order they were imported
+// in, with the top level tasks after the tasks already under the parent. It
+// returns the position of the last top level task.
+func (i *Import) setPositions(records []storage.Task, parentID uuid.UUID) (string, error) {
+	siblings := make(map[string][]int)
+	order := make([]string, 0)
+	for n, record := range records {
+		if _, ok := siblings[record.ParentID.V]; !ok {
+			order = append(order, record.ParentID.V)
+		}
+		siblings[record.ParentID.V] = append(siblings[record.ParentID.V], n)
+	}
+
+	var last string
+	for _, id := range order {
+		if id != parentID.String() {
+			for n, position := range evenPositions(len(siblings[id])) {
+				records[siblings[id][n]].Position = position
+			}
+			continue
+		}
+
+		position, err := i.taskRepo.LastPosition(id)
+		if err != nil {
+			return "", fmt.Errorf("failed to find last position: %w", err)
+		}
+
+		for _, n := range siblings[id] {
+			position = positionAfter(position)
+			records[n].Position = position
+		}
+		last = position
+	}
+
+	return last, nil
+}
@@ -0,0 +1,204 @@
+package tasks
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID  = uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c01")
+		childID = uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c02")
+		leafID  = uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c03")
+		doneAt  = time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'alice'), (2, 'bob')")
+	require.NoError(t, err, "failed to insert users")
+
+	rates, err := money.NewRates("EUR", map[string]string{"USD": "0.5"})
+	require.NoError(t, err, "failed to create rates")
+
+	taskRepo := storage.NewTaskRepository(db)
+	userRepo := storage.NewUserRepository(db)
+	importTasks := NewImport(
+		NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, rates),
+		taskRepo,
+		userRepo,
+		history.NewRecord(storage.NewHistoryRepository(db)),
+		rates,
+		true,
+	)
+
+	tree := []ExportedTask{
+		{ID: rootID, Title: "Renovate", Cost: money.New(100, "EUR"), CreatedBy: "alice"},
+		{ID: childID, ParentID: rootID, Title: "Paint", Cost: money.New(200, "USD"), CreatedBy: "bob"},
+		{
+			ID: leafID, ParentID: childID, Title: "Buy paint", Cost: money.New(50, "USD"), CreatedBy: "carol",
+			Completed: true, CompletedAt: doneAt, CompletedBy: "bob",
+		},
+	}
+
+	preview, err := importTasks.Preview(tree, uuid.Nil, 1)
+	require.NoError(t, err, "failed to preview")
+	require.Len(t, preview.Tasks, 3)
+	assert.Equal(t, money.New(100+125, "EUR"), preview.Tasks[0].Cost, "the preview has the total cost")
+	previewed, err := taskRepo.ListAll()
+	require.NoError(t, err)
+	assert.Empty(t, previewed, "the preview doesn't create anything")
+
+	imported, err := importTasks.Run(tree, uuid.Nil, 1)
+	require.NoError(t, err, "failed to import")
+	assert.Equal(t, map[uuid.UUID]uuid.UUID{rootID: rootID, childID: childID, leafID: leafID}, imported.IDs)
+
+	exported, err := NewExport(taskRepo, userRepo).Run()
+	require.NoError(t, err, "failed to export")
+	require.Len(t, exported, 3)
+	assert.Equal(t, []uuid.UUID{rootID, childID, leafID}, []uuid.UUID{exported[0].ID, exported[1].ID, exported[2].ID})
+	assert.Equal(t, money.New(100+125, "EUR"), exported[0].TotalCost, "total cost is computed on import")
+	assert.Equal(t, money.New(250, "USD"), exported[1].TotalCost)
+	assert.Equal(t, "alice", exported[2].CreatedBy, "unknown users are replaced by the one importing")
+	assert.Equal(t, "bob", exported[2].CompletedBy)
+	assert.Equal(t, doneAt, exported[2].CompletedAt)
+
+	// Importing the same tree again under the first one remaps all the IDs.
+	again, err := importTasks.Run(exported, rootID, 2)
+	require.NoError(t, err, "failed to import again")
+	require.Len(t, again.Tasks, 3)
+	assert.NotEqual(t, rootID, again.IDs[rootID])
+	assert.Equal(t, again.IDs[rootID], again.Tasks[1].ParentID, "parent links follow the new IDs")
+	assert.Equal(t, rootID, again.Tasks[0].ParentID)
+
+	root, err := taskRepo.Find(rootID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*225), root.TotalCost, "the imported subtree is added to the parent")
+
+	// Children can come before their parents in the file.
+	reversed, err := importTasks.Run([]ExportedTask{exported[2], exported[1], exported[0]}, uuid.Nil, 1)
+	require.NoError(t, err, "failed to import reversed tree")
+	assert.Equal(t, "Renovate", reversed.Tasks[0].Title, "parents are created first")
+	assert.Equal(t, "Buy paint", reversed.Tasks[2].Title)
+
+	tests := []struct {
+		name string
+		give []ExportedTask
+	}{
+		{name: "no tasks"},
+		{name: "empty title", give: []ExportedTask{{ID: uuid.New(), Title: " "}}},
+		{name: "unknown parent", give: []ExportedTask{{ID: uuid.New(), ParentID: uuid.New(), Title: "Orphan"}}},
+		{name: "duplicate id", give: []ExportedTask{{ID: leafID, Title: "A"}, {ID: leafID, Title: "B"}}},
+		{
+			name: "cycle",
+			give: []ExportedTask{
+				{ID: uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c11"), ParentID: uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c12"), Title: "A"},
+				{ID: uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c12"), ParentID: uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c11"), Title: "B"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := importTasks.Run(tt.give, uuid.Nil, 1)
+			assert.ErrorIs(t, err, ErrInvalidImport)
+		})
+	}
+
+	_, err = importTasks.Run([]ExportedTask{{ID: uuid.New(), Title: "Pounds", Cost: money.New(1, "GBP")}}, rootID, 1)
+	assert.ErrorIs(t, err, money.ErrCurrencyMismatch, "nothing is imported when the cost can't be added to the parent")
+
+	all, err := taskRepo.ListAll()
+	require.NoError(t, err)
+	assert.Len(t, all, 9, "failed imports don't create anything")
+}
+
+func TestImportArchived(t *testing.T) {
+	t.Parallel()
+
+	var (
+		parentID   = uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c21")
+		openID     = uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c22")
+		archivedID = uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c23")
+		leafID     = uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c24")
+		topID      = uuid.MustParse("9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c25")
+		archivedAt = time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name           string
+		rollUpArchived bool
+		openCost       int64
+		parentCost     int64
+	}{
+		{name: "archived cost is rolled up", rollUpArchived: true, openCost: 1 + 2 + 4, parentCost: 16 + 7 + 8},
+		{name: "archived cost is left out", rollUpArchived: false, openCost: 1, parentCost: 16 + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, err := sqlx.Open("sqlite3", ":memory:")
+			require.NoError(t, err, "failed to open database")
+			t.Cleanup(func() {
+				db.Close()
+			})
+			require.NoError(t, storage.CreateDB(db))
+
+			_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+			require.NoError(t, err, "failed to insert user")
+
+			taskRepo := storage.NewTaskRepository(db)
+			top := sql.Null[string]{V: uuid.Nil.String(), Valid: true}
+			require.NoError(t, taskRepo.Create(storage.Task{
+				ID: parentID.String(), Title: "House", CreatedBy: 1, ParentID: top, Cost: 16, TotalCost: 16, Currency: "EUR",
+			}))
+
+			importTasks := NewImport(
+				NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t)),
+				taskRepo,
+				storage.NewUserRepository(db),
+				history.NewRecord(storage.NewHistoryRepository(db)),
+				testRates(t),
+				tt.rollUpArchived,
+			)
+
+			// open(1) -> archived(2) -> leaf(4), top(8) archived on its own
+			_, err = importTasks.Run([]ExportedTask{
+				{ID: openID, Title: "Renovate", Cost: money.New(1, "EUR")},
+				{ID: archivedID, ParentID: openID, Title: "Paint", Cost: money.New(2, "EUR"), ArchivedAt: archivedAt},
+				{ID: leafID, ParentID: archivedID, Title: "Buy paint", Cost: money.New(4, "EUR"), ArchivedAt: archivedAt},
+				{ID: topID, Title: "Tiles", Cost: money.New(8, "EUR"), ArchivedAt: archivedAt},
+			}, parentID, 1)
+			require.NoError(t, err, "failed to import")
+
+			totalCost := func(id uuid.UUID) int64 {
+				record, err := taskRepo.Find(id.String())
+				require.NoError(t, err)
+
+				return record.TotalCost
+			}
+			assert.Equal(t, tt.openCost, totalCost(openID))
+			assert.Equal(t, int64(2+4), totalCost(archivedID), "the archived subtree still rolls up on its own")
+			assert.Equal(t, int64(8), totalCost(topID))
+			assert.Equal(t, tt.parentCost, totalCost(parentID))
+		})
+	}
+}
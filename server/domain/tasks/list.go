@@ -2,43 +2,115 @@ package tasks
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/attachments"
 	"github.com/zemzale/ubiquitest/storage"
 )
 
 type List struct {
-	db       *sqlx.DB
-	taskRepo *storage.TaksRepository
+	db             *sqlx.DB
+	taskRepo       *storage.TaksRepository
+	assigneeRepo   *storage.AssigneeRepository
+	labelRepo      *storage.LabelRepository
+	attachmentRepo *storage.AttachmentRepository
+	dependencyRepo *storage.DependencyRepository
+	rollUp         *RollUp
 }
 
-func NewList(db *sqlx.DB, taskRepo *storage.TaksRepository) *List {
-	return &List{db: db, taskRepo: taskRepo}
+func NewList(
+	db *sqlx.DB,
+	taskRepo *storage.TaksRepository,
+	assigneeRepo *storage.AssigneeRepository,
+	labelRepo *storage.LabelRepository,
+	attachmentRepo *storage.AttachmentRepository,
+	dependencyRepo *storage.DependencyRepository,
+	rollUp *RollUp,
+) *List {
+	return &List{
+		db:             db,
+		taskRepo:       taskRepo,
+		assigneeRepo:   assigneeRepo,
+		labelRepo:      labelRepo,
+		attachmentRepo: attachmentRepo,
+		dependencyRepo: dependencyRepo,
+		rollUp:         rollUp,
+	}
+}
+
+type ListFilter struct {
+	// ID keeps only the task with this ID.
+	ID uuid.UUID
+	// Overdue keeps only incomplete tasks whose due date has passed.
+	Overdue bool
+	// AssigneeID keeps only the tasks assigned to this user.
+	AssigneeID uint
+	// LabelIDs keeps only the tasks that have all of these labels.
+	LabelIDs []uuid.UUID
+	// Archived returns the archived tasks instead of the ones that are not.
+	Archived bool
 }
 
-func (l *List) Run() ([]Task, error) {
-	tasksRecords, err := l.taskRepo.List()
+func (l *List) Run(filter ListFilter) ([]Task, error) {
+	repoFilter := storage.TaskFilter{
+		ID:         lo.Ternary(filter.ID == uuid.Nil, "", filter.ID.String()),
+		AssigneeID: filter.AssigneeID,
+		LabelIDs:   lo.Map(filter.LabelIDs, func(id uuid.UUID, _ int) string { return id.String() }),
+		Archived:   filter.Archived,
+	}
+	if filter.Overdue {
+		repoFilter.OverdueAt = time.Now()
+	}
+
+	tasksRecords, err := l.taskRepo.List(repoFilter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query tasks: %w", err)
 	}
 
-	tasks := make([]Task, 0, len(tasksRecords))
-	for _, taskRecord := range tasksRecords {
-		parentID := uuid.Nil
-		if taskRecord.ParentID.Valid {
-			parentID = uuid.MustParse(taskRecord.ParentID.V)
-		}
-
-		tasks = append(tasks, Task{
-			ID:        uuid.MustParse(taskRecord.ID),
-			Title:     taskRecord.Title,
-			CreatedBy: taskRecord.CreatedBy,
-			Completed: taskRecord.Completed,
-			ParentID:  parentID,
-			Cost:      taskRecord.TotalCost,
-		})
+	assignees, err := l.assigneeRepo.ListAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assignees: %w", err)
+	}
+
+	labels, err := l.labelRepo.ListAllByTask()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query labels: %w", err)
+	}
+
+	taskAttachments, err := l.attachmentRepo.ListAllByTask()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attachments: %w", err)
+	}
+
+	dependencies, err := l.dependencyRepo.ListAllByTask()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dependencies: %w", err)
+	}
+
+	blockedIDs, err := l.dependencyRepo.ListBlocked()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query blocked tasks: %w", err)
+	}
+	blocked := lo.SliceToMap(blockedIDs, func(id string) (string, bool) { return id, true })
+
+	rollups, err := l.rollUp.Run()
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll up tasks: %w", err)
 	}
 
-	return tasks, nil
+	return lo.Map(tasksRecords, func(t *storage.Task, _ int) Task {
+		task := mapNewTaskFromDB(*t)
+		task.Assignees = assignees[t.ID]
+		task.Labels = lo.Map(labels[t.ID], func(id string, _ int) uuid.UUID { return uuid.MustParse(id) })
+		task.Attachments = lo.Map(taskAttachments[t.ID], func(a storage.Attachment, _ int) attachments.Attachment {
+			return attachments.MapAttachmentFromDB(a)
+		})
+		task.BlockedBy = lo.Map(dependencies[t.ID], func(id string, _ int) uuid.UUID { return uuid.MustParse(id) })
+		task.Blocked = blocked[t.ID]
+		task.Rollup = rollups[task.ID]
+		return task
+	}), nil
 }
@@ -0,0 +1,119 @@
+package tasks
+
+import (
+	"errors"
+	"strings"
+)
+
+// Positions order the siblings of a task by comparing them as strings, so a
+// task can be moved between two others by changing only its own position.
+// They are written with the digits below, which are in ASCII order, and never
+// end with the lowest digit, so there is always room for one more between any
+// two of them.
+const positionDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// maxPositionLength is how long a position can get before the siblings are
+// rebalanced.
+const maxPositionLength = 32
+
+var errPositionOrder = errors.New("positions are not in order")
+
+// positionBetween returns a position that sorts after a and before b. An
+// empty a is before the first sibling and an empty b is after the last one.
+func positionBetween(a string, b string) (string, error) {
+	if b != "" && a >= b {
+		return "", errPositionOrder
+	}
+
+	if b == "" {
+		return positionAfter(a), nil
+	}
+
+	return midpoint(a, b), nil
+}
+
+// positionAfter returns the shortest position after a, incrementing its first
+// digit that can still be incremented.
+func positionAfter(a string) string {
+	for i := 0; i < len(a); i++ {
+		digit := strings.IndexByte(positionDigits, a[i])
+		if digit < len(positionDigits)-1 {
+			return a[:i] + string(positionDigits[digit+1])
+		}
+	}
+
+	return a + string(positionDigits[len(positionDigits)/2])
+}
+
+// midpoint returns a position between a and b, where a < b and b is not
+// empty. Missing digits of a are treated as the lowest digit.
+func midpoint(a string, b string) string {
+	n := 0
+	for n < len(b) && digitAt(a, n) == b[n] {
+		n++
+	}
+	if n > 0 {
+		return b[:n] + midpoint(suffix(a, n), b[n:])
+	}
+
+	low := strings.IndexByte(positionDigits, digitAt(a, 0))
+	high := len(positionDigits)
+	if b != "" {
+		high = strings.IndexByte(positionDigits, b[0])
+	}
+
+	if high-low > 1 {
+		return string(positionDigits[(low+high)/2])
+	}
+
+	// The first digits are next to each other, so anything starting with
+	// the digit of b that is shorter than b works, otherwise the rest has to
+	// go after the rest of a.
+	if len(b) > 1 {
+		return b[:1]
+	}
+
+	return string(positionDigits[low]) + midpoint(suffix(a, 1), "")
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+
+	return positionDigits[0]
+}
+
+func suffix(s string, i int) string {
+	if i < len(s) {
+		return s[i:]
+	}
+
+	return ""
+}
+
+// evenPositions returns n positions of the same length spread evenly over
+// the whole range, leaving room between all of them.
+func evenPositions(n int) []string {
+	base := len(positionDigits)
+	length, capacity := 1, base
+	for capacity <= n {
+		length++
+		capacity *= base
+	}
+
+	positions := make([]string, n)
+	for i := range positions {
+		value := (i + 1) * capacity / (n + 1)
+
+		digits := make([]byte, length)
+		for j := length - 1; j >= 0; j-- {
+			digits[j] = positionDigits[value%base]
+			value /= base
+		}
+
+		positions[i] = strings.TrimRight(string(digits), positionDigits[:1])
+	}
+
+	return positions
+}
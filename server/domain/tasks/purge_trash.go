@@ -0,0 +1,83 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/zemzale/ubiquitest/blob"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type PurgeTrash struct {
+	taskRepo  *storage.TaksRepository
+	blobStore blob.Store
+}
+
+func NewPurgeTrash(taskRepo *storage.TaksRepository, blobStore blob.Store) *PurgeTrash {
+	return &PurgeTrash{taskRepo: taskRepo, blobStore: blobStore}
+}
+
+// Run removes the tasks that were put in the trash before the given time for
+// good and returns how many were removed.
+func (p *PurgeTrash) Run(ctx context.Context, before time.Time) (int, error) {
+	ids, err := p.taskRepo.ListTrashedBefore(before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list trash: %w", err)
+	}
+
+	attachmentIDs, err := p.taskRepo.Purge(ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge tasks: %w", err)
+	}
+
+	// The tasks are already gone, a leftover file only takes up space.
+	for _, attachmentID := range attachmentIDs {
+		if err := p.blobStore.Delete(ctx, attachmentID); err != nil {
+			log.Println("failed to delete blob of purged attachment ", err)
+		}
+	}
+
+	return len(ids), nil
+}
+
+// TrashPurger runs PurgeTrash periodically for everything that has been in the
+// trash longer than the retention.
+type TrashPurger struct {
+	purgeTrash   *PurgeTrash
+	retention    time.Duration
+	pollInterval time.Duration
+}
+
+func NewTrashPurger(purgeTrash *PurgeTrash, retention time.Duration, pollInterval time.Duration) *TrashPurger {
+	return &TrashPurger{purgeTrash: purgeTrash, retention: retention, pollInterval: pollInterval}
+}
+
+// Run blocks until the context is cancelled.
+func (t *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		t.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *TrashPurger) purge(ctx context.Context) {
+	purged, err := t.purgeTrash.Run(ctx, time.Now().Add(-t.retention))
+	if err != nil {
+		log.Println("failed to purge trash ", err)
+		return
+	}
+
+	if purged > 0 {
+		log.Printf("purged %d tasks from the trash\n", purged)
+	}
+}
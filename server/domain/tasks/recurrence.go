@@ -0,0 +1,106 @@
+package tasks
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+type Recurrence struct {
+	// Rule is an RFC 5545 RRULE without the DTSTART, e.g. "FREQ=WEEKLY;BYDAY=MO".
+	// The series starts at the task's due date. Empty when the task doesn't
+	// repeat.
+	Rule string
+	// Timezone is the IANA name of the timezone the rule is evaluated in, so
+	// "every day at 9:00" stays at 9:00 local time across DST changes.
+	// Defaults to UTC.
+	Timezone string
+	// CopySubtree also copies all the subtasks to the next occurrence.
+	CopySubtree bool
+}
+
+func (r Recurrence) IsZero() bool {
+	return r.Rule == ""
+}
+
+func (r Recurrence) location() (*time.Location, error) {
+	loc, err := time.LoadLocation(cmp.Or(r.Timezone, "UTC"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timezone %s: %w", r.Timezone, err)
+	}
+
+	return loc, nil
+}
+
+func (r Recurrence) rrule(dtstart time.Time) (*rrule.RRule, error) {
+	loc, err := r.location()
+	if err != nil {
+		return nil, err
+	}
+
+	option, err := rrule.StrToROptionInLocation(r.Rule, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse recurrence rule: %w", err)
+	}
+
+	option.Dtstart = dtstart.In(loc)
+
+	rule, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func validateRecurrence(task Task) error {
+	if task.Recurrence.IsZero() {
+		return nil
+	}
+
+	if task.DueAt.IsZero() {
+		return fmt.Errorf("recurring task must have a due date")
+	}
+
+	_, err := task.Recurrence.rrule(task.DueAt)
+	return err
+}
+
+// nextOccurrence returns the due date of the occurrence that follows the one
+// due at due and the recurrence the next task should carry. Occurrences that
+// were missed while the task was overdue are skipped, so completing a weekly
+// chore late doesn't create a backlog of already overdue copies. ok is false
+// when the series has ended.
+func nextOccurrence(recurrence Recurrence, due time.Time, now time.Time) (next time.Time, nextRecurrence Recurrence, ok bool, err error) {
+	rule, err := recurrence.rrule(due)
+	if err != nil {
+		return time.Time{}, Recurrence{}, false, err
+	}
+
+	next = rule.After(maxTime(due, now), false)
+	if next.IsZero() {
+		return time.Time{}, Recurrence{}, false, nil
+	}
+
+	nextRecurrence = recurrence
+	if rule.OrigOptions.Count > 0 {
+		// The rule of the next task starts from the next occurrence, so COUNT
+		// has to be reduced by this occurrence and all the skipped ones.
+		option := rule.OrigOptions
+		option.Dtstart = time.Time{}
+		option.Count -= 1 + len(rule.Between(due, next, false))
+		nextRecurrence.Rule = option.RRuleString()
+	}
+
+	return next.UTC(), nextRecurrence, true, nil
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,163 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	riga, err := time.LoadLocation("Europe/Riga")
+	require.NoError(t, err, "failed to load timezone")
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err, "failed to load timezone")
+
+	tests := []struct {
+		name               string
+		giveRecurrence     Recurrence
+		giveDue            time.Time
+		giveNow            time.Time
+		want               time.Time
+		wantRecurrenceRule string
+		wantEnded          bool
+	}{
+		{
+			name:           "daily keeps local time when DST starts",
+			giveRecurrence: Recurrence{Rule: "FREQ=DAILY", Timezone: "Europe/Riga"},
+			giveDue:        time.Date(2025, 3, 29, 9, 0, 0, 0, riga),
+			giveNow:        time.Date(2025, 3, 29, 8, 0, 0, 0, riga),
+			want:           time.Date(2025, 3, 30, 9, 0, 0, 0, riga),
+		},
+		{
+			name:           "daily keeps local time when DST ends",
+			giveRecurrence: Recurrence{Rule: "FREQ=DAILY", Timezone: "Europe/Riga"},
+			giveDue:        time.Date(2025, 10, 25, 9, 0, 0, 0, riga),
+			giveNow:        time.Date(2025, 10, 25, 8, 0, 0, 0, riga),
+			want:           time.Date(2025, 10, 26, 9, 0, 0, 0, riga),
+		},
+		{
+			name:           "weekly across DST in another timezone",
+			giveRecurrence: Recurrence{Rule: "FREQ=WEEKLY;BYDAY=MO", Timezone: "America/New_York"},
+			giveDue:        time.Date(2025, 3, 3, 9, 0, 0, 0, newYork),
+			giveNow:        time.Date(2025, 3, 3, 8, 0, 0, 0, newYork),
+			want:           time.Date(2025, 3, 10, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:           "UTC rule drifts in local time across DST",
+			giveRecurrence: Recurrence{Rule: "FREQ=DAILY"},
+			giveDue:        time.Date(2025, 3, 29, 7, 0, 0, 0, time.UTC),
+			giveNow:        time.Date(2025, 3, 29, 6, 0, 0, 0, time.UTC),
+			want:           time.Date(2025, 3, 30, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "last day of the month",
+			giveRecurrence: Recurrence{Rule: "FREQ=MONTHLY;BYMONTHDAY=-1"},
+			giveDue:        time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC),
+			giveNow:        time.Date(2025, 1, 31, 10, 0, 0, 0, time.UTC),
+			want:           time.Date(2025, 2, 28, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "last day of the month in a leap year",
+			giveRecurrence: Recurrence{Rule: "FREQ=MONTHLY;BYMONTHDAY=-1"},
+			giveDue:        time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC),
+			giveNow:        time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC),
+			want:           time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "monthly on the 31st skips shorter months",
+			giveRecurrence: Recurrence{Rule: "FREQ=MONTHLY"},
+			giveDue:        time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC),
+			giveNow:        time.Date(2025, 1, 31, 10, 0, 0, 0, time.UTC),
+			want:           time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "completing late skips missed occurrences",
+			giveRecurrence: Recurrence{Rule: "FREQ=WEEKLY;BYDAY=MO"},
+			giveDue:        time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC),
+			giveNow:        time.Date(2025, 3, 12, 9, 0, 0, 0, time.UTC),
+			want:           time.Date(2025, 3, 17, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:               "count is reduced for the next occurrence",
+			giveRecurrence:     Recurrence{Rule: "FREQ=DAILY;COUNT=3"},
+			giveDue:            time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC),
+			giveNow:            time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC),
+			want:               time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC),
+			wantRecurrenceRule: "FREQ=DAILY;COUNT=2",
+		},
+		{
+			name:               "count includes skipped occurrences",
+			giveRecurrence:     Recurrence{Rule: "FREQ=DAILY;COUNT=5"},
+			giveDue:            time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC),
+			giveNow:            time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC),
+			want:               time.Date(2025, 3, 6, 9, 0, 0, 0, time.UTC),
+			wantRecurrenceRule: "FREQ=DAILY;COUNT=2",
+		},
+		{
+			name:           "last occurrence of a count",
+			giveRecurrence: Recurrence{Rule: "FREQ=DAILY;COUNT=1"},
+			giveDue:        time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC),
+			giveNow:        time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC),
+			wantEnded:      true,
+		},
+		{
+			name:           "until has passed",
+			giveRecurrence: Recurrence{Rule: "FREQ=WEEKLY;UNTIL=20250305T000000Z"},
+			giveDue:        time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC),
+			giveNow:        time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC),
+			wantEnded:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotRecurrence, ok, err := nextOccurrence(tt.giveRecurrence, tt.giveDue, tt.giveNow)
+			require.NoError(t, err, "failed to calculate next occurrence")
+
+			if tt.wantEnded {
+				assert.False(t, ok, "expected the series to end")
+				return
+			}
+
+			require.True(t, ok, "expected a next occurrence")
+			assert.True(t, tt.want.Equal(got), "expected %s, got %s", tt.want, got)
+
+			wantRule := tt.giveRecurrence.Rule
+			if tt.wantRecurrenceRule != "" {
+				wantRule = tt.wantRecurrenceRule
+			}
+			assert.Equal(t, wantRule, gotRecurrence.Rule)
+		})
+	}
+}
+
+func TestValidateRecurrence(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		give    Task
+		wantErr bool
+	}{
+		{name: "no recurrence", give: Task{}},
+		{name: "valid", give: Task{DueAt: due, Recurrence: Recurrence{Rule: "FREQ=WEEKLY", Timezone: "Europe/Riga"}}},
+		{name: "missing due date", give: Task{Recurrence: Recurrence{Rule: "FREQ=WEEKLY"}}, wantErr: true},
+		{name: "invalid rule", give: Task{DueAt: due, Recurrence: Recurrence{Rule: "FREQ=SOMETIMES"}}, wantErr: true},
+		{name: "unknown timezone", give: Task{DueAt: due, Recurrence: Recurrence{Rule: "FREQ=DAILY", Timezone: "Mars/Olympus"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRecurrence(tt.give)
+			if tt.wantErr {
+				assert.Error(t, err, "expected error")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// ErrNotSibling is returned when moving a task next to a task with another
+// parent.
+var ErrNotSibling = errors.New("tasks don't have the same parent")
+
+// TaskPosition is the new position of a task.
+type TaskPosition struct {
+	ID       uuid.UUID
+	Position string
+}
+
+// Reordered are the tasks whose position changed, usually only the moved
+// task, but all the siblings when they had to be rebalanced.
+type Reordered struct {
+	ParentID  uuid.UUID
+	Positions []TaskPosition
+}
+
+// Placement is where a task is among its siblings, between the ones with
+// AfterID and BeforeID. Either is uuid.Nil at the start or the end.
+type Placement struct {
+	AfterID  uuid.UUID
+	BeforeID uuid.UUID
+}
+
+type Reorder struct {
+	taskRepo      *storage.TaksRepository
+	recordHistory *history.Record
+}
+
+func NewReorder(taskRepo *storage.TaksRepository, recordHistory *history.Record) *Reorder {
+	return &Reorder{taskRepo: taskRepo, recordHistory: recordHistory}
+}
+
+// Run moves the task right after afterID, or right before beforeID when
+// afterID is not set, among its siblings. With neither set it's moved to the
+// end. The operation moves the task back to where it was when undone.
+func (r *Reorder) Run(id uuid.UUID, afterID uuid.UUID, beforeID uuid.UUID, actorID uint) (Reordered, Operation, error) {
+	record, err := r.taskRepo.Find(id.String())
+	if err != nil {
+		return Reordered{}, Operation{}, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	if record.DeletedAt.Valid {
+		return Reordered{}, Operation{}, ErrTrashed
+	}
+
+	if record.ArchivedAt.Valid {
+		return Reordered{}, Operation{}, ErrArchived
+	}
+
+	siblings, err := r.taskRepo.ListSiblings(record.ParentID.V)
+	if err != nil {
+		return Reordered{}, Operation{}, err
+	}
+
+	from := placementAt(siblings, slices.IndexFunc(siblings, func(t *storage.Task) bool { return t.ID == record.ID }))
+	siblings = slices.DeleteFunc(siblings, func(t *storage.Task) bool { return t.ID == record.ID })
+
+	task := mapStoredTaskFromDB(*record)
+	reordered := Reordered{ParentID: task.ParentID}
+	op := Operation{Kind: OperationMove, Before: task, After: task, From: from, To: from}
+	if afterID == id || beforeID == id {
+		reordered.Positions = []TaskPosition{{ID: id, Position: record.Position}}
+		return reordered, op, nil
+	}
+
+	index := len(siblings)
+	switch {
+	case afterID != uuid.Nil:
+		index = slices.IndexFunc(siblings, func(t *storage.Task) bool { return t.ID == afterID.String() })
+		if index == -1 {
+			return Reordered{}, Operation{}, ErrNotSibling
+		}
+		index++
+	case beforeID != uuid.Nil:
+		index = slices.IndexFunc(siblings, func(t *storage.Task) bool { return t.ID == beforeID.String() })
+		if index == -1 {
+			return Reordered{}, Operation{}, ErrNotSibling
+		}
+	}
+
+	reordered.Positions, err = r.move(record, siblings, index)
+	if err != nil {
+		return Reordered{}, Operation{}, err
+	}
+
+	op.To = placementAt(slices.Insert(siblings, index, record), index)
+	for _, position := range reordered.Positions {
+		if position.ID == id {
+			op.After.Position = position.Position
+		}
+	}
+
+	change := history.Change{Field: history.FieldPosition, OldValue: record.Position, NewValue: op.After.Position}
+	if err := r.recordHistory.Run(id, actorID, change); err != nil {
+		log.Println("failed to record history of reordered task ", err)
+	}
+
+	return reordered, op, nil
+}
+
+// placementAt returns between which siblings the one at the index is.
+func placementAt(siblings []*storage.Task, index int) Placement {
+	placement := Placement{}
+	if index > 0 {
+		placement.AfterID = uuid.MustParse(siblings[index-1].ID)
+	}
+	if index+1 < len(siblings) {
+		placement.BeforeID = uuid.MustParse(siblings[index+1].ID)
+	}
+
+	return placement
+}
+
+// move puts the task at the index among the other siblings and returns the
+// positions that changed.
+func (r *Reorder) move(record *storage.Task, siblings []*storage.Task, index int) ([]TaskPosition, error) {
+	lower, upper := "", ""
+	if index > 0 {
+		lower = siblings[index-1].Position
+	}
+	if index < len(siblings) {
+		upper = siblings[index].Position
+	}
+
+	// Siblings can share a position, e.g. the ones created before positions
+	// existed, then there is no room between them without rebalancing.
+	position, err := positionBetween(lower, upper)
+	if err != nil || len(position) > maxPositionLength {
+		return rebalance(r.taskRepo, record.ParentID.V, record, index)
+	}
+
+	if err := r.taskRepo.UpdatePositions(map[string]string{record.ID: position}); err != nil {
+		return nil, err
+	}
+
+	return []TaskPosition{{ID: uuid.MustParse(record.ID), Position: position}}, nil
+}
+
+// rebalance spreads the positions of all the children of the parent evenly,
+// so the positions are short again. If moved is set, it's put at the index
+// among the other siblings first.
+func rebalance(taskRepo *storage.TaksRepository, parentID string, moved *storage.Task, index int) ([]TaskPosition, error) {
+	siblings, err := taskRepo.ListSiblings(parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if moved != nil {
+		siblings = slices.DeleteFunc(siblings, func(t *storage.Task) bool { return t.ID == moved.ID })
+		siblings = slices.Insert(siblings, index, moved)
+	}
+
+	positions := evenPositions(len(siblings))
+	updates := make(map[string]string, len(siblings))
+	taskPositions := make([]TaskPosition, 0, len(siblings))
+	for i, sibling := range siblings {
+		updates[sibling.ID] = positions[i]
+		taskPositions = append(taskPositions, TaskPosition{ID: uuid.MustParse(sibling.ID), Position: positions[i]})
+	}
+
+	if err := taskRepo.UpdatePositions(updates); err != nil {
+		return nil, fmt.Errorf("failed to rebalance positions: %w", err)
+	}
+
+	return taskPositions, nil
+}
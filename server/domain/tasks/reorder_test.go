@@ -0,0 +1,180 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestPositionBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{name: "empty list", a: "", b: ""},
+		{name: "after last", a: "V", b: ""},
+		{name: "after all z", a: "zz", b: ""},
+		{name: "before first", a: "", b: "V"},
+		{name: "before lowest", a: "", b: "01"},
+		{name: "between far apart", a: "A", b: "z"},
+		{name: "between adjacent digits", a: "A", b: "B"},
+		{name: "between prefix", a: "A", b: "A1"},
+		{name: "between longer", a: "Az5", b: "B0V"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			position, err := positionBetween(tt.a, tt.b)
+			require.NoError(t, err)
+			assert.Greater(t, position, tt.a)
+			if tt.b != "" {
+				assert.Less(t, position, tt.b)
+			}
+			assert.False(t, strings.HasSuffix(position, "0"), "positions must not end with the lowest digit")
+		})
+	}
+
+	_, err := positionBetween("B", "A")
+	assert.ErrorIs(t, err, errPositionOrder)
+
+	_, err = positionBetween("A", "A")
+	assert.ErrorIs(t, err, errPositionOrder)
+}
+
+func TestEvenPositions(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 61, 62, 500} {
+		positions := evenPositions(n)
+		require.Len(t, positions, n)
+		for i := 1; i < n; i++ {
+			assert.Less(t, positions[i-1], positions[i], "positions must be increasing for n=%d", n)
+		}
+	}
+}
+
+func TestReorder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		parentID = uuid.MustParse("3c9a1f0e-6b7d-4e2a-8f15-0d4c3b2a1901")
+		firstID  = uuid.MustParse("3c9a1f0e-6b7d-4e2a-8f15-0d4c3b2a1902")
+		secondID = uuid.MustParse("3c9a1f0e-6b7d-4e2a-8f15-0d4c3b2a1903")
+		thirdID  = uuid.MustParse("3c9a1f0e-6b7d-4e2a-8f15-0d4c3b2a1904")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+	require.NoError(t, err, "failed to insert user")
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, testRates(t))
+	list := NewList(
+		db, taskRepo, assigneeRepo, storage.NewLabelRepository(db), storage.NewAttachmentRepository(db),
+		storage.NewDependencyRepository(db), NewRollUp(taskRepo, DefaultMetrics(testRates(t)), true),
+	)
+	reorder := NewReorder(taskRepo, recordHistory)
+
+	require.NoError(t, store.Run(Task{ID: parentID, Title: "Groceries", CreatedBy: 1}))
+	for _, task := range []Task{
+		{ID: firstID, Title: "Milk", CreatedBy: 1, ParentID: parentID},
+		{ID: secondID, Title: "Bread", CreatedBy: 1, ParentID: parentID},
+		{ID: thirdID, Title: "Eggs", CreatedBy: 1, ParentID: parentID},
+	} {
+		require.NoError(t, store.Run(task))
+	}
+
+	children := func() []uuid.UUID {
+		tasks, err := list.Run(ListFilter{})
+		require.NoError(t, err)
+
+		return lo.FilterMap(tasks, func(task Task, _ int) (uuid.UUID, bool) {
+			return task.ID, task.ParentID == parentID
+		})
+	}
+	require.Equal(t, []uuid.UUID{firstID, secondID, thirdID}, children(), "new tasks must be added at the end")
+
+	reordered, _, err := reorder.Run(thirdID, uuid.Nil, firstID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, parentID, reordered.ParentID)
+	assert.Len(t, reordered.Positions, 1, "a move must only change the moved task")
+	assert.Equal(t, []uuid.UUID{thirdID, firstID, secondID}, children())
+
+	_, _, err = reorder.Run(thirdID, secondID, uuid.Nil, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{firstID, secondID, thirdID}, children())
+
+	_, _, err = reorder.Run(firstID, uuid.Nil, uuid.Nil, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{secondID, thirdID, firstID}, children())
+
+	_, _, err = reorder.Run(firstID, parentID, uuid.Nil, 1)
+	assert.ErrorIs(t, err, ErrNotSibling)
+
+	// Moves are undone by moving the task back between the same siblings.
+	stack := NewUndoStack(10)
+	update := NewUpdate(updateParentCost, taskRepo, storage.NewDependencyRepository(db), NewCreateNextOccurrence(store, taskRepo, assigneeRepo, recordHistory), recordHistory, testRates(t))
+	deleteTask := NewDelete(updateParentCost, taskRepo, recordHistory)
+	restore := NewRestore(updateParentCost, taskRepo, assigneeRepo, recordHistory)
+	undo := NewUndo(stack, update, deleteTask, restore, reorder)
+	redo := NewRedo(stack, update, deleteTask, restore, reorder)
+
+	_, op, err := reorder.Run(firstID, uuid.Nil, secondID, 1)
+	require.NoError(t, err)
+	stack.Push(1, op)
+	require.Equal(t, []uuid.UUID{firstID, secondID, thirdID}, children())
+
+	changes, err := undo.Run(1)
+	require.NoError(t, err)
+	require.Len(t, changes.Reordered, 1)
+	assert.Equal(t, []uuid.UUID{secondID, thirdID, firstID}, children())
+
+	_, err = redo.Run(1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{firstID, secondID, thirdID}, children())
+
+	_, err = undo.Run(1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{secondID, thirdID, firstID}, children())
+
+	entries, err := history.NewListByTask(storage.NewHistoryRepository(db)).Run(firstID)
+	require.NoError(t, err)
+	assert.Len(t, lo.Filter(entries, func(e history.Entry, _ int) bool { return e.Field == history.FieldPosition }), 5,
+		"every move, undo and redo must be in the history")
+
+	// Moving a task to the front over and over makes the positions longer
+	// until the siblings get rebalanced.
+	rebalanced := false
+	for i := 0; i < 200; i++ {
+		moved := lo.Ternary(i%2 == 0, secondID, thirdID)
+		other := lo.Ternary(i%2 == 0, thirdID, secondID)
+		reordered, _, err := reorder.Run(moved, uuid.Nil, other, 1)
+		require.NoError(t, err)
+		for _, position := range reordered.Positions {
+			assert.LessOrEqual(t, len(position.Position), maxPositionLength)
+		}
+		rebalanced = rebalanced || len(reordered.Positions) == 3
+	}
+	assert.True(t, rebalanced, "the siblings must be rebalanced when positions grow too long")
+	assert.Equal(t, []uuid.UUID{thirdID, secondID, firstID}, children())
+}
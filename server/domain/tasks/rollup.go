@@ -0,0 +1,289 @@
+package tasks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// Rollup has the metrics of the whole subtree of a task, the task included.
+type Rollup struct {
+	// Cost is the sum of the own costs in the currency of the task.
+	Cost money.Money
+	// RemainingCost is the sum of the own costs of the incomplete tasks.
+	RemainingCost money.Money
+	Descendants   int
+	// PercentComplete is the share of completed tasks, from 0 to 100.
+	PercentComplete float64
+	// MaxDueAt is the latest due date, the zero time when nothing is due.
+	MaxDueAt time.Time
+}
+
+func (r Rollup) IsZero() bool {
+	return r == Rollup{}
+}
+
+// Aggregator folds the subtree of a task into a metric, T is the value of a
+// subtree while it's being folded.
+type Aggregator[T any] interface {
+	// Value returns the value of the task on its own.
+	Value(task Task) (T, error)
+	// Merge adds the value of the subtree of a child to its parent.
+	Merge(parent T, child T) (T, error)
+	// Set writes the value of the whole subtree to the rollup.
+	Set(rollup *Rollup, value T)
+}
+
+// Metric is an aggregator that can be used by RollUp, see NewMetric.
+type Metric interface {
+	value(task Task) (any, error)
+	merge(parent any, child any) (any, error)
+	set(rollup *Rollup, value any)
+}
+
+// NewMetric wraps the aggregator so it can be computed together with others.
+func NewMetric[T any](aggregator Aggregator[T]) Metric {
+	return metric[T]{aggregator: aggregator}
+}
+
+type metric[T any] struct {
+	aggregator Aggregator[T]
+}
+
+func (m metric[T]) value(task Task) (any, error) {
+	return m.aggregator.Value(task)
+}
+
+func (m metric[T]) merge(parent any, child any) (any, error) {
+	return m.aggregator.Merge(parent.(T), child.(T))
+}
+
+func (m metric[T]) set(rollup *Rollup, value any) {
+	m.aggregator.Set(rollup, value.(T))
+}
+
+// DefaultMetrics returns all the metrics of Rollup.
+func DefaultMetrics(rates *money.Rates) []Metric {
+	return []Metric{
+		NewMetric[money.Money](CostSum{rates: rates}),
+		NewMetric[money.Money](RemainingCostSum{rates: rates}),
+		NewMetric[int](DescendantCount{}),
+		NewMetric[completion](PercentComplete{}),
+		NewMetric[time.Time](MaxDueAt{}),
+	}
+}
+
+// RollUp computes the metrics of every task that is not in the trash.
+type RollUp struct {
+	taskRepo *storage.TaksRepository
+	metrics  []Metric
+	// rollUpArchived keeps archived tasks in the metrics of their ancestors
+	// that are not archived.
+	rollUpArchived bool
+}
+
+func NewRollUp(taskRepo *storage.TaksRepository, metrics []Metric, rollUpArchived bool) *RollUp {
+	return &RollUp{taskRepo: taskRepo, metrics: metrics, rollUpArchived: rollUpArchived}
+}
+
+func (r *RollUp) Run() (map[uuid.UUID]Rollup, error) {
+	records, err := r.taskRepo.ListNotTrashed()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	tasks := make([]Task, 0, len(records))
+	for _, record := range records {
+		tasks = append(tasks, mapStoredTaskFromDB(*record))
+	}
+
+	if !r.rollUpArchived {
+		tasks = detachArchived(tasks)
+	}
+
+	return rollUp(tasks, r.metrics)
+}
+
+// detachArchived makes the archived tasks under a task that is not archived
+// roots, so their subtrees are left out of the metrics of the ancestors.
+func detachArchived(tasks []Task) []Task {
+	archived := make(map[uuid.UUID]bool, len(tasks))
+	for _, task := range tasks {
+		archived[task.ID] = !task.ArchivedAt.IsZero()
+	}
+
+	detached := make([]Task, 0, len(tasks))
+	for _, task := range tasks {
+		if archived[task.ID] && !archived[task.ParentID] {
+			task.ParentID = uuid.Nil
+		}
+		detached = append(detached, task)
+	}
+
+	return detached
+}
+
+// rollUp computes all the metrics in a single pass over the tasks, which have
+// their own cost. Tasks whose parent is not among them are the roots.
+func rollUp(tasks []Task, metrics []Metric) (map[uuid.UUID]Rollup, error) {
+	byID := make(map[uuid.UUID]Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
+	}
+
+	children := make(map[uuid.UUID][]uuid.UUID)
+	roots := make([]uuid.UUID, 0)
+	for _, task := range tasks {
+		if _, ok := byID[task.ParentID]; !ok {
+			roots = append(roots, task.ID)
+			continue
+		}
+
+		children[task.ParentID] = append(children[task.ParentID], task.ID)
+	}
+
+	rollups := make(map[uuid.UUID]Rollup, len(tasks))
+	var fold func(id uuid.UUID) ([]any, error)
+	fold = func(id uuid.UUID) ([]any, error) {
+		task := byID[id]
+		values := make([]any, len(metrics))
+		for i, m := range metrics {
+			value, err := m.value(task)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compute %s: %w", id, err)
+			}
+			values[i] = value
+		}
+
+		for _, childID := range children[id] {
+			childValues, err := fold(childID)
+			if err != nil {
+				return nil, err
+			}
+
+			for i, m := range metrics {
+				values[i], err = m.merge(values[i], childValues[i])
+				if err != nil {
+					return nil, fmt.Errorf("failed to add %s to %s: %w", childID, id, err)
+				}
+			}
+		}
+
+		rollup := Rollup{}
+		for i, m := range metrics {
+			m.set(&rollup, values[i])
+		}
+		rollups[id] = rollup
+
+		return values, nil
+	}
+
+	for _, id := range roots {
+		if _, err := fold(id); err != nil {
+			return nil, err
+		}
+	}
+
+	return rollups, nil
+}
+
+// CostSum adds up the costs, converted to the currency of the parent.
+type CostSum struct {
+	rates *money.Rates
+}
+
+func (c CostSum) Value(task Task) (money.Money, error) {
+	return task.Cost, nil
+}
+
+func (c CostSum) Merge(parent money.Money, child money.Money) (money.Money, error) {
+	return c.rates.Add(parent, child)
+}
+
+func (c CostSum) Set(rollup *Rollup, value money.Money) {
+	rollup.Cost = value
+}
+
+// RemainingCostSum adds up the costs of the incomplete tasks.
+type RemainingCostSum struct {
+	rates *money.Rates
+}
+
+func (c RemainingCostSum) Value(task Task) (money.Money, error) {
+	if task.Completed {
+		return money.New(0, task.Cost.Currency), nil
+	}
+
+	return task.Cost, nil
+}
+
+func (c RemainingCostSum) Merge(parent money.Money, child money.Money) (money.Money, error) {
+	return c.rates.Add(parent, child)
+}
+
+func (c RemainingCostSum) Set(rollup *Rollup, value money.Money) {
+	rollup.RemainingCost = value
+}
+
+// DescendantCount counts the tasks below the task.
+type DescendantCount struct{}
+
+func (DescendantCount) Value(Task) (int, error) {
+	return 1, nil
+}
+
+func (DescendantCount) Merge(parent int, child int) (int, error) {
+	return parent + child, nil
+}
+
+func (DescendantCount) Set(rollup *Rollup, value int) {
+	// The count includes the task itself.
+	rollup.Descendants = value - 1
+}
+
+type completion struct {
+	completed int
+	total     int
+}
+
+// PercentComplete is the share of completed tasks, every task has the same
+// weight no matter its cost.
+type PercentComplete struct{}
+
+func (PercentComplete) Value(task Task) (completion, error) {
+	if task.Completed {
+		return completion{completed: 1, total: 1}, nil
+	}
+
+	return completion{total: 1}, nil
+}
+
+func (PercentComplete) Merge(parent completion, child completion) (completion, error) {
+	return completion{completed: parent.completed + child.completed, total: parent.total + child.total}, nil
+}
+
+func (PercentComplete) Set(rollup *Rollup, value completion) {
+	rollup.PercentComplete = 100 * float64(value.completed) / float64(value.total)
+}
+
+// MaxDueAt finds the latest due date.
+type MaxDueAt struct{}
+
+func (MaxDueAt) Value(task Task) (time.Time, error) {
+	return task.DueAt, nil
+}
+
+func (MaxDueAt) Merge(parent time.Time, child time.Time) (time.Time, error) {
+	if child.After(parent) {
+		return child, nil
+	}
+
+	return parent, nil
+}
+
+func (MaxDueAt) Set(rollup *Rollup, value time.Time) {
+	rollup.MaxDueAt = value
+}
@@ -0,0 +1,122 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/money"
+)
+
+func TestRollUp(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID   = uuid.MustParse("7f3e4d5c-6b7a-4c8d-8e9f-1a2b3c4d5e01")
+		parentID = uuid.MustParse("7f3e4d5c-6b7a-4c8d-8e9f-1a2b3c4d5e02")
+		childID1 = uuid.MustParse("7f3e4d5c-6b7a-4c8d-8e9f-1a2b3c4d5e03")
+		childID2 = uuid.MustParse("7f3e4d5c-6b7a-4c8d-8e9f-1a2b3c4d5e04")
+		early    = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+		late     = time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	rates, err := money.NewRates("EUR", map[string]string{"USD": "0.5"})
+	require.NoError(t, err, "failed to create rates")
+
+	tests := []struct {
+		name    string
+		give    []Task
+		want    map[uuid.UUID]Rollup
+		wantErr error
+	}{
+		{
+			name: "roll up all metrics",
+			// root -> parent -> child1 (completed), child2
+			give: []Task{
+				{ID: rootID, Cost: money.New(100, "EUR"), DueAt: early},
+				{ID: parentID, ParentID: rootID, Cost: money.New(200, "USD")},
+				{ID: childID1, ParentID: parentID, Cost: money.New(10, "USD"), Completed: true, DueAt: late},
+				{ID: childID2, ParentID: parentID, Cost: money.New(20, "EUR")},
+			},
+			want: map[uuid.UUID]Rollup{
+				rootID: {
+					Cost:            money.New(100+100+5+20, "EUR"),
+					RemainingCost:   money.New(100+100+20, "EUR"),
+					Descendants:     3,
+					PercentComplete: 25,
+					MaxDueAt:        late,
+				},
+				parentID: {
+					Cost:            money.New(200+10+40, "USD"),
+					RemainingCost:   money.New(200+40, "USD"),
+					Descendants:     2,
+					PercentComplete: 100.0 / 3,
+					MaxDueAt:        late,
+				},
+				childID1: {
+					Cost:            money.New(10, "USD"),
+					RemainingCost:   money.New(0, "USD"),
+					PercentComplete: 100,
+					MaxDueAt:        late,
+				},
+				childID2: {
+					Cost:          money.New(20, "EUR"),
+					RemainingCost: money.New(20, "EUR"),
+				},
+			},
+		},
+		{
+			name: "task with parent outside of the tasks is a root",
+			give: []Task{
+				{ID: childID1, ParentID: parentID, Cost: money.New(10, "EUR")},
+			},
+			want: map[uuid.UUID]Rollup{
+				childID1: {Cost: money.New(10, "EUR"), RemainingCost: money.New(10, "EUR")},
+			},
+		},
+		{
+			name: "refuse currency without rate",
+			give: []Task{
+				{ID: rootID, Cost: money.New(100, "EUR")},
+				{ID: parentID, ParentID: rootID, Cost: money.New(200, "GBP")},
+			},
+			wantErr: money.ErrCurrencyMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := rollUp(tt.give, DefaultMetrics(rates))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// titleLength is an aggregator that isn't part of the default metrics.
+type titleLength struct{}
+
+func (titleLength) Value(task Task) (int, error)             { return len(task.Title), nil }
+func (titleLength) Merge(parent int, child int) (int, error) { return parent + child, nil }
+func (titleLength) Set(rollup *Rollup, value int)            { rollup.Descendants = value }
+
+func TestRollUpCustomMetric(t *testing.T) {
+	t.Parallel()
+
+	rootID := uuid.MustParse("7f3e4d5c-6b7a-4c8d-8e9f-1a2b3c4d5e05")
+	got, err := rollUp([]Task{
+		{ID: rootID, Title: "Plan"},
+		{ID: uuid.MustParse("7f3e4d5c-6b7a-4c8d-8e9f-1a2b3c4d5e06"), Title: "Book", ParentID: rootID},
+	}, []Metric{NewMetric[int](titleLength{})})
+	require.NoError(t, err)
+
+	assert.Equal(t, 8, got[rootID].Descendants)
+}
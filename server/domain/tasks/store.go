@@ -1,21 +1,45 @@
 package tasks
 
 import (
+	"database/sql"
 	"fmt"
+	"log"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
 	"github.com/zemzale/ubiquitest/storage"
 )
 
 type Store struct {
 	updateParentCost *UpdateParentCost
 
-	taskRepo *storage.TaksRepository
-	userRepo *storage.UserRepository
+	taskRepo     *storage.TaksRepository
+	userRepo     *storage.UserRepository
+	assigneeRepo *storage.AssigneeRepository
+
+	recordHistory *history.Record
+	rates         *money.Rates
 }
 
-func NewStore(updateParentCost *UpdateParentCost, insertTask *storage.TaksRepository, userRepo *storage.UserRepository) *Store {
-	return &Store{updateParentCost: updateParentCost, taskRepo: insertTask, userRepo: userRepo}
+func NewStore(
+	updateParentCost *UpdateParentCost,
+	insertTask *storage.TaksRepository,
+	userRepo *storage.UserRepository,
+	assigneeRepo *storage.AssigneeRepository,
+	recordHistory *history.Record,
+	rates *money.Rates,
+) *Store {
+	return &Store{
+		updateParentCost: updateParentCost,
+		taskRepo:         insertTask,
+		userRepo:         userRepo,
+		assigneeRepo:     assigneeRepo,
+		recordHistory:    recordHistory,
+		rates:            rates,
+	}
 }
 
 func (s *Store) Run(task Task) error {
@@ -28,15 +52,80 @@ func (s *Store) Run(task Task) error {
 		return fmt.Errorf("user doesn't exist")
 	}
 
+	if err := validateRecurrence(task); err != nil {
+		return err
+	}
+
+	for _, assignee := range task.Assignees {
+		if _, err := s.userRepo.Exists(assignee); err != nil {
+			return fmt.Errorf("assignee doesn't exist: %w", err)
+		}
+	}
+
 	err = s.checkIfParentExists(task.ParentID)
 	if err != nil {
 		return fmt.Errorf("failed to check if parent exists: %w", err)
 	}
 
-	if err := s.taskRepo.Create(mapNewTaskToDB(task)); err != nil {
+	task.Cost, err = s.rates.Normalize(task.Cost)
+	if err != nil {
+		return err
+	}
+
+	task.Budget, err = budgetIn(s.rates, task.Budget, task.Cost.Currency)
+	if err != nil {
+		return err
+	}
+
+	if err := s.updateParentCost.Check(task.ParentID, task.Cost); err != nil {
+		return err
+	}
+
+	record := mapNewTaskToDB(task)
+	if task.Completed {
+		record.CompletedBy = sql.Null[uint]{V: task.CreatedBy, Valid: true}
+		record.CompletedAt = mapTimeToDB(time.Now())
+	}
+
+	lastPosition, err := s.taskRepo.LastPosition(record.ParentID.V)
+	if err != nil {
+		return fmt.Errorf("failed to find last position: %w", err)
+	}
+	record.Position = positionAfter(lastPosition)
+
+	if err := s.taskRepo.Create(record); err != nil {
 		return fmt.Errorf("failed to insert task: %w", err)
 	}
 
+	if len(record.Position) > maxPositionLength {
+		if _, err := rebalance(s.taskRepo, record.ParentID.V, nil, 0); err != nil {
+			log.Println("failed to rebalance positions ", err)
+		}
+	}
+
+	for _, assignee := range task.Assignees {
+		_, err := s.assigneeRepo.Create(storage.Assignee{
+			TaskID:     task.ID.String(),
+			UserID:     assignee,
+			AssignedBy: task.CreatedBy,
+			AssignedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to assign user: %w", err)
+		}
+	}
+
+	changes := []history.Change{{Field: history.FieldCreated, NewValue: task.Title}}
+	for _, assignee := range task.Assignees {
+		changes = append(changes, history.Change{
+			Field:    history.FieldAssignee,
+			NewValue: strconv.FormatUint(uint64(assignee), 10),
+		})
+	}
+	if err := s.recordHistory.Run(task.ID, task.CreatedBy, changes...); err != nil {
+		log.Println("failed to record history of created task ", err)
+	}
+
 	if err := s.updateParentCost.Run(task.ParentID, task.Cost); err != nil {
 		return fmt.Errorf("failed to update parent cost: %w", err)
 	}
@@ -44,6 +133,41 @@ func (s *Store) Run(task Task) error {
 	return nil
 }
 
+// Revert removes the tasks Run stored for good and takes their cost back out
+// of the ancestors that are kept, for when only some of the tasks that belong
+// together could be stored.
+func (s *Store) Revert(stored []Task) error {
+	parents := make(map[uuid.UUID]uuid.UUID, len(stored))
+	ids := make([]string, 0, len(stored))
+	for _, task := range stored {
+		parents[task.ID] = task.ParentID
+		ids = append(ids, task.ID.String())
+	}
+
+	if _, err := s.taskRepo.Purge(ids); err != nil {
+		return fmt.Errorf("failed to remove tasks: %w", err)
+	}
+
+	for _, task := range stored {
+		// The own cost of every task was added up to the top, only the
+		// ancestors that are not removed still have it.
+		ancestorID := task.ParentID
+		for {
+			parentID, ok := parents[ancestorID]
+			if !ok {
+				break
+			}
+			ancestorID = parentID
+		}
+
+		if err := s.updateParentCost.Run(ancestorID, task.Cost.Neg()); err != nil {
+			return fmt.Errorf("failed to update parent cost: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (s *Store) checkIfParentExists(parentID uuid.UUID) error {
 	if parentID == uuid.Nil {
 		return nil
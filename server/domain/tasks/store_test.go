@@ -8,6 +8,8 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
 	"github.com/zemzale/ubiquitest/storage"
 )
 
@@ -42,6 +44,27 @@ func TestStore(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "fail to store subtask in currency without rate",
+			prepareDB: func(t *testing.T, db *sqlx.DB) {
+				t.Helper()
+				_, err := db.Exec("INSERT INTO users (username) VALUES (?)", "user")
+				require.NoError(t, err, "failed to insert user")
+				_, err = db.Exec(
+					"INSERT INTO tasks (id, title, created_by, parent_id, currency) VALUES (?, ?, ?, ?, ?)",
+					"a3afc3d5-9717-40d8-9e66-2c0b9c2b6a54", "Parent", 1, uuid.Nil.String(), "EUR",
+				)
+				require.NoError(t, err, "failed to insert parent")
+			},
+			giveTask: Task{
+				ID:        uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a53"),
+				Title:     "Create a new task",
+				CreatedBy: 1,
+				ParentID:  uuid.MustParse("a3afc3d5-9717-40d8-9e66-2c0b9c2b6a54"),
+				Cost:      money.New(100, "USD"),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -56,7 +79,7 @@ func TestStore(t *testing.T) {
 
 			taskRepo := storage.NewTaskRepository(db)
 
-			action := NewStore(NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo), taskRepo, storage.NewUserRepository(db))
+			action := NewStore(NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t)), taskRepo, storage.NewUserRepository(db), storage.NewAssigneeRepository(db), history.NewRecord(storage.NewHistoryRepository(db)), testRates(t))
 			if tt.wantErr {
 				assert.Error(t, action.Run(tt.giveTask), "expected error")
 				return
@@ -70,3 +93,12 @@ func TestStore(t *testing.T) {
 		})
 	}
 }
+
+func testRates(t *testing.T) *money.Rates {
+	t.Helper()
+
+	rates, err := money.NewRates("EUR", nil)
+	require.NoError(t, err, "failed to create rates")
+
+	return rates
+}
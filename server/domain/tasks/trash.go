@@ -0,0 +1,170 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+var (
+	// ErrTrashed is returned when deleting a task that is already in the trash.
+	ErrTrashed = errors.New("task is already in the trash")
+	// ErrNotTrashed is returned when restoring a task that is not in the trash.
+	ErrNotTrashed = errors.New("task is not in the trash")
+	// ErrParentTrashed is returned when restoring a task whose parent is still
+	// in the trash, the parent has to be restored first.
+	ErrParentTrashed = errors.New("parent of the task is in the trash")
+)
+
+type Delete struct {
+	updateParentCost *UpdateParentCost
+	taskRepo         *storage.TaksRepository
+	recordHistory    *history.Record
+}
+
+func NewDelete(updateParentCost *UpdateParentCost, taskRepo *storage.TaksRepository, recordHistory *history.Record) *Delete {
+	return &Delete{updateParentCost: updateParentCost, taskRepo: taskRepo, recordHistory: recordHistory}
+}
+
+// Run moves the task and all of its subtasks to the trash and returns the
+// task with the IDs of everything that was deleted. Their cost and logged time
+// are taken out of the ancestors, like they don't exist.
+func (d *Delete) Run(id uuid.UUID, actorID uint) (task Task, deletedIDs []uuid.UUID, err error) {
+	record, err := d.taskRepo.Find(id.String())
+	if err != nil {
+		return Task{}, nil, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	if record.DeletedAt.Valid {
+		return Task{}, nil, ErrTrashed
+	}
+
+	// The cost of an archived task might already be out of the ancestors.
+	if record.ArchivedAt.Valid {
+		return Task{}, nil, ErrArchived
+	}
+
+	now := time.Now().UTC()
+	ids, err := d.taskRepo.SoftDeleteSubtree(record.ID, now)
+	if err != nil {
+		return Task{}, nil, fmt.Errorf("failed to delete task: %w", err)
+	}
+
+	task = mapNewTaskFromDB(*record)
+	task.DeletedAt = now
+	if err := d.updateParentCost.Subtract(task.ParentID, task.Cost); err != nil {
+		return Task{}, nil, fmt.Errorf("failed to update parent cost: %w", err)
+	}
+
+	if err := d.taskRepo.AddLoggedTime(task.ParentID.String(), -record.TotalLogged); err != nil {
+		return Task{}, nil, fmt.Errorf("failed to update parent logged time: %w", err)
+	}
+
+	deletedIDs = lo.Map(ids, func(id string, _ int) uuid.UUID { return uuid.MustParse(id) })
+	for _, deletedID := range deletedIDs {
+		change := history.Change{Field: history.FieldDeletedAt, NewValue: now.Format(time.RFC3339)}
+		if err := d.recordHistory.Run(deletedID, actorID, change); err != nil {
+			log.Println("failed to record history of deleted task ", err)
+		}
+	}
+
+	return task, deletedIDs, nil
+}
+
+type Restore struct {
+	updateParentCost *UpdateParentCost
+	taskRepo         *storage.TaksRepository
+	assigneeRepo     *storage.AssigneeRepository
+	recordHistory    *history.Record
+}
+
+func NewRestore(
+	updateParentCost *UpdateParentCost,
+	taskRepo *storage.TaksRepository,
+	assigneeRepo *storage.AssigneeRepository,
+	recordHistory *history.Record,
+) *Restore {
+	return &Restore{
+		updateParentCost: updateParentCost,
+		taskRepo:         taskRepo,
+		assigneeRepo:     assigneeRepo,
+		recordHistory:    recordHistory,
+	}
+}
+
+// Run takes the task and the subtasks that were deleted together with it out
+// of the trash and returns them, the task first. Their cost and logged time are
+// added back to the ancestors.
+func (r *Restore) Run(id uuid.UUID, actorID uint) ([]Task, error) {
+	record, err := r.taskRepo.Find(id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	if !record.DeletedAt.Valid {
+		return nil, ErrNotTrashed
+	}
+
+	task := mapNewTaskFromDB(*record)
+	if task.ParentID != uuid.Nil {
+		if err := r.taskRepo.CheckIfParentExists(task.ParentID.String()); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrParentTrashed, err)
+		}
+	}
+
+	ids, err := r.taskRepo.RestoreSubtree(record.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore task: %w", err)
+	}
+
+	if err := r.updateParentCost.Run(task.ParentID, task.Cost); err != nil {
+		return nil, fmt.Errorf("failed to update parent cost: %w", err)
+	}
+
+	if err := r.taskRepo.AddLoggedTime(task.ParentID.String(), record.TotalLogged); err != nil {
+		return nil, fmt.Errorf("failed to update parent logged time: %w", err)
+	}
+
+	restored := make([]Task, 0, len(ids))
+	for _, restoredID := range ids {
+		restoredTask, err := findWithAssignees(r.taskRepo, r.assigneeRepo, uuid.MustParse(restoredID))
+		if err != nil {
+			return nil, err
+		}
+
+		change := history.Change{Field: history.FieldDeletedAt, OldValue: task.DeletedAt.Format(time.RFC3339)}
+		if err := r.recordHistory.Run(restoredTask.ID, actorID, change); err != nil {
+			log.Println("failed to record history of restored task ", err)
+		}
+
+		restored = append(restored, restoredTask)
+	}
+
+	return restored, nil
+}
+
+type ListTrash struct {
+	taskRepo *storage.TaksRepository
+}
+
+func NewListTrash(taskRepo *storage.TaksRepository) *ListTrash {
+	return &ListTrash{taskRepo: taskRepo}
+}
+
+// Run returns the tasks in the trash, the most recently deleted first.
+func (l *ListTrash) Run() ([]Task, error) {
+	records, err := l.taskRepo.ListTrashed()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trash: %w", err)
+	}
+
+	return lo.Map(records, func(t *storage.Task, _ int) Task {
+		return mapNewTaskFromDB(*t)
+	}), nil
+}
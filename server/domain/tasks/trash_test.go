@@ -0,0 +1,117 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/blob"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestTrash(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID       = uuid.MustParse("5d1c2b3a-4f5e-4a6b-8c7d-9e0f1a2b3c01")
+		parentID     = uuid.MustParse("5d1c2b3a-4f5e-4a6b-8c7d-9e0f1a2b3c02")
+		childID      = uuid.MustParse("5d1c2b3a-4f5e-4a6b-8c7d-9e0f1a2b3c03")
+		grandchildID = uuid.MustParse("5d1c2b3a-4f5e-4a6b-8c7d-9e0f1a2b3c04")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+	require.NoError(t, err, "failed to insert user")
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, testRates(t))
+	list := NewList(db, taskRepo, assigneeRepo, storage.NewLabelRepository(db), storage.NewAttachmentRepository(db), storage.NewDependencyRepository(db), NewRollUp(taskRepo, DefaultMetrics(testRates(t)), true))
+
+	// root(1) -> parent(2) -> child(3) -> grandchild(4)
+	require.NoError(t, store.Run(Task{ID: rootID, Title: "Move house", CreatedBy: 1, Cost: money.New(1, "EUR")}))
+	require.NoError(t, store.Run(Task{ID: parentID, Title: "Pack", CreatedBy: 1, ParentID: rootID, Cost: money.New(2, "EUR")}))
+	require.NoError(t, store.Run(Task{ID: childID, Title: "Kitchen", CreatedBy: 1, ParentID: parentID, Cost: money.New(3, "EUR")}))
+	require.NoError(t, store.Run(Task{ID: grandchildID, Title: "Plates", CreatedBy: 1, ParentID: childID, Cost: money.New(4, "EUR")}))
+
+	totalCost := func(id uuid.UUID) int64 {
+		record, err := taskRepo.Find(id.String())
+		require.NoError(t, err)
+
+		return record.TotalCost
+	}
+	listed := func() []uuid.UUID {
+		taskList, err := list.Run(ListFilter{})
+		require.NoError(t, err)
+
+		return lo.Map(taskList, func(task Task, _ int) uuid.UUID { return task.ID })
+	}
+
+	require.Equal(t, int64(10), totalCost(rootID))
+
+	deleteTask := NewDelete(updateParentCost, taskRepo, recordHistory)
+	restore := NewRestore(updateParentCost, taskRepo, assigneeRepo, recordHistory)
+
+	// The grandchild is trashed on its own first, it must not come back when
+	// its ancestors are restored.
+	_, deletedIDs, err := deleteTask.Run(grandchildID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{grandchildID}, deletedIDs)
+	assert.Equal(t, int64(6), totalCost(rootID))
+
+	_, deletedIDs, err = deleteTask.Run(parentID, 1)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{parentID, childID}, deletedIDs)
+	assert.Equal(t, int64(1), totalCost(rootID), "the cost of the subtree must be taken out of the root")
+	assert.Equal(t, []uuid.UUID{rootID}, listed())
+
+	_, _, err = deleteTask.Run(parentID, 1)
+	assert.ErrorIs(t, err, ErrTrashed)
+
+	trash, err := NewListTrash(taskRepo).Run()
+	require.NoError(t, err)
+	assert.Len(t, trash, 3)
+
+	_, err = restore.Run(childID, 1)
+	assert.ErrorIs(t, err, ErrParentTrashed, "a task can't be restored into a trashed parent")
+
+	_, err = restore.Run(rootID, 1)
+	assert.ErrorIs(t, err, ErrNotTrashed)
+
+	restored, err := restore.Run(parentID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{parentID, childID}, lo.Map(restored, func(task Task, _ int) uuid.UUID { return task.ID }))
+	assert.Equal(t, int64(6), totalCost(rootID), "the cost of the subtree must be added back to the root")
+	assert.ElementsMatch(t, []uuid.UUID{rootID, parentID, childID}, listed())
+
+	blobStore, err := blob.NewLocal(t.TempDir())
+	require.NoError(t, err)
+	purgeTrash := NewPurgeTrash(taskRepo, blobStore)
+
+	purged, err := purgeTrash.Run(context.Background(), time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, purged, "tasks trashed after the cutoff must be kept")
+
+	purged, err = purgeTrash.Run(context.Background(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	_, err = taskRepo.Find(grandchildID.String())
+	assert.Error(t, err, "purged task must be gone")
+}
@@ -0,0 +1,306 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNothingToUndo is returned when the user has no operations left to undo.
+	ErrNothingToUndo = errors.New("nothing to undo")
+	// ErrNothingToRedo is returned when the user has no undone operations left to redo.
+	ErrNothingToRedo = errors.New("nothing to redo")
+	// ErrUndoConflict is returned when the task was changed after the operation
+	// in a way that it can't be reversed anymore, the operation is dropped.
+	ErrUndoConflict = errors.New("operation can no longer be reversed")
+)
+
+type OperationKind string
+
+const (
+	OperationCreate  OperationKind = "create"
+	OperationUpdate  OperationKind = "update"
+	OperationDelete  OperationKind = "delete"
+	OperationRestore OperationKind = "restore"
+	OperationMove    OperationKind = "move"
+)
+
+// Operation is a change a user made to a task, with the state of the task
+// needed to reverse it.
+type Operation struct {
+	Kind OperationKind
+	// Before is the task before an update or a delete.
+	Before Task
+	// After is the task after a create, an update or a restore.
+	After Task
+	// Created are the tasks created as a side effect of an update, like the
+	// next occurrence of a completed recurring task, the root first.
+	Created []Task
+	// From and To are where a moved task was and is among its siblings.
+	From Placement
+	To   Placement
+}
+
+// Changes are what undoing or redoing an operation did to the tasks.
+type Changes struct {
+	Updated []Task
+	// Deleted are the roots of the subtrees that were moved to the trash and
+	// DeletedIDs are all the tasks in them.
+	Deleted    []Task
+	DeletedIDs []uuid.UUID
+	Restored   []Task
+	Reordered  []Reordered
+}
+
+func (c *Changes) add(other Changes) {
+	c.Updated = append(c.Updated, other.Updated...)
+	c.Deleted = append(c.Deleted, other.Deleted...)
+	c.DeletedIDs = append(c.DeletedIDs, other.DeletedIDs...)
+	c.Restored = append(c.Restored, other.Restored...)
+	c.Reordered = append(c.Reordered, other.Reordered...)
+}
+
+// UndoStack keeps the recent operations of every user in memory. Doing a new
+// operation clears what the user can redo.
+type UndoStack struct {
+	mu    sync.Mutex
+	depth int
+	undo  map[uint][]Operation
+	redo  map[uint][]Operation
+}
+
+// NewUndoStack creates a stack that keeps up to depth operations per user.
+func NewUndoStack(depth int) *UndoStack {
+	return &UndoStack{
+		depth: depth,
+		undo:  make(map[uint][]Operation),
+		redo:  make(map[uint][]Operation),
+	}
+}
+
+// Push records an operation done by the user.
+func (s *UndoStack) Push(userID uint, op Operation) {
+	if userID == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.undo[userID] = s.trim(append(s.undo[userID], op))
+	delete(s.redo, userID)
+}
+
+func (s *UndoStack) popUndo(userID uint) (Operation, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return pop(s.undo, userID)
+}
+
+func (s *UndoStack) popRedo(userID uint) (Operation, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return pop(s.redo, userID)
+}
+
+func (s *UndoStack) pushUndone(userID uint, op Operation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.redo[userID] = s.trim(append(s.redo[userID], op))
+}
+
+func (s *UndoStack) pushRedone(userID uint, op Operation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.undo[userID] = s.trim(append(s.undo[userID], op))
+}
+
+func (s *UndoStack) trim(ops []Operation) []Operation {
+	if len(ops) <= s.depth {
+		return ops
+	}
+
+	return ops[len(ops)-s.depth:]
+}
+
+func pop(stacks map[uint][]Operation, userID uint) (Operation, bool) {
+	ops := stacks[userID]
+	if len(ops) == 0 {
+		return Operation{}, false
+	}
+
+	stacks[userID] = ops[:len(ops)-1]
+
+	return ops[len(ops)-1], true
+}
+
+type Undo struct {
+	stack      *UndoStack
+	operations operations
+}
+
+func NewUndo(stack *UndoStack, update *Update, deleteTask *Delete, restore *Restore, reorder *Reorder) *Undo {
+	return &Undo{
+		stack:      stack,
+		operations: operations{update: update, deleteTask: deleteTask, restore: restore, reorder: reorder},
+	}
+}
+
+// Run reverses the last operation of the user and returns what changed. The
+// changes are returned even on failure, when only part of it was reversed.
+func (u *Undo) Run(userID uint) (Changes, error) {
+	op, ok := u.stack.popUndo(userID)
+	if !ok {
+		return Changes{}, ErrNothingToUndo
+	}
+
+	changes, err := u.operations.revert(op, userID)
+	if err != nil {
+		return changes, fmt.Errorf("failed to undo %s: %w", op.Kind, err)
+	}
+
+	u.stack.pushUndone(userID, op)
+
+	return changes, nil
+}
+
+type Redo struct {
+	stack      *UndoStack
+	operations operations
+}
+
+func NewRedo(stack *UndoStack, update *Update, deleteTask *Delete, restore *Restore, reorder *Reorder) *Redo {
+	return &Redo{
+		stack:      stack,
+		operations: operations{update: update, deleteTask: deleteTask, restore: restore, reorder: reorder},
+	}
+}
+
+// Run does the last undone operation of the user again and returns what
+// changed.
+func (r *Redo) Run(userID uint) (Changes, error) {
+	op, ok := r.stack.popRedo(userID)
+	if !ok {
+		return Changes{}, ErrNothingToRedo
+	}
+
+	changes, err := r.operations.reapply(op, userID)
+	if err != nil {
+		return changes, fmt.Errorf("failed to redo %s: %w", op.Kind, err)
+	}
+
+	r.stack.pushRedone(userID, op)
+
+	return changes, nil
+}
+
+// operations applies the inverse of an operation and the operation itself.
+// Creates are undone by moving the task to the trash, so they can be redone by
+// restoring it with the same ID and subtasks.
+type operations struct {
+	update     *Update
+	deleteTask *Delete
+	restore    *Restore
+	reorder    *Reorder
+}
+
+func (o operations) revert(op Operation, userID uint) (Changes, error) {
+	switch op.Kind {
+	case OperationCreate, OperationRestore:
+		return o.trash(op.After.ID, userID)
+	case OperationDelete:
+		return o.untrash(op.Before.ID, userID)
+	case OperationUpdate:
+		updated, err := o.update.replace(op.After, op.Before, userID)
+		if err != nil {
+			return Changes{}, err
+		}
+
+		changes := Changes{Updated: []Task{updated}}
+		if len(op.Created) == 0 {
+			return changes, nil
+		}
+
+		trashed, err := o.trash(op.Created[0].ID, userID)
+		changes.add(trashed)
+
+		return changes, err
+	case OperationMove:
+		return o.move(op.After.ID, op.From, userID)
+	default:
+		return Changes{}, fmt.Errorf("unknown operation %q", op.Kind)
+	}
+}
+
+func (o operations) reapply(op Operation, userID uint) (Changes, error) {
+	switch op.Kind {
+	case OperationCreate, OperationRestore:
+		return o.untrash(op.After.ID, userID)
+	case OperationDelete:
+		return o.trash(op.Before.ID, userID)
+	case OperationUpdate:
+		updated, err := o.update.replace(op.Before, op.After, userID)
+		if err != nil {
+			return Changes{}, err
+		}
+
+		changes := Changes{Updated: []Task{updated}}
+		if len(op.Created) == 0 {
+			return changes, nil
+		}
+
+		restored, err := o.untrash(op.Created[0].ID, userID)
+		changes.add(restored)
+
+		return changes, err
+	case OperationMove:
+		return o.move(op.Before.ID, op.To, userID)
+	default:
+		return Changes{}, fmt.Errorf("unknown operation %q", op.Kind)
+	}
+}
+
+func (o operations) trash(id uuid.UUID, userID uint) (Changes, error) {
+	task, deletedIDs, err := o.deleteTask.Run(id, userID)
+	if errors.Is(err, ErrTrashed) || errors.Is(err, ErrArchived) {
+		return Changes{}, fmt.Errorf("%w: %w", ErrUndoConflict, err)
+	}
+	if err != nil {
+		return Changes{}, err
+	}
+
+	return Changes{Deleted: []Task{task}, DeletedIDs: deletedIDs}, nil
+}
+
+func (o operations) untrash(id uuid.UUID, userID uint) (Changes, error) {
+	restored, err := o.restore.Run(id, userID)
+	if errors.Is(err, ErrNotTrashed) || errors.Is(err, ErrParentTrashed) {
+		return Changes{}, fmt.Errorf("%w: %w", ErrUndoConflict, err)
+	}
+	if err != nil {
+		return Changes{}, err
+	}
+
+	return Changes{Restored: restored}, nil
+}
+
+// move puts the task back between the same siblings, it can't be done once
+// the sibling it was after, or before at the start, is gone.
+func (o operations) move(id uuid.UUID, placement Placement, userID uint) (Changes, error) {
+	reordered, _, err := o.reorder.Run(id, placement.AfterID, placement.BeforeID, userID)
+	if errors.Is(err, ErrNotSibling) || errors.Is(err, ErrTrashed) || errors.Is(err, ErrArchived) {
+		return Changes{}, fmt.Errorf("%w: %w", ErrUndoConflict, err)
+	}
+	if err != nil {
+		return Changes{}, err
+	}
+
+	return Changes{Reordered: []Reordered{reordered}}, nil
+}
@@ -0,0 +1,177 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestUndo(t *testing.T) {
+	t.Parallel()
+
+	var (
+		parentID = uuid.MustParse("7e6d5c4b-3a29-4180-9f7e-6d5c4b3a2901")
+		choreID  = uuid.MustParse("7e6d5c4b-3a29-4180-9f7e-6d5c4b3a2902")
+		due      = time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second)
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	for _, username := range []string{"alice", "bob"} {
+		_, err = db.Exec("INSERT INTO users (username) VALUES (?)", username)
+		require.NoError(t, err, "failed to insert user")
+	}
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, testRates(t))
+	update := NewUpdate(updateParentCost, taskRepo, storage.NewDependencyRepository(db), NewCreateNextOccurrence(store, taskRepo, assigneeRepo, recordHistory), recordHistory, testRates(t))
+	deleteTask := NewDelete(updateParentCost, taskRepo, recordHistory)
+	restore := NewRestore(updateParentCost, taskRepo, assigneeRepo, recordHistory)
+
+	stack := NewUndoStack(10)
+	reorder := NewReorder(taskRepo, recordHistory)
+	undo := NewUndo(stack, update, deleteTask, restore, reorder)
+	redo := NewRedo(stack, update, deleteTask, restore, reorder)
+
+	find := func(id uuid.UUID) *storage.Task {
+		record, err := taskRepo.Find(id.String())
+		require.NoError(t, err)
+
+		return record
+	}
+
+	parent := Task{ID: parentID, Title: "Household", CreatedBy: 1}
+	require.NoError(t, store.Run(parent))
+	stack.Push(1, Operation{Kind: OperationCreate, After: parent})
+
+	chore := Task{
+		ID: choreID, Title: "Clean the kitchen", CreatedBy: 1, ParentID: parentID, Cost: money.New(5, "EUR"), DueAt: due,
+		Recurrence: Recurrence{Rule: "FREQ=WEEKLY"},
+	}
+	require.NoError(t, store.Run(chore))
+	stack.Push(1, Operation{Kind: OperationCreate, After: chore})
+
+	// Completing the recurring chore by mistake creates its next occurrence.
+	op, err := update.Run(Change{ID: choreID, Title: chore.Title, Completed: true}, 1)
+	require.NoError(t, err)
+	require.Len(t, op.Created, 1)
+	stack.Push(1, op)
+	nextID := op.Created[0].ID
+	require.Equal(t, int64(10), find(parentID).TotalCost)
+
+	_, err = undo.Run(2)
+	assert.ErrorIs(t, err, ErrNothingToUndo, "the stack is per user")
+
+	changes, err := undo.Run(1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{choreID}, lo.Map(changes.Updated, func(task Task, _ int) uuid.UUID { return task.ID }))
+	assert.Equal(t, []uuid.UUID{nextID}, changes.DeletedIDs)
+	assert.False(t, find(choreID).Completed)
+	assert.Equal(t, "FREQ=WEEKLY", find(choreID).RecurrenceRule, "recurrence must be back on the chore")
+	assert.Equal(t, int64(5), find(parentID).TotalCost, "cost of the occurrence must be taken out of the parent")
+
+	changes, err = redo.Run(1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{nextID}, lo.Map(changes.Restored, func(task Task, _ int) uuid.UUID { return task.ID }))
+	assert.True(t, find(choreID).Completed)
+	assert.Empty(t, find(choreID).RecurrenceRule)
+	assert.Equal(t, int64(10), find(parentID).TotalCost)
+
+	_, err = redo.Run(1)
+	assert.ErrorIs(t, err, ErrNothingToRedo)
+
+	// Undo the completion and the creation of the chore.
+	_, err = undo.Run(1)
+	require.NoError(t, err)
+	changes, err = undo.Run(1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{choreID}, changes.DeletedIDs)
+	assert.Zero(t, find(parentID).TotalCost)
+
+	// Undoing a create only moves the task to the trash, so changes from
+	// someone else don't get in the way.
+	_, err = update.Run(Change{ID: parentID, Title: "Chores"}, 2)
+	require.NoError(t, err)
+
+	changes, err = undo.Run(1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{parentID}, changes.DeletedIDs)
+
+	_, err = undo.Run(1)
+	assert.ErrorIs(t, err, ErrNothingToUndo)
+
+	changes, err = redo.Run(1)
+	require.NoError(t, err)
+	assert.Len(t, changes.Restored, 1)
+
+	// A change from someone else after an update makes the update
+	// irreversible and drops it.
+	op, err = update.Run(Change{ID: parentID, Title: "Housework"}, 1)
+	require.NoError(t, err)
+	stack.Push(1, op)
+	_, err = update.Run(Change{ID: parentID, Title: "Housework", Cost: lo.ToPtr(money.New(3, "EUR"))}, 2)
+	require.NoError(t, err)
+
+	_, err = undo.Run(1)
+	assert.ErrorIs(t, err, ErrUndoConflict)
+	assert.Equal(t, "Housework", find(parentID).Title)
+
+	_, err = redo.Run(1)
+	assert.ErrorIs(t, err, ErrNothingToRedo, "a new operation must clear the redo stack")
+
+	// Undoing and redoing a cost change takes it out of the ancestors and
+	// puts it back.
+	chore = Task{ID: uuid.MustParse("7e6d5c4b-3a29-4180-9f7e-6d5c4b3a2903"), Title: "Mop", CreatedBy: 1, ParentID: parentID}
+	require.NoError(t, store.Run(chore))
+	op, err = update.Run(Change{ID: chore.ID, Title: "Mop", Cost: lo.ToPtr(money.New(7, "EUR"))}, 1)
+	require.NoError(t, err)
+	stack.Push(1, op)
+	require.Equal(t, int64(10), find(parentID).TotalCost)
+
+	_, err = undo.Run(1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), find(parentID).TotalCost, "undoing the cost change must take it out of the parent")
+	assert.Equal(t, int64(0), find(chore.ID).TotalCost)
+
+	_, err = redo.Run(1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), find(parentID).TotalCost, "redoing the cost change must put it back in the parent")
+	assert.Equal(t, int64(7), find(chore.ID).TotalCost)
+}
+
+func TestUndoStackDepth(t *testing.T) {
+	t.Parallel()
+
+	stack := NewUndoStack(2)
+	for _, title := range []string{"first", "second", "third"} {
+		stack.Push(1, Operation{Kind: OperationCreate, After: Task{Title: title}})
+	}
+
+	var titles []string
+	for {
+		op, ok := stack.popUndo(1)
+		if !ok {
+			break
+		}
+		titles = append(titles, op.After.Title)
+	}
+
+	assert.Equal(t, []string{"third", "second"}, titles)
+}
@@ -1,52 +1,307 @@
 package tasks
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
-	"github.com/jmoiron/sqlx"
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
 )
 
+var (
+	// ErrBlocked is returned when completing a task that is blocked by
+	// incomplete tasks.
+	ErrBlocked = errors.New("task is blocked by incomplete tasks")
+	// ErrChanged is returned when the task no longer has the state the change
+	// expects.
+	ErrChanged = errors.New("task was changed since it was read")
+)
+
+// Change is what an update sets on a task. The fields that are nil keep their
+// stored values, the zero Budget, DueAt or Recurrence clears them.
+type Change struct {
+	ID         uuid.UUID
+	Title      string
+	Completed  bool
+	Cost       *money.Money
+	Budget     *money.Money
+	DueAt      *time.Time
+	Recurrence *Recurrence
+	// Expected is the state the task has to still have for the change to be
+	// made, checked by the same statement that makes it. Any when nil.
+	Expected *Expected
+}
+
+// Expected is the stored state a change expects the task to have.
+type Expected struct {
+	ParentID  uuid.UUID
+	Title     string
+	Completed bool
+	// CompletedAt and DueAt are the zero time when they are not set.
+	CompletedAt time.Time
+	DueAt       time.Time
+}
+
+func (e *Expected) condition() *storage.TaskCondition {
+	if e == nil {
+		return nil
+	}
+
+	return &storage.TaskCondition{
+		ParentID:    e.ParentID.String(),
+		Title:       e.Title,
+		Completed:   e.Completed,
+		CompletedAt: mapTimeToDB(e.CompletedAt),
+		DueAt:       mapTimeToDB(e.DueAt),
+	}
+}
+
+// apply returns the task with the change made to it.
+func (c Change) apply(task Task) Task {
+	task.Title = c.Title
+	task.Completed = c.Completed
+
+	if c.Cost != nil {
+		task.Cost = *c.Cost
+	}
+
+	if c.Budget != nil {
+		task.Budget = *c.Budget
+	}
+
+	if c.DueAt != nil {
+		task.DueAt = *c.DueAt
+	}
+
+	if c.Recurrence != nil {
+		task.Recurrence = *c.Recurrence
+	}
+
+	return task
+}
+
 type Update struct {
-	db *sqlx.DB
+	updateParentCost *UpdateParentCost
+
+	taskRepo       *storage.TaksRepository
+	dependencyRepo *storage.DependencyRepository
+
+	createNextOccurrence *CreateNextOccurrence
+	recordHistory        *history.Record
+	rates                *money.Rates
 }
 
-func NewUpdate(db *sqlx.DB) *Update {
-	return &Update{db: db}
+func NewUpdate(
+	updateParentCost *UpdateParentCost,
+	taskRepo *storage.TaksRepository,
+	dependencyRepo *storage.DependencyRepository,
+	createNextOccurrence *CreateNextOccurrence,
+	recordHistory *history.Record,
+	rates *money.Rates,
+) *Update {
+	return &Update{
+		updateParentCost:     updateParentCost,
+		taskRepo:             taskRepo,
+		dependencyRepo:       dependencyRepo,
+		createNextOccurrence: createNextOccurrence,
+		recordHistory:        recordHistory,
+		rates:                rates,
+	}
 }
 
-func (u *Update) Run(task Task, userID uint) error {
+// Run updates the task and returns the operation, which has the task before
+// and after the update and the tasks that were created as a side effect, like
+// the next occurrence of a completed recurring task.
+func (u *Update) Run(change Change, userID uint) (Operation, error) {
+	record, err := u.taskRepo.Find(change.ID.String())
+	if err != nil {
+		return Operation{}, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	if record.DeletedAt.Valid {
+		return Operation{}, ErrTrashed
+	}
+
+	task := change.apply(mapStoredTaskFromDB(*record))
+
+	if task.Completed && !record.Completed {
+		if err := u.checkBlockers(record.ID); err != nil {
+			return Operation{}, err
+		}
+	}
+
+	op := Operation{Kind: OperationUpdate, Before: mapStoredTaskFromDB(*record)}
 	if task.Completed {
-		return u.completeTask(task, userID)
+		op.Created, err = u.completeTask(*record, task, change.Expected, userID)
+	} else {
+		err = u.updateTask(*record, task, change.Expected, userID)
+	}
+	if err != nil {
+		return op, err
+	}
+
+	updated, err := u.taskRepo.Find(task.ID.String())
+	if err != nil {
+		return op, fmt.Errorf("failed to find updated task: %w", err)
+	}
+	op.After = mapStoredTaskFromDB(*updated)
+
+	return op, nil
+}
+
+func (u *Update) updateTask(record storage.Task, task Task, expected *Expected, userID uint) error {
+	if err := validateRecurrence(task); err != nil {
+		return err
+	}
+
+	var err error
+	task.Cost, err = u.rates.Normalize(task.Cost)
+	if err != nil {
+		return err
+	}
+
+	task.Budget, err = budgetIn(u.rates, task.Budget, task.Cost.Currency)
+	if err != nil {
+		return err
 	}
 
-	_, err := u.db.Exec(
-		"UPDATE tasks SET title = ?, completed = ?, completed_by = ?, cost = ? WHERE id = ?",
-		task.Title, task.Completed, nil, task.ID.String(), task.Cost,
-	)
+	totalCost, costUpdates, err := u.costUpdates(record, task)
+	if err != nil {
+		return err
+	}
+
+	updated := mapUpdatedTaskToDB(record, task, totalCost)
+	updated.CompletedBy = sql.Null[uint]{}
+	updated.CompletedAt = sql.Null[time.Time]{}
+	err = u.taskRepo.Update(updated, totalCosts(costUpdates), expected.condition())
+	if errors.Is(err, storage.ErrTaskChanged) {
+		return ErrChanged
+	}
 	if err != nil {
 		return fmt.Errorf("failed to update task: %w", err)
 	}
 
+	for _, update := range costUpdates {
+		u.updateParentCost.checkBudget(update)
+	}
+
+	u.record(record, task, userID)
+
 	return nil
 }
 
-func (u *Update) completeTask(task Task, userID uint) error {
-	result, err := u.db.Exec(
-		"UPDATE tasks SET title = ?, completed = ?, completed_by = ? WHERE id = ?",
-		task.Title, task.Completed, userID, task.ID.String(),
-	)
+// replace puts the task back to the given state without any of the side
+// effects of Run, used to undo and redo updates. It fails with
+// ErrUndoConflict if the task is no longer in the expected state, so changes
+// made by someone else in the meantime are not overwritten.
+func (u *Update) replace(expected Task, task Task, userID uint) (Task, error) {
+	record, err := u.taskRepo.Find(task.ID.String())
 	if err != nil {
-		return fmt.Errorf("failed to update task: %w", err)
+		return Task{}, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	if record.DeletedAt.Valid {
+		return Task{}, fmt.Errorf("%w: %w", ErrUndoConflict, ErrTrashed)
+	}
+
+	if !sameState(mapStoredTaskFromDB(*record), expected) {
+		return Task{}, fmt.Errorf("%w: task was changed since", ErrUndoConflict)
+	}
+
+	if task.Completed && !record.Completed {
+		if err := u.checkBlockers(record.ID); err != nil {
+			return Task{}, fmt.Errorf("%w: %w", ErrUndoConflict, err)
+		}
+	}
+
+	totalCost, costUpdates, err := u.costUpdates(*record, task)
+	if err != nil {
+		return Task{}, fmt.Errorf("%w: %w", ErrUndoConflict, err)
+	}
+
+	replaced := mapUpdatedTaskToDB(*record, task, totalCost)
+	replaced.CompletedBy = sql.Null[uint]{V: userID, Valid: task.Completed}
+	replaced.CompletedAt = sql.Null[time.Time]{V: time.Now().UTC(), Valid: task.Completed}
+	if err := u.taskRepo.Update(replaced, totalCosts(costUpdates), nil); err != nil {
+		return Task{}, fmt.Errorf("failed to update task: %w", err)
+	}
+
+	for _, update := range costUpdates {
+		u.updateParentCost.checkBudget(update)
+	}
+
+	u.record(*record, task, userID)
+
+	updated, err := u.taskRepo.Find(task.ID.String())
+	if err != nil {
+		return Task{}, fmt.Errorf("failed to find updated task: %w", err)
+	}
+
+	return mapNewTaskFromDB(*updated), nil
+}
+
+func (u *Update) completeTask(record storage.Task, task Task, expected *Expected, userID uint) ([]Task, error) {
+	err := u.taskRepo.Complete(task.ID.String(), task.Title, userID, time.Now(), expected.condition())
+	if errors.Is(err, storage.ErrTaskChanged) {
+		return nil, ErrChanged
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+
+	u.record(record, task, userID)
+
+	created, err := u.createNextOccurrence.Run(task.ID, userID)
+	if err != nil {
+		return created, fmt.Errorf("failed to create next occurrence: %w", err)
+	}
+
+	return created, nil
+}
+
+// costUpdates returns the total cost of the task and the updates of the total
+// costs of its ancestors when its stored cost is replaced with the cost of the
+// task. The total cost of the subtasks is converted when the currency of the
+// task changes. It fails with money.ErrCurrencyMismatch if there is no rate
+// between the currencies.
+func (u *Update) costUpdates(record storage.Task, task Task) (money.Money, []parentCostUpdate, error) {
+	totalCost, err := u.rates.Convert(money.New(record.TotalCost-record.Cost, record.Currency), task.Cost.Currency)
+	if err != nil {
+		return money.Money{}, nil, fmt.Errorf("failed to convert total cost: %w", err)
+	}
+	totalCost.Amount += task.Cost.Amount
+
+	updates, err := u.updateParentCost.replace(task.ParentID, money.New(record.Cost, record.Currency), task.Cost)
+	if err != nil {
+		return money.Money{}, nil, fmt.Errorf("failed to update parent cost: %w", err)
 	}
 
-	res, err := result.RowsAffected()
+	return totalCost, updates, nil
+}
+
+// checkBlockers fails with ErrBlocked if any of the tasks blocking the task is
+// incomplete.
+func (u *Update) checkBlockers(id string) error {
+	blockers, err := u.dependencyRepo.ListIncompleteBlockers(id)
 	if err != nil {
-		return fmt.Errorf("failed to get affected rows: %w", err)
+		return fmt.Errorf("failed to list blockers: %w", err)
 	}
 
-	if res == 0 {
-		return fmt.Errorf("no rows affected")
+	if len(blockers) > 0 {
+		return fmt.Errorf("%w: %s", ErrBlocked, strings.Join(blockers, ", "))
 	}
 
 	return nil
 }
+
+func (u *Update) record(record storage.Task, task Task, userID uint) {
+	if err := u.recordHistory.Run(task.ID, userID, updateChanges(record, task)...); err != nil {
+		log.Println("failed to record history of updated task ", err)
+	}
+}
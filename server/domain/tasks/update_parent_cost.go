@@ -5,36 +5,161 @@ import (
 	"log"
 
 	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/money"
 	"github.com/zemzale/ubiquitest/storage"
 )
 
+// budgetAlertBuffer is how many budget alerts can wait to be received before
+// new ones are dropped, so updating costs never blocks on them.
+const budgetAlertBuffer = 64
+
 type UpdateParentCost struct {
 	findAllParents *FindAllParents
 	repo           *storage.TaksRepository
+	rates          *money.Rates
+
+	budgetExceeded chan BudgetExceeded
+}
+
+func NewUpdateParentCost(findAllParents *FindAllParents, repo *storage.TaksRepository, rates *money.Rates) *UpdateParentCost {
+	return &UpdateParentCost{
+		findAllParents: findAllParents,
+		repo:           repo,
+		rates:          rates,
+		budgetExceeded: make(chan BudgetExceeded, budgetAlertBuffer),
+	}
+}
+
+// BudgetExceeded returns the channel the ancestors that went over their budget
+// are delivered on. Alerts are dropped while the channel is full.
+func (u *UpdateParentCost) BudgetExceeded() <-chan BudgetExceeded {
+	return u.budgetExceeded
+}
+
+// Run adds the cost to the parent and all of its ancestors, converted to the
+// currency of each of them. Nothing is changed if the cost can't be converted
+// to any of them.
+func (u *UpdateParentCost) Run(parentID uuid.UUID, cost money.Money) error {
+	updates, err := u.convert(parentID, cost)
+	if err != nil {
+		return err
+	}
+
+	for _, update := range updates {
+		log.Println("updating parent cost ", update.parent.ID, update.cost)
+		if err := u.repo.UpdateTotalCost(update.parent.ID.String(), update.cost.Amount); err != nil {
+			return fmt.Errorf("failed to update cost of %s: %w", update.parent.ID, err)
+		}
+
+		u.checkBudget(update)
+	}
+
+	return nil
+}
+
+// checkBudget sends an alert when the update takes the parent over its budget,
+// so it's sent once and not for every cost added after that.
+func (u *UpdateParentCost) checkBudget(update parentCostUpdate) {
+	parent := update.parent
+	if parent.OverBudget() {
+		return
+	}
+
+	parent.Cost.Amount += update.cost.Amount
+	if !parent.OverBudget() {
+		return
+	}
+
+	select {
+	case u.budgetExceeded <- BudgetExceeded{Task: parent}:
+	default:
+		log.Println("dropping budget exceeded alert for ", parent.ID)
+	}
 }
 
-func NewUpdateParentCost(findAllParents *FindAllParents, repo *storage.TaksRepository) *UpdateParentCost {
-	return &UpdateParentCost{findAllParents: findAllParents, repo: repo}
+// Subtract removes the cost from the parent and all of its ancestors, used
+// when a subtree is moved to the trash.
+func (u *UpdateParentCost) Subtract(parentID uuid.UUID, cost money.Money) error {
+	return u.Run(parentID, cost.Neg())
 }
 
-func (u *UpdateParentCost) Run(parentID uuid.UUID, cost uint) error {
+// Check fails with money.ErrCurrencyMismatch if the cost can't be added to
+// the parent or any of its ancestors.
+func (u *UpdateParentCost) Check(parentID uuid.UUID, cost money.Money) error {
+	_, err := u.convert(parentID, cost)
+	return err
+}
+
+// replace returns the updates that take the old cost of a task out of the
+// parent and all of its ancestors and add the new one, each converted to the
+// currency of the ancestor.
+func (u *UpdateParentCost) replace(parentID uuid.UUID, oldCost money.Money, newCost money.Money) ([]parentCostUpdate, error) {
+	if oldCost == newCost {
+		return nil, nil
+	}
+
+	removed, err := u.convert(parentID, oldCost.Neg())
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := u.convert(parentID, newCost)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both have the same ancestors in the same order, unless one of the costs
+	// is zero and has no updates.
+	if len(removed) == 0 {
+		return added, nil
+	}
+
+	for i, update := range added {
+		removed[i].cost.Amount += update.cost.Amount
+	}
+
+	return removed, nil
+}
+
+type parentCostUpdate struct {
+	parent Task
+	cost   money.Money
+}
+
+// totalCosts returns the costs to add to the total cost of each ancestor by
+// its ID.
+func totalCosts(updates []parentCostUpdate) map[string]int64 {
+	costs := make(map[string]int64, len(updates))
+	for _, update := range updates {
+		costs[update.parent.ID.String()] += update.cost.Amount
+	}
+
+	return costs
+}
+
+func (u *UpdateParentCost) convert(parentID uuid.UUID, cost money.Money) ([]parentCostUpdate, error) {
 	if parentID == uuid.Nil {
-		return nil
+		return nil, nil
 	}
 
-	if cost == 0 {
-		return nil
+	if cost.IsZero() {
+		return nil, nil
 	}
 
 	parents, err := u.findAllParents.Run(parentID)
 	if err != nil {
-		return fmt.Errorf("failed to find parents: %w", err)
+		return nil, fmt.Errorf("failed to find parents: %w", err)
 	}
 
+	updates := make([]parentCostUpdate, 0, len(parents))
 	for _, parent := range parents {
-		log.Println("updating parent cost ", parent.ID.String(), cost)
-		u.repo.UpdateTotalCost(parent.ID.String(), cost)
+		converted, err := u.rates.Convert(cost, parent.Cost.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert cost for %s: %w", parent.ID, err)
+		}
+
+		updates = append(updates, parentCostUpdate{parent: parent, cost: converted})
 	}
 
-	return nil
+	return updates, nil
 }
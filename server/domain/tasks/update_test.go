@@ -0,0 +1,235 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestUpdateRecordsHistory(t *testing.T) {
+	t.Parallel()
+
+	taskID := uuid.MustParse("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d")
+	due := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	for _, username := range []string{"alice", "bob"} {
+		_, err = db.Exec("INSERT INTO users (username) VALUES (?)", username)
+		require.NoError(t, err, "failed to insert user")
+	}
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	historyRepo := storage.NewHistoryRepository(db)
+	recordHistory := history.NewRecord(historyRepo)
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(
+		updateParentCost,
+		taskRepo,
+		storage.NewUserRepository(db),
+		assigneeRepo,
+		recordHistory,
+		testRates(t),
+	)
+	update := NewUpdate(updateParentCost, taskRepo, storage.NewDependencyRepository(db), NewCreateNextOccurrence(store, taskRepo, assigneeRepo, recordHistory), recordHistory, testRates(t))
+
+	require.NoError(t, store.Run(Task{ID: taskID, Title: "Buy milk", CreatedBy: 1, Cost: money.New(3, "EUR")}))
+
+	_, err = update.Run(Change{ID: taskID, Title: "Buy oat milk", Cost: lo.ToPtr(money.New(5, "EUR")), DueAt: &due}, 2)
+	require.NoError(t, err, "failed to update task")
+
+	_, err = update.Run(Change{ID: taskID, Title: "Buy oat milk", Completed: true}, 1)
+	require.NoError(t, err, "failed to complete task")
+
+	entries, err := history.NewListByTask(historyRepo).Run(taskID)
+	require.NoError(t, err)
+
+	type change struct {
+		actor         uint
+		field         string
+		before, after string
+	}
+	assert.Equal(t, []change{
+		{1, history.FieldCreated, "", "Buy milk"},
+		{2, history.FieldTitle, "Buy milk", "Buy oat milk"},
+		{2, history.FieldCost, "0.03 EUR", "0.05 EUR"},
+		{2, history.FieldDueAt, "", "2025-03-03T09:00:00Z"},
+		{1, history.FieldCompleted, "false", "true"},
+	}, lo.Map(entries, func(e history.Entry, _ int) change {
+		return change{e.ActorID, e.Field, e.OldValue, e.NewValue}
+	}))
+}
+
+func TestUpdateKeepsLeftOutFields(t *testing.T) {
+	t.Parallel()
+
+	taskID := uuid.MustParse("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6e")
+	due := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'alice'), (2, 'bob')")
+	require.NoError(t, err, "failed to insert users")
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, testRates(t))
+	update := NewUpdate(updateParentCost, taskRepo, storage.NewDependencyRepository(db), NewCreateNextOccurrence(store, taskRepo, assigneeRepo, recordHistory), recordHistory, testRates(t))
+
+	require.NoError(t, store.Run(Task{
+		ID: taskID, Title: "Water plants", CreatedBy: 1, Cost: money.New(3, "EUR"), Budget: money.New(10, "EUR"), DueAt: due,
+		Recurrence: Recurrence{Rule: "FREQ=WEEKLY"},
+	}))
+
+	op, err := update.Run(Change{ID: taskID, Title: "Water the plants"}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Water the plants", op.After.Title)
+	assert.Equal(t, money.New(3, "EUR"), op.After.Cost)
+	assert.Equal(t, money.New(10, "EUR"), op.After.Budget)
+	assert.Equal(t, due, op.After.DueAt)
+	assert.Equal(t, Recurrence{Rule: "FREQ=WEEKLY"}, op.After.Recurrence)
+
+	op, err = update.Run(Change{
+		ID: taskID, Title: "Water the plants", Budget: &money.Money{}, DueAt: &time.Time{}, Recurrence: &Recurrence{},
+	}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, money.New(3, "EUR"), op.After.Cost)
+	assert.False(t, op.After.HasBudget(), "a zero budget clears it")
+	assert.True(t, op.After.DueAt.IsZero(), "a zero due date clears it")
+	assert.True(t, op.After.Recurrence.IsZero(), "a zero recurrence clears it")
+}
+
+func TestUpdateCostRollsUp(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID   = uuid.MustParse("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c70")
+		parentID = uuid.MustParse("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c71")
+		childID  = uuid.MustParse("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c72")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+	require.NoError(t, err, "failed to insert user")
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, testRates(t))
+	update := NewUpdate(updateParentCost, taskRepo, storage.NewDependencyRepository(db), NewCreateNextOccurrence(store, taskRepo, assigneeRepo, recordHistory), recordHistory, testRates(t))
+
+	for _, task := range []Task{
+		{ID: rootID, Title: "House", CreatedBy: 1, Cost: money.New(10, "EUR")},
+		{ID: parentID, Title: "Kitchen", CreatedBy: 1, ParentID: rootID, Cost: money.New(100, "EUR")},
+		{ID: childID, Title: "Paint", CreatedBy: 1, ParentID: parentID, Cost: money.New(50, "EUR")},
+	} {
+		require.NoError(t, store.Run(task))
+	}
+
+	totalCost := func(id uuid.UUID) int64 {
+		record, err := taskRepo.Find(id.String())
+		require.NoError(t, err)
+
+		return record.TotalCost
+	}
+
+	_, err = update.Run(Change{ID: childID, Title: "Paint", Cost: lo.ToPtr(money.New(500, "EUR"))}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), totalCost(childID))
+	assert.Equal(t, int64(600), totalCost(parentID), "the new cost of the child must be in the parent")
+	assert.Equal(t, int64(610), totalCost(rootID), "the new cost of the child must be in all the ancestors")
+
+	_, err = update.Run(Change{ID: parentID, Title: "Kitchen", Cost: lo.ToPtr(money.New(200, "EUR"))}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(700), totalCost(parentID), "the cost of the subtasks must stay in the total")
+	assert.Equal(t, int64(710), totalCost(rootID))
+
+	_, err = update.Run(Change{ID: childID, Title: "Paint", Cost: &money.Money{}}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), totalCost(parentID), "clearing the cost must take it out of the parent")
+	assert.Equal(t, int64(210), totalCost(rootID))
+}
+
+func TestUpdateExpected(t *testing.T) {
+	t.Parallel()
+
+	var (
+		taskID = uuid.MustParse("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c80")
+		due    = time.Date(2025, 6, 3, 10, 0, 0, 0, time.UTC)
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+	require.NoError(t, err, "failed to insert user")
+
+	taskRepo := storage.NewTaskRepository(db)
+	assigneeRepo := storage.NewAssigneeRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := NewUpdateParentCost(NewFindAllParents(taskRepo), taskRepo, testRates(t))
+	store := NewStore(updateParentCost, taskRepo, storage.NewUserRepository(db), assigneeRepo, recordHistory, testRates(t))
+	update := NewUpdate(updateParentCost, taskRepo, storage.NewDependencyRepository(db), NewCreateNextOccurrence(store, taskRepo, assigneeRepo, recordHistory), recordHistory, testRates(t))
+
+	require.NoError(t, store.Run(Task{ID: taskID, Title: "Paint", CreatedBy: 1, DueAt: due}))
+	read := Expected{Title: "Paint", DueAt: due}
+
+	// Someone else changes the task after it was read.
+	_, err = update.Run(Change{ID: taskID, Title: "Paint walls"}, 1)
+	require.NoError(t, err)
+
+	_, err = update.Run(Change{ID: taskID, Title: "Paint ceiling", Expected: &read}, 1)
+	assert.ErrorIs(t, err, ErrChanged)
+	_, err = update.Run(Change{ID: taskID, Title: "Paint ceiling", Completed: true, Expected: &read}, 1)
+	assert.ErrorIs(t, err, ErrChanged)
+
+	record, err := taskRepo.Find(taskID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Paint walls", record.Title, "the change is not made to a task that was changed since")
+	assert.False(t, record.Completed)
+
+	read.Title = "Paint walls"
+	op, err := update.Run(Change{ID: taskID, Title: "Paint walls", Completed: true, Expected: &read}, 1)
+	require.NoError(t, err)
+	assert.True(t, op.After.Completed)
+
+	record, err = taskRepo.Find(taskID.String())
+	require.NoError(t, err)
+	read.Completed, read.CompletedAt = true, record.CompletedAt.V
+	op, err = update.Run(Change{ID: taskID, Title: "Paint walls", Expected: &read}, 1)
+	require.NoError(t, err)
+	assert.False(t, op.After.Completed)
+}
@@ -0,0 +1,76 @@
+package timetracking
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Add struct {
+	entryRepo     *storage.TimeEntryRepository
+	taskRepo      *storage.TaksRepository
+	userRepo      *storage.UserRepository
+	recordHistory *history.Record
+}
+
+func NewAdd(
+	entryRepo *storage.TimeEntryRepository,
+	taskRepo *storage.TaksRepository,
+	userRepo *storage.UserRepository,
+	recordHistory *history.Record,
+) *Add {
+	return &Add{entryRepo: entryRepo, taskRepo: taskRepo, userRepo: userRepo, recordHistory: recordHistory}
+}
+
+// Run logs time that wasn't tracked with a timer. The entry ends at endedAt,
+// or after the duration when endedAt is the zero time.
+func (a *Add) Run(
+	taskID uuid.UUID, userID uint, startedAt time.Time, endedAt time.Time, duration time.Duration, note string,
+) (Entry, error) {
+	note = strings.TrimSpace(note)
+	if err := validateNote(note); err != nil {
+		return Entry{}, err
+	}
+
+	startedAt = startedAt.UTC().Truncate(time.Second)
+	if endedAt.IsZero() {
+		endedAt = startedAt.Add(duration)
+	}
+	endedAt = endedAt.UTC().Truncate(time.Second)
+
+	if !endedAt.After(startedAt) {
+		return Entry{}, fmt.Errorf("%w: has to end after it starts", ErrInvalidEntry)
+	}
+
+	if _, err := a.userRepo.Exists(userID); err != nil {
+		return Entry{}, fmt.Errorf("user doesn't exist: %w", err)
+	}
+
+	if err := findTask(a.taskRepo, taskID); err != nil {
+		return Entry{}, err
+	}
+
+	entry := Entry{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: startedAt,
+		EndedAt:   endedAt,
+		Duration:  endedAt.Sub(startedAt),
+		Note:      note,
+	}
+
+	if err := a.entryRepo.Create(mapEntryToDB(entry)); err != nil {
+		return Entry{}, fmt.Errorf("failed to create time entry: %w", err)
+	}
+
+	if err := logTime(a.taskRepo, a.recordHistory, entry, userID); err != nil {
+		return Entry{}, err
+	}
+
+	return entry, nil
+}
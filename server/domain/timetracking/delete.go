@@ -0,0 +1,52 @@
+package timetracking
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Delete struct {
+	entryRepo     *storage.TimeEntryRepository
+	taskRepo      *storage.TaksRepository
+	recordHistory *history.Record
+}
+
+func NewDelete(entryRepo *storage.TimeEntryRepository, taskRepo *storage.TaksRepository, recordHistory *history.Record) *Delete {
+	return &Delete{entryRepo: entryRepo, taskRepo: taskRepo, recordHistory: recordHistory}
+}
+
+// Run deletes the entry and takes its time out of the task. Only the user that
+// logged the time can delete it.
+func (d *Delete) Run(taskID uuid.UUID, entryID uuid.UUID, userID uint) (Entry, error) {
+	record, err := d.entryRepo.Find(entryID.String())
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to find time entry: %w", err)
+	}
+
+	entry := mapEntryFromDB(record)
+	if entry.TaskID != taskID {
+		return Entry{}, fmt.Errorf("time entry doesn't belong to task %s", taskID)
+	}
+
+	if entry.UserID != userID {
+		return Entry{}, ErrNotOwner
+	}
+
+	if err := d.entryRepo.Delete(record.ID); err != nil {
+		return Entry{}, fmt.Errorf("failed to delete time entry: %w", err)
+	}
+
+	// A running timer hasn't logged anything yet.
+	if entry.Running() {
+		return entry, nil
+	}
+
+	if err := unlogTime(d.taskRepo, d.recordHistory, entry, userID); err != nil {
+		return Entry{}, err
+	}
+
+	return entry, nil
+}
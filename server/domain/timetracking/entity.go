@@ -0,0 +1,131 @@
+package timetracking
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// maxNoteLength is the longest note in bytes.
+const maxNoteLength = 1000
+
+var (
+	// ErrInvalidEntry is returned when the times or the note of an entry
+	// don't make sense.
+	ErrInvalidEntry = errors.New("invalid time entry")
+	// ErrTimerRunning is returned when starting a timer while the user already
+	// has one running.
+	ErrTimerRunning = errors.New("user already has a running timer")
+	// ErrNoTimer is returned when stopping a timer while none is running.
+	ErrNoTimer = errors.New("user has no running timer")
+	// ErrNotOwner is returned when someone other than the user that logged
+	// the time tries to delete the entry.
+	ErrNotOwner = errors.New("only the owner can delete the time entry")
+	// ErrTaskTrashed is returned when logging time on a task in the trash.
+	ErrTaskTrashed = errors.New("task is in the trash")
+)
+
+// Entry is time a user spent on a task. EndedAt is the zero time while the
+// timer is running.
+type Entry struct {
+	ID        uuid.UUID
+	TaskID    uuid.UUID
+	UserID    uint
+	StartedAt time.Time
+	EndedAt   time.Time
+	Duration  time.Duration
+	Note      string
+}
+
+func (e Entry) Running() bool {
+	return e.EndedAt.IsZero()
+}
+
+func validateNote(note string) error {
+	if len(note) > maxNoteLength {
+		return fmt.Errorf("%w: note can't be longer than %d bytes", ErrInvalidEntry, maxNoteLength)
+	}
+
+	return nil
+}
+
+// findTask fails if the task doesn't exist or is in the trash.
+func findTask(taskRepo *storage.TaksRepository, taskID uuid.UUID) error {
+	record, err := taskRepo.Find(taskID.String())
+	if err != nil {
+		return fmt.Errorf("failed to find task: %w", err)
+	}
+
+	if record.DeletedAt.Valid {
+		return ErrTaskTrashed
+	}
+
+	return nil
+}
+
+func mapEntryToDB(entry Entry) storage.TimeEntry {
+	endedAt := sql.Null[time.Time]{}
+	if !entry.EndedAt.IsZero() {
+		endedAt = sql.Null[time.Time]{V: entry.EndedAt.UTC(), Valid: true}
+	}
+
+	return storage.TimeEntry{
+		ID:        entry.ID.String(),
+		TaskID:    entry.TaskID.String(),
+		UserID:    entry.UserID,
+		StartedAt: entry.StartedAt.UTC(),
+		EndedAt:   endedAt,
+		Duration:  int64(entry.Duration / time.Second),
+		Note:      entry.Note,
+	}
+}
+
+func mapEntryFromDB(record storage.TimeEntry) Entry {
+	entry := Entry{
+		ID:        uuid.MustParse(record.ID),
+		TaskID:    uuid.MustParse(record.TaskID),
+		UserID:    record.UserID,
+		StartedAt: record.StartedAt.UTC(),
+		Duration:  time.Duration(record.Duration) * time.Second,
+		Note:      record.Note,
+	}
+	if record.EndedAt.Valid {
+		entry.EndedAt = record.EndedAt.V.UTC()
+	}
+
+	return entry
+}
+
+// logTime adds the duration of the entry to the task and its ancestors.
+func logTime(taskRepo *storage.TaksRepository, recordHistory *history.Record, entry Entry, actorID uint) error {
+	if err := taskRepo.AddLoggedTime(entry.TaskID.String(), int64(entry.Duration/time.Second)); err != nil {
+		return err
+	}
+
+	change := history.Change{Field: history.FieldTimeEntry, NewValue: entry.Duration.String()}
+	if err := recordHistory.Run(entry.TaskID, actorID, change); err != nil {
+		log.Println("failed to record history of time entry ", err)
+	}
+
+	return nil
+}
+
+// unlogTime takes the duration of the entry out of the task and its ancestors.
+func unlogTime(taskRepo *storage.TaksRepository, recordHistory *history.Record, entry Entry, actorID uint) error {
+	if err := taskRepo.AddLoggedTime(entry.TaskID.String(), -int64(entry.Duration/time.Second)); err != nil {
+		return err
+	}
+
+	change := history.Change{Field: history.FieldTimeEntry, OldValue: entry.Duration.String()}
+	if err := recordHistory.Run(entry.TaskID, actorID, change); err != nil {
+		log.Println("failed to record history of time entry ", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package timetracking
+
+import (
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type List struct {
+	entryRepo *storage.TimeEntryRepository
+}
+
+func NewList(entryRepo *storage.TimeEntryRepository) *List {
+	return &List{entryRepo: entryRepo}
+}
+
+func (l *List) Run(taskID uuid.UUID) ([]Entry, error) {
+	records, err := l.entryRepo.ListByTask(taskID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(records))
+	for _, record := range records {
+		entries = append(entries, mapEntryFromDB(record))
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,61 @@
+package timetracking
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Start struct {
+	entryRepo *storage.TimeEntryRepository
+	taskRepo  *storage.TaksRepository
+	userRepo  *storage.UserRepository
+}
+
+func NewStart(entryRepo *storage.TimeEntryRepository, taskRepo *storage.TaksRepository, userRepo *storage.UserRepository) *Start {
+	return &Start{entryRepo: entryRepo, taskRepo: taskRepo, userRepo: userRepo}
+}
+
+// Run starts a timer on the task, the user has to stop the running one first.
+func (s *Start) Run(taskID uuid.UUID, userID uint, note string) (Entry, error) {
+	note = strings.TrimSpace(note)
+	if err := validateNote(note); err != nil {
+		return Entry{}, err
+	}
+
+	if _, err := s.userRepo.Exists(userID); err != nil {
+		return Entry{}, fmt.Errorf("user doesn't exist: %w", err)
+	}
+
+	if err := findTask(s.taskRepo, taskID); err != nil {
+		return Entry{}, err
+	}
+
+	_, err := s.entryRepo.FindRunning(userID)
+	if err == nil {
+		return Entry{}, ErrTimerRunning
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return Entry{}, err
+	}
+
+	entry := Entry{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: time.Now().UTC().Truncate(time.Second),
+		Note:      note,
+	}
+
+	// The unique index still refuses a second timer started at the same time.
+	if err := s.entryRepo.Create(mapEntryToDB(entry)); err != nil {
+		return Entry{}, fmt.Errorf("failed to create time entry: %w", err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,46 @@
+package timetracking
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Stop struct {
+	entryRepo     *storage.TimeEntryRepository
+	taskRepo      *storage.TaksRepository
+	recordHistory *history.Record
+}
+
+func NewStop(entryRepo *storage.TimeEntryRepository, taskRepo *storage.TaksRepository, recordHistory *history.Record) *Stop {
+	return &Stop{entryRepo: entryRepo, taskRepo: taskRepo, recordHistory: recordHistory}
+}
+
+// Run stops the running timer of the user and logs the time on its task.
+func (s *Stop) Run(userID uint) (Entry, error) {
+	record, err := s.entryRepo.FindRunning(userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Entry{}, ErrNoTimer
+	}
+	if err != nil {
+		return Entry{}, err
+	}
+
+	entry := mapEntryFromDB(record)
+	entry.EndedAt = time.Now().UTC().Truncate(time.Second)
+	entry.Duration = entry.EndedAt.Sub(entry.StartedAt)
+
+	if err := s.entryRepo.Stop(record.ID, entry.EndedAt, int64(entry.Duration/time.Second)); err != nil {
+		return Entry{}, fmt.Errorf("failed to stop timer: %w", err)
+	}
+
+	if err := logTime(s.taskRepo, s.recordHistory, entry, userID); err != nil {
+		return Entry{}, err
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,120 @@
+package timetracking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestTimeTracking(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootID  = uuid.MustParse("1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c01")
+		childID = uuid.MustParse("1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c02")
+		otherID = uuid.MustParse("1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c03")
+	)
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'alice'), (2, 'bob')")
+	require.NoError(t, err, "failed to insert users")
+
+	taskRepo := storage.NewTaskRepository(db)
+	entryRepo := storage.NewTimeEntryRepository(db)
+	userRepo := storage.NewUserRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	for _, task := range []storage.Task{
+		{ID: rootID.String(), Title: "Renovate", CreatedBy: 1},
+		{ID: childID.String(), Title: "Paint", CreatedBy: 1},
+		{ID: otherID.String(), Title: "Tiles", CreatedBy: 1},
+	} {
+		task.ParentID.Valid = true
+		task.ParentID.V = uuid.Nil.String()
+		if task.ID != rootID.String() {
+			task.ParentID.V = rootID.String()
+		}
+		require.NoError(t, taskRepo.Create(task))
+	}
+
+	totalLogged := func(id uuid.UUID) int64 {
+		record, err := taskRepo.Find(id.String())
+		require.NoError(t, err)
+
+		return record.TotalLogged
+	}
+
+	start := NewStart(entryRepo, taskRepo, userRepo)
+	stop := NewStop(entryRepo, taskRepo, recordHistory)
+
+	_, err = stop.Run(1)
+	assert.ErrorIs(t, err, ErrNoTimer)
+
+	running, err := start.Run(childID, 1, "First coat")
+	require.NoError(t, err, "failed to start timer")
+	assert.True(t, running.Running())
+
+	_, err = start.Run(otherID, 1, "")
+	assert.ErrorIs(t, err, ErrTimerRunning, "only one timer can run per user")
+
+	_, err = start.Run(otherID, 2, "")
+	require.NoError(t, err, "other users can have their own timer")
+
+	// Pretend the timer has been running for an hour.
+	_, err = db.Exec("UPDATE time_entries SET started_at = ? WHERE id = ?", running.StartedAt.Add(-time.Hour), running.ID.String())
+	require.NoError(t, err)
+
+	stopped, err := stop.Run(1)
+	require.NoError(t, err, "failed to stop timer")
+	assert.False(t, stopped.Running())
+	assert.Equal(t, time.Hour, stopped.Duration)
+	assert.Equal(t, int64(3600), totalLogged(childID))
+	assert.Equal(t, int64(3600), totalLogged(rootID), "time must roll up to the parent")
+
+	add := NewAdd(entryRepo, taskRepo, userRepo, recordHistory)
+	startedAt := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	_, err = add.Run(otherID, 1, startedAt, startedAt.Add(-time.Minute), 0, "")
+	assert.ErrorIs(t, err, ErrInvalidEntry)
+
+	added, err := add.Run(otherID, 1, startedAt, time.Time{}, 30*time.Minute, "Bought tiles")
+	require.NoError(t, err, "failed to add entry")
+	assert.Equal(t, startedAt.Add(30*time.Minute), added.EndedAt)
+	assert.Equal(t, int64(1800), totalLogged(otherID))
+	assert.Equal(t, int64(5400), totalLogged(rootID))
+
+	entries, err := NewList(entryRepo).Run(otherID)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "the running timer of the other user is listed too")
+
+	deleteEntry := NewDelete(entryRepo, taskRepo, recordHistory)
+	_, err = deleteEntry.Run(otherID, added.ID, 2)
+	assert.ErrorIs(t, err, ErrNotOwner)
+
+	_, err = deleteEntry.Run(otherID, added.ID, 1)
+	require.NoError(t, err, "failed to delete entry")
+	assert.Equal(t, int64(0), totalLogged(otherID))
+	assert.Equal(t, int64(3600), totalLogged(rootID), "deleted time must be taken out of the parent")
+
+	// Time logged in a subtree in the trash stays out of the live ancestors.
+	_, err = taskRepo.SoftDeleteSubtree(childID.String(), time.Now())
+	require.NoError(t, err)
+	require.NoError(t, taskRepo.AddLoggedTime(childID.String(), 60))
+	assert.Equal(t, int64(3660), totalLogged(childID))
+	assert.Equal(t, int64(3600), totalLogged(rootID))
+
+	_, err = start.Run(childID, 1, "")
+	assert.ErrorIs(t, err, ErrTaskTrashed)
+}
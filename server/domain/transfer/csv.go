@@ -0,0 +1,190 @@
+package transfer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// csvColumns are written in this order. When reading, the columns are found
+// by the header, so they can be in any order and all but the title can be
+// left out. Amounts are in the minor units of the currency, the budget is in
+// the currency of the cost.
+var csvColumns = []string{
+	"id", "parent_id", "title", "cost", "currency", "total_cost", "budget", "due_at",
+	"completed", "completed_at", "archived_at", "created_by", "completed_by",
+}
+
+func encodeCSV(w io.Writer, exported []tasks.ExportedTask) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvColumns); err != nil {
+		return fmt.Errorf("failed to write csv header: %w", err)
+	}
+
+	for _, task := range exported {
+		row := []string{
+			task.ID.String(),
+			formatCSVID(task.ParentID),
+			task.Title,
+			strconv.FormatInt(task.Cost.Amount, 10),
+			task.Cost.Currency,
+			strconv.FormatInt(task.TotalCost.Amount, 10),
+			strconv.FormatInt(task.Budget.Amount, 10),
+			formatCSVTime(task.DueAt),
+			strconv.FormatBool(task.Completed),
+			formatCSVTime(task.CompletedAt),
+			formatCSVTime(task.ArchivedAt),
+			task.CreatedBy,
+			task.CompletedBy,
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write csv row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+
+	return nil
+}
+
+func formatCSVID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+func formatCSVTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func decodeCSV(content []byte) ([]tasks.ExportedTask, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidFile)
+	}
+
+	header := rows[0]
+	if !slices.Contains(header, "title") {
+		return nil, fmt.Errorf("%w: missing title column", ErrInvalidFile)
+	}
+
+	exported := make([]tasks.ExportedTask, 0, len(rows)-1)
+	for n, row := range rows[1:] {
+		task, err := decodeCSVRow(header, row)
+		if err != nil {
+			return nil, fmt.Errorf("%w: row %d: %w", ErrInvalidFile, n+2, err)
+		}
+
+		exported = append(exported, task)
+	}
+
+	return exported, nil
+}
+
+func decodeCSVRow(header []string, row []string) (tasks.ExportedTask, error) {
+	values := make(map[string]string, len(header))
+	for i, column := range header {
+		if i < len(row) {
+			values[column] = row[i]
+		}
+	}
+
+	var (
+		task tasks.ExportedTask
+		err  error
+	)
+
+	if task.ID, err = parseCSVID(values["id"]); err != nil {
+		return task, fmt.Errorf("invalid id: %w", err)
+	}
+
+	if task.ParentID, err = parseCSVID(values["parent_id"]); err != nil {
+		return task, fmt.Errorf("invalid parent_id: %w", err)
+	}
+
+	task.Title = values["title"]
+	currency := values["currency"]
+	if task.Cost, err = parseCSVMoney(values["cost"], currency); err != nil {
+		return task, fmt.Errorf("invalid cost: %w", err)
+	}
+
+	if task.Budget, err = parseCSVMoney(values["budget"], currency); err != nil {
+		return task, fmt.Errorf("invalid budget: %w", err)
+	}
+
+	if task.DueAt, err = parseCSVTime(values["due_at"]); err != nil {
+		return task, fmt.Errorf("invalid due_at: %w", err)
+	}
+
+	if values["completed"] != "" {
+		if task.Completed, err = strconv.ParseBool(values["completed"]); err != nil {
+			return task, fmt.Errorf("invalid completed: %w", err)
+		}
+	}
+
+	if task.CompletedAt, err = parseCSVTime(values["completed_at"]); err != nil {
+		return task, fmt.Errorf("invalid completed_at: %w", err)
+	}
+
+	if task.ArchivedAt, err = parseCSVTime(values["archived_at"]); err != nil {
+		return task, fmt.Errorf("invalid archived_at: %w", err)
+	}
+
+	task.CreatedBy = values["created_by"]
+	task.CompletedBy = values["completed_by"]
+
+	return task, nil
+}
+
+func parseCSVID(value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, nil
+	}
+
+	return uuid.Parse(value)
+}
+
+func parseCSVMoney(value string, currency string) (money.Money, error) {
+	if value == "" {
+		return money.New(0, currency), nil
+	}
+
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return money.New(amount, currency), nil
+}
+
+func parseCSVTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
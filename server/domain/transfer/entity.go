@@ -0,0 +1,105 @@
+// Package transfer reads and writes task trees in the formats they are
+// exported and imported in.
+package transfer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// maxFileSize is the largest file that is read, so a single import can't
+// exhaust the memory of the server.
+const maxFileSize = 32 << 20
+
+var (
+	// ErrUnknownFormat is returned for a format that can't be read or written.
+	ErrUnknownFormat = errors.New("unknown format")
+	// ErrInvalidFile is returned when the file can't be read in its format.
+	ErrInvalidFile = errors.New("invalid file")
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatCSV  Format = "csv"
+	// FormatTrello is the JSON export of a Trello board, it can only be
+	// imported.
+	FormatTrello Format = "trello"
+	// FormatTodoist is the CSV export of a Todoist project, it can only be
+	// imported.
+	FormatTodoist Format = "todoist"
+	// FormatMarkdown is a nested Markdown checklist, it can only be
+	// imported.
+	FormatMarkdown Format = "markdown"
+)
+
+// Native reports whether tasks are exported in the format. Those keep who
+// completed them and when, and are imported all at once with tasks.Import.
+func (f Format) Native() bool {
+	return f == FormatJSON || f == FormatCSV
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	default:
+		return "application/json"
+	}
+}
+
+// Encode writes the tasks in the format.
+func Encode(w io.Writer, format Format, exported []tasks.ExportedTask) error {
+	switch format {
+	case FormatJSON:
+		return encodeJSON(w, exported)
+	case FormatCSV:
+		return encodeCSV(w, exported)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+// Decoder reads tasks in any of the formats.
+type Decoder struct {
+	// currency is the one of the amounts in formats that only have numbers.
+	currency string
+}
+
+func NewDecoder(rates *money.Rates) *Decoder {
+	return &Decoder{currency: rates.Base()}
+}
+
+// Decode reads the tasks in the format. Tasks from other tools get new IDs,
+// with the parents before their children.
+func (d *Decoder) Decode(r io.Reader, format Format) ([]tasks.ExportedTask, error) {
+	content, err := io.ReadAll(io.LimitReader(r, maxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	if len(content) > maxFileSize {
+		return nil, fmt.Errorf("%w: larger than %d bytes", ErrInvalidFile, maxFileSize)
+	}
+
+	switch format {
+	case FormatJSON:
+		return decodeJSON(content)
+	case FormatCSV:
+		return decodeCSV(content)
+	case FormatTrello:
+		return decodeTrello(content, d.currency)
+	case FormatTodoist:
+		return decodeTodoist(content)
+	case FormatMarkdown:
+		return decodeMarkdown(content)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
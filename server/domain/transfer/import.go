@@ -0,0 +1,56 @@
+package transfer
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// Import creates the tasks read from other tools one by one with tasks.Store,
+// after checking them the same way tasks.Import does.
+type Import struct {
+	taskImport *tasks.Import
+	taskStore  *tasks.Store
+}
+
+func NewImport(taskImport *tasks.Import, taskStore *tasks.Store) *Import {
+	return &Import{taskImport: taskImport, taskStore: taskStore}
+}
+
+// Run returns the tasks like tasks.Import.Run does. The tasks are created
+// parents first, when one fails the ones before it are removed again, so
+// nothing is imported.
+func (i *Import) Run(exported []tasks.ExportedTask, parentID uuid.UUID, actorID uint) (tasks.Imported, error) {
+	imported, err := i.taskImport.Preview(exported, parentID, actorID)
+	if err != nil {
+		return tasks.Imported{}, err
+	}
+
+	// The previewed tasks have the cost of their subtree, Store adds the
+	// own cost of each to its ancestors. The decoders give every task an ID,
+	// so it can be found by it.
+	costs := make(map[uuid.UUID]money.Money, len(exported))
+	for _, e := range exported {
+		costs[imported.IDs[e.ID]] = e.Cost
+	}
+
+	stored := make([]tasks.Task, 0, len(imported.Tasks))
+	for _, task := range imported.Tasks {
+		task.Cost = costs[task.ID]
+		if err := i.taskStore.Run(task); err != nil {
+			if revertErr := i.taskStore.Revert(stored); revertErr != nil {
+				return tasks.Imported{}, fmt.Errorf(
+					"failed to store %q: %w, and failed to remove the %d tasks stored before it: %w",
+					task.Title, err, len(stored), revertErr,
+				)
+			}
+
+			return tasks.Imported{}, fmt.Errorf("failed to store %q, nothing was imported: %w", task.Title, err)
+		}
+		stored = append(stored, task)
+	}
+
+	return imported, nil
+}
@@ -0,0 +1,107 @@
+package transfer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// jsonVersion is bumped when the JSON format changes in a way older servers
+// can't read.
+const jsonVersion = 1
+
+type jsonFile struct {
+	Version int        `json:"version"`
+	Tasks   []jsonTask `json:"tasks"`
+}
+
+type jsonTask struct {
+	ID          uuid.UUID  `json:"id"`
+	ParentID    *uuid.UUID `json:"parent_id,omitempty"`
+	Title       string     `json:"title"`
+	Cost        jsonMoney  `json:"cost"`
+	TotalCost   *jsonMoney `json:"total_cost,omitempty"`
+	Budget      *jsonMoney `json:"budget,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Completed   bool       `json:"completed"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	CreatedBy   string     `json:"created_by,omitempty"`
+	CompletedBy string     `json:"completed_by,omitempty"`
+}
+
+type jsonMoney struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency,omitempty"`
+}
+
+func encodeJSON(w io.Writer, exported []tasks.ExportedTask) error {
+	file := jsonFile{
+		Version: jsonVersion,
+		Tasks: lo.Map(exported, func(task tasks.ExportedTask, _ int) jsonTask {
+			return jsonTask{
+				ID:          task.ID,
+				ParentID:    lo.EmptyableToPtr(task.ParentID),
+				Title:       task.Title,
+				Cost:        jsonMoney(task.Cost),
+				TotalCost:   lo.ToPtr(jsonMoney(task.TotalCost)),
+				Budget:      jsonMoneyPtr(task.Budget),
+				DueAt:       lo.EmptyableToPtr(task.DueAt),
+				Completed:   task.Completed,
+				CompletedAt: lo.EmptyableToPtr(task.CompletedAt),
+				ArchivedAt:  lo.EmptyableToPtr(task.ArchivedAt),
+				CreatedBy:   task.CreatedBy,
+				CompletedBy: task.CompletedBy,
+			}
+		}),
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(file); err != nil {
+		return fmt.Errorf("failed to encode json: %w", err)
+	}
+
+	return nil
+}
+
+func jsonMoneyPtr(m money.Money) *jsonMoney {
+	if m.IsZero() {
+		return nil
+	}
+
+	return lo.ToPtr(jsonMoney(m))
+}
+
+func decodeJSON(content []byte) ([]tasks.ExportedTask, error) {
+	var file jsonFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	if file.Version > jsonVersion {
+		return nil, fmt.Errorf("%w: version %d is newer than %d", ErrInvalidFile, file.Version, jsonVersion)
+	}
+
+	return lo.Map(file.Tasks, func(task jsonTask, _ int) tasks.ExportedTask {
+		return tasks.ExportedTask{
+			ID:          task.ID,
+			ParentID:    lo.FromPtr(task.ParentID),
+			Title:       task.Title,
+			Cost:        money.Money(task.Cost),
+			Budget:      money.Money(lo.FromPtr(task.Budget)),
+			DueAt:       lo.FromPtr(task.DueAt),
+			Completed:   task.Completed,
+			CompletedAt: lo.FromPtr(task.CompletedAt),
+			ArchivedAt:  lo.FromPtr(task.ArchivedAt),
+			CreatedBy:   task.CreatedBy,
+			CompletedBy: task.CompletedBy,
+		}
+	}), nil
+}
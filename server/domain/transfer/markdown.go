@@ -0,0 +1,66 @@
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// markdownItem matches a checklist item like "- [ ] Buy milk" or
+// "* [x] Walk the dog", with the indent before it.
+var markdownItem = regexp.MustCompile(`^([ \t]*)[-*+] \[([ xX])\] +(.+)$`)
+
+// decodeMarkdown reads the checklist items of a Markdown document, the ones
+// indented under an item are under it. Other lines are left out.
+func decodeMarkdown(content []byte) ([]tasks.ExportedTask, error) {
+	type parent struct {
+		id     uuid.UUID
+		indent int
+	}
+
+	var (
+		exported []tasks.ExportedTask
+		parents  []parent
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, maxFileSize)
+	for scanner.Scan() {
+		match := markdownItem.FindStringSubmatch(strings.TrimRight(scanner.Text(), " \t\r"))
+		if match == nil {
+			continue
+		}
+
+		// Tabs are as deep as the 4 spaces they are usually shown as.
+		indent := len(strings.ReplaceAll(match[1], "\t", "    "))
+		for len(parents) > 0 && parents[len(parents)-1].indent >= indent {
+			parents = parents[:len(parents)-1]
+		}
+
+		task := tasks.ExportedTask{
+			ID:        uuid.New(),
+			Title:     match[3],
+			Completed: match[2] != " ",
+		}
+		if len(parents) > 0 {
+			task.ParentID = parents[len(parents)-1].id
+		}
+
+		parents = append(parents, parent{id: task.ID, indent: indent})
+		exported = append(exported, task)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	if len(exported) == 0 {
+		return nil, fmt.Errorf("%w: no checklist items", ErrInvalidFile)
+	}
+
+	return exported, nil
+}
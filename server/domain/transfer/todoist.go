@@ -0,0 +1,104 @@
+package transfer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// todoistDateLayouts are the layouts of the dates in Todoist exports. Dates
+// in other layouts, like recurring ones, are left out.
+var todoistDateLayouts = []string{time.DateOnly, "2006-01-02 15:04", time.RFC3339}
+
+// decodeTodoist reads the CSV export of a Todoist project. Sections are top
+// level tasks with the tasks after them under them and tasks are nested by
+// their indent. Notes are left out. Todoist only exports open tasks, so none
+// of them are completed, and it has nothing like a cost.
+func decodeTodoist(content []byte) ([]tasks.ExportedTask, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	if len(rows) == 0 || !slices.Contains(rows[0], "TYPE") || !slices.Contains(rows[0], "CONTENT") {
+		return nil, fmt.Errorf("%w: not a todoist export", ErrInvalidFile)
+	}
+
+	var (
+		header   = rows[0]
+		exported []tasks.ExportedTask
+		section  uuid.UUID
+		// parents are the last tasks of every indent, the first one being
+		// the current section.
+		parents []uuid.UUID
+	)
+	for n, row := range rows[1:] {
+		values := make(map[string]string, len(header))
+		for i, column := range header {
+			if i < len(row) {
+				values[column] = strings.TrimSpace(row[i])
+			}
+		}
+
+		switch values["TYPE"] {
+		case "section":
+			section = uuid.New()
+			parents = []uuid.UUID{section}
+			exported = append(exported, tasks.ExportedTask{ID: section, Title: values["CONTENT"]})
+		case "task":
+			indent := 1
+			if values["INDENT"] != "" {
+				if indent, err = strconv.Atoi(values["INDENT"]); err != nil || indent < 1 {
+					return nil, fmt.Errorf("%w: row %d: invalid indent %q", ErrInvalidFile, n+2, values["INDENT"])
+				}
+			}
+
+			if len(parents) == 0 {
+				parents = []uuid.UUID{section}
+			}
+
+			// A task indented deeper than the one before it goes under it.
+			indent = min(indent, len(parents))
+			task := tasks.ExportedTask{
+				ID:        uuid.New(),
+				ParentID:  parents[indent-1],
+				Title:     values["CONTENT"],
+				CreatedBy: todoistUsername(values["AUTHOR"]),
+			}
+			for _, layout := range todoistDateLayouts {
+				if due, err := time.Parse(layout, values["DATE"]); err == nil {
+					task.DueAt = due.UTC()
+					break
+				}
+			}
+
+			parents = append(parents[:indent], task.ID)
+			exported = append(exported, task)
+		}
+	}
+
+	if len(exported) == 0 {
+		return nil, fmt.Errorf("%w: no tasks", ErrInvalidFile)
+	}
+
+	return exported, nil
+}
+
+// todoistUsername returns the name of an author written as "Name (id)".
+func todoistUsername(author string) string {
+	if i := strings.LastIndex(author, " ("); i >= 0 && strings.HasSuffix(author, ")") {
+		return author[:i]
+	}
+
+	return author
+}
@@ -0,0 +1,323 @@
+package transfer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	decoder := newDecoder(t)
+
+	rootID := uuid.MustParse("3e2d1c0b-9a8f-4e7d-8c6b-5a4f3e2d1c01")
+	exported := []tasks.ExportedTask{
+		{
+			ID:        rootID,
+			Title:     "Renovate, then move",
+			Cost:      money.New(1250, "EUR"),
+			TotalCost: money.New(1500, "EUR"),
+			Budget:    money.New(5000, "EUR"),
+			DueAt:     time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			CreatedBy: "alice",
+		},
+		{
+			ID:          uuid.MustParse("3e2d1c0b-9a8f-4e7d-8c6b-5a4f3e2d1c02"),
+			ParentID:    rootID,
+			Title:       "Paint \"the\" walls",
+			Cost:        money.New(250, "EUR"),
+			TotalCost:   money.New(250, "EUR"),
+			Budget:      money.New(0, "EUR"),
+			Completed:   true,
+			CompletedAt: time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC),
+			ArchivedAt:  time.Date(2025, 5, 2, 12, 0, 0, 0, time.UTC),
+			CreatedBy:   "alice",
+			CompletedBy: "bob",
+		},
+	}
+
+	for _, format := range []Format{FormatJSON, FormatCSV} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			require.NoError(t, Encode(&buf, format, exported))
+
+			decoded, err := decoder.Decode(&buf, format)
+			require.NoError(t, err)
+
+			// The total cost is computed again on import, so it's not read.
+			want := make([]tasks.ExportedTask, len(exported))
+			for i, task := range exported {
+				task.TotalCost = money.Money{}
+				want[i] = task
+			}
+			if format == FormatJSON {
+				want[1].Budget = money.Money{}
+			}
+			assert.Equal(t, want, decoded)
+		})
+	}
+}
+
+func TestDecodeCSV(t *testing.T) {
+	t.Parallel()
+
+	decoder := newDecoder(t)
+
+	decoded, err := decoder.Decode(strings.NewReader("title,completed,cost,currency\nBuy milk,true,150,EUR\nWalk the dog,,,\n"), FormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, []tasks.ExportedTask{
+		{Title: "Buy milk", Completed: true, Cost: money.New(150, "EUR"), Budget: money.New(0, "EUR")},
+		{Title: "Walk the dog"},
+	}, decoded, "columns can be in any order and left out")
+
+	for _, give := range []string{"", "id\n", "title,cost\nBuy milk,1.50\n", "title,id\nBuy milk,42\n"} {
+		_, err := decoder.Decode(strings.NewReader(give), FormatCSV)
+		assert.ErrorIs(t, err, ErrInvalidFile, give)
+	}
+}
+
+func TestDecodeUnknown(t *testing.T) {
+	t.Parallel()
+
+	decoder := newDecoder(t)
+
+	_, err := decoder.Decode(strings.NewReader("{}"), "xml")
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+
+	_, err = decoder.Decode(strings.NewReader(`{"version": 2, "tasks": []}`), FormatJSON)
+	assert.ErrorIs(t, err, ErrInvalidFile)
+}
+
+func TestDecodeTrello(t *testing.T) {
+	t.Parallel()
+
+	board := `{
+		"name": "House",
+		"lists": [
+			{"id": "l2", "name": "Done", "pos": 2},
+			{"id": "l1", "name": "To do", "pos": 1},
+			{"id": "l3", "name": "Old", "closed": true, "pos": 3}
+		],
+		"cards": [
+			{"id": "c1", "name": "Paint", "idList": "l1", "pos": 1, "due": "2025-06-01T10:00:00.000Z",
+				"customFieldItems": [{"idCustomField": "f1", "value": {"number": "12.5"}}, {"idCustomField": "f2", "value": {"number": "20"}}]},
+			{"id": "c2", "name": "Fix roof", "idList": "l2", "pos": 1, "dueComplete": true},
+			{"id": "c3", "name": "Archived", "idList": "l1", "pos": 2, "closed": true},
+			{"id": "c4", "name": "Old card", "idList": "l3", "pos": 1}
+		],
+		"checklists": [
+			{"id": "k1", "idCard": "c1", "name": "Steps", "checkItems": [
+				{"name": "Prime", "state": "incomplete", "pos": 2},
+				{"name": "Buy paint", "state": "complete", "pos": 1}
+			]}
+		],
+		"customFields": [{"id": "f1", "name": "Cost", "type": "number"}, {"id": "f2", "name": "Budget", "type": "number"}]
+	}`
+
+	decoded, err := newDecoder(t).Decode(strings.NewReader(board), FormatTrello)
+	require.NoError(t, err)
+	require.Len(t, decoded, 7)
+
+	titles := make([]string, len(decoded))
+	for i, task := range decoded {
+		titles[i] = task.Title
+	}
+	assert.Equal(t, []string{"House", "To do", "Paint", "Buy paint", "Prime", "Done", "Fix roof"}, titles)
+
+	house, todo, paint := decoded[0], decoded[1], decoded[2]
+	assert.Equal(t, uuid.Nil, house.ParentID)
+	assert.Equal(t, house.ID, todo.ParentID)
+	assert.Equal(t, todo.ID, paint.ParentID)
+	assert.Equal(t, paint.ID, decoded[3].ParentID, "the items of the only checklist are under the card")
+	assert.Equal(t, money.New(1250, "EUR"), paint.Cost)
+	assert.Equal(t, money.New(2000, "EUR"), paint.Budget)
+	assert.Equal(t, time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC), paint.DueAt)
+	assert.True(t, decoded[3].Completed)
+	assert.False(t, decoded[4].Completed)
+	assert.True(t, decoded[6].Completed)
+
+	for _, give := range []string{"[]", "{}", `{"name": "House", "lists": [{"id": "l1"}], "cards": [{"idList": "l1", "customFieldItems": [{"idCustomField": "f1", "value": {"number": "lots"}}]}], "customFields": [{"id": "f1", "name": "Cost", "type": "number"}]}`} {
+		_, err := newDecoder(t).Decode(strings.NewReader(give), FormatTrello)
+		assert.ErrorIs(t, err, ErrInvalidFile, give)
+	}
+}
+
+func TestDecodeTodoist(t *testing.T) {
+	t.Parallel()
+
+	export := "\ufeffTYPE,CONTENT,DESCRIPTION,PRIORITY,INDENT,AUTHOR,RESPONSIBLE,DATE,DATE_LANG,TIMEZONE\n" +
+		"task,Call the plumber,,1,1,Alice (123),,2025-06-01,en,UTC\n" +
+		",,,,,,,,,\n" +
+		"section,Kitchen,,,,,,,,\n" +
+		"task,Paint,,1,1,Bob (456),,every day,en,UTC\n" +
+		"note,Use the white one,,,,Bob (456),,,,\n" +
+		"task,Buy paint,,1,2,Bob (456),,,en,UTC\n" +
+		"task,Tape the edges,,1,3,Bob (456),,,en,UTC\n" +
+		"task,Clean up,,1,1,Bob (456),,,en,UTC\n"
+
+	decoded, err := newDecoder(t).Decode(strings.NewReader(export), FormatTodoist)
+	require.NoError(t, err)
+	require.Len(t, decoded, 6)
+
+	plumber, kitchen, paint, buy, tape, clean := decoded[0], decoded[1], decoded[2], decoded[3], decoded[4], decoded[5]
+	assert.Equal(t, uuid.Nil, plumber.ParentID)
+	assert.Equal(t, "Alice", plumber.CreatedBy)
+	assert.Equal(t, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), plumber.DueAt)
+	assert.Equal(t, "Kitchen", kitchen.Title)
+	assert.Equal(t, uuid.Nil, kitchen.ParentID)
+	assert.Equal(t, kitchen.ID, paint.ParentID)
+	assert.True(t, paint.DueAt.IsZero(), "recurring dates are left out")
+	assert.Equal(t, paint.ID, buy.ParentID)
+	assert.Equal(t, buy.ID, tape.ParentID)
+	assert.Equal(t, kitchen.ID, clean.ParentID)
+
+	for _, give := range []string{"", "title\nBuy milk\n", "TYPE,CONTENT\nnote,Hi\n", "TYPE,CONTENT,INDENT\ntask,Buy milk,deep\n"} {
+		_, err := newDecoder(t).Decode(strings.NewReader(give), FormatTodoist)
+		assert.ErrorIs(t, err, ErrInvalidFile, give)
+	}
+}
+
+func TestDecodeMarkdown(t *testing.T) {
+	t.Parallel()
+
+	checklist := "# Moving\n\n" +
+		"- [ ] Pack\n" +
+		"  - [x] Books\n" +
+		"  - [ ] Kitchen\n" +
+		"\t\t* [X] Plates\n" +
+		"  Some notes\n" +
+		"+ [ ] Clean\n"
+
+	decoded, err := newDecoder(t).Decode(strings.NewReader(checklist), FormatMarkdown)
+	require.NoError(t, err)
+	require.Len(t, decoded, 5)
+
+	pack, books, kitchen, plates, clean := decoded[0], decoded[1], decoded[2], decoded[3], decoded[4]
+	assert.Equal(t, "Pack", pack.Title)
+	assert.Equal(t, uuid.Nil, pack.ParentID)
+	assert.False(t, pack.Completed)
+	assert.Equal(t, pack.ID, books.ParentID)
+	assert.True(t, books.Completed)
+	assert.Equal(t, pack.ID, kitchen.ParentID)
+	assert.Equal(t, kitchen.ID, plates.ParentID)
+	assert.True(t, plates.Completed)
+	assert.Equal(t, uuid.Nil, clean.ParentID)
+
+	_, err = newDecoder(t).Decode(strings.NewReader("# Moving\n\n- Pack\n"), FormatMarkdown)
+	assert.ErrorIs(t, err, ErrInvalidFile)
+}
+
+func TestImport(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'alice')")
+	require.NoError(t, err, "failed to insert user")
+
+	rates, err := money.NewRates("EUR", nil)
+	require.NoError(t, err)
+
+	taskRepo := storage.NewTaskRepository(db)
+	userRepo := storage.NewUserRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := tasks.NewUpdateParentCost(tasks.NewFindAllParents(taskRepo), taskRepo, rates)
+	store := tasks.NewStore(updateParentCost, taskRepo, userRepo, storage.NewAssigneeRepository(db), recordHistory, rates)
+	taskImport := tasks.NewImport(updateParentCost, taskRepo, userRepo, recordHistory, rates, true)
+
+	boardID, cardID := uuid.New(), uuid.New()
+	imported, err := NewImport(taskImport, store).Run([]tasks.ExportedTask{
+		{ID: cardID, ParentID: boardID, Title: "Book movers", Cost: money.New(300, "EUR"), Completed: true},
+		{ID: boardID, Title: "Moving", Cost: money.New(50, "EUR")},
+	}, uuid.Nil, 1)
+	require.NoError(t, err)
+	require.Len(t, imported.Tasks, 2)
+	assert.Equal(t, boardID, imported.Tasks[0].ID, "parents are stored before their children")
+
+	board, err := taskRepo.Find(boardID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), board.Cost)
+	assert.Equal(t, int64(350), board.TotalCost)
+
+	card, err := taskRepo.Find(cardID.String())
+	require.NoError(t, err)
+	assert.True(t, card.Completed)
+	assert.True(t, card.CompletedAt.Valid, "completed tasks are completed at the import")
+}
+
+func TestImportFailure(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (id, username) VALUES (1, 'alice')")
+	require.NoError(t, err, "failed to insert user")
+
+	rates, err := money.NewRates("EUR", nil)
+	require.NoError(t, err)
+
+	taskRepo := storage.NewTaskRepository(db)
+	userRepo := storage.NewUserRepository(db)
+	recordHistory := history.NewRecord(storage.NewHistoryRepository(db))
+	updateParentCost := tasks.NewUpdateParentCost(tasks.NewFindAllParents(taskRepo), taskRepo, rates)
+	store := tasks.NewStore(updateParentCost, taskRepo, userRepo, storage.NewAssigneeRepository(db), recordHistory, rates)
+	taskImport := tasks.NewImport(updateParentCost, taskRepo, userRepo, recordHistory, rates, true)
+
+	parentID := uuid.New()
+	require.NoError(t, store.Run(tasks.Task{ID: parentID, Title: "House", CreatedBy: 1, Cost: money.New(10, "EUR")}))
+
+	// The last task fails after the others are stored.
+	_, err = db.Exec(`CREATE TRIGGER fail_import BEFORE INSERT ON tasks WHEN NEW.title = 'Pack'
+		BEGIN SELECT RAISE(ABORT, 'disk is full'); END`)
+	require.NoError(t, err)
+
+	boardID, cardID, checklistID := uuid.New(), uuid.New(), uuid.New()
+	_, err = NewImport(taskImport, store).Run([]tasks.ExportedTask{
+		{ID: boardID, Title: "Moving", Cost: money.New(50, "EUR")},
+		{ID: cardID, ParentID: boardID, Title: "Book movers", Cost: money.New(300, "EUR")},
+		{ID: checklistID, ParentID: cardID, Title: "Pack", Cost: money.New(5, "EUR")},
+	}, parentID, 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nothing was imported")
+
+	all, err := taskRepo.ListAll()
+	require.NoError(t, err)
+	require.Len(t, all, 1, "the tasks stored before the failure are removed")
+
+	parent, err := taskRepo.Find(parentID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), parent.TotalCost, "the cost of the removed tasks is taken out of the parent")
+}
+
+func newDecoder(t *testing.T) *Decoder {
+	t.Helper()
+
+	rates, err := money.NewRates("EUR", nil)
+	require.NoError(t, err)
+
+	return NewDecoder(rates)
+}
@@ -0,0 +1,177 @@
+package transfer
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// trelloBoard is the part of the JSON export of a Trello board that is
+// imported.
+type trelloBoard struct {
+	Name         string              `json:"name"`
+	Lists        []trelloList        `json:"lists"`
+	Cards        []trelloCard        `json:"cards"`
+	Checklists   []trelloChecklist   `json:"checklists"`
+	CustomFields []trelloCustomField `json:"customFields"`
+}
+
+type trelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+type trelloCard struct {
+	ID               string                  `json:"id"`
+	Name             string                  `json:"name"`
+	Closed           bool                    `json:"closed"`
+	IDList           string                  `json:"idList"`
+	Pos              float64                 `json:"pos"`
+	Due              *time.Time              `json:"due"`
+	DueComplete      bool                    `json:"dueComplete"`
+	CustomFieldItems []trelloCustomFieldItem `json:"customFieldItems"`
+}
+
+type trelloChecklist struct {
+	ID         string            `json:"id"`
+	IDCard     string            `json:"idCard"`
+	Name       string            `json:"name"`
+	Pos        float64           `json:"pos"`
+	CheckItems []trelloCheckItem `json:"checkItems"`
+}
+
+type trelloCheckItem struct {
+	Name  string  `json:"name"`
+	State string  `json:"state"`
+	Pos   float64 `json:"pos"`
+}
+
+type trelloCustomField struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type trelloCustomFieldItem struct {
+	IDCustomField string `json:"idCustomField"`
+	Value         struct {
+		Number string `json:"number"`
+	} `json:"value"`
+}
+
+// decodeTrello makes the board the top level task, with its lists under it,
+// the cards under the lists and the checklist items under the cards. Items of
+// cards with more than one checklist are under a task for every checklist.
+// Archived lists and cards are left out. Cards are completed when their due
+// date is marked complete and their cost and budget are number custom fields
+// with "cost" or "price" and "budget" in their name, in the currency.
+func decodeTrello(content []byte, currency string) ([]tasks.ExportedTask, error) {
+	var board trelloBoard
+	if err := json.Unmarshal(content, &board); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	if board.Name == "" {
+		return nil, fmt.Errorf("%w: not a trello board", ErrInvalidFile)
+	}
+
+	costFields, budgetFields := map[string]bool{}, map[string]bool{}
+	for _, field := range board.CustomFields {
+		name := strings.ToLower(field.Name)
+		if field.Type != "number" {
+			continue
+		}
+
+		switch {
+		case strings.Contains(name, "budget"):
+			budgetFields[field.ID] = true
+		case strings.Contains(name, "cost"), strings.Contains(name, "price"):
+			costFields[field.ID] = true
+		}
+	}
+
+	checklists := map[string][]trelloChecklist{}
+	for _, checklist := range board.Checklists {
+		checklists[checklist.IDCard] = append(checklists[checklist.IDCard], checklist)
+	}
+
+	byPos := func(a, b float64) int { return cmp.Compare(a, b) }
+	slices.SortStableFunc(board.Lists, func(a, b trelloList) int { return byPos(a.Pos, b.Pos) })
+	slices.SortStableFunc(board.Cards, func(a, b trelloCard) int { return byPos(a.Pos, b.Pos) })
+
+	root := tasks.ExportedTask{ID: uuid.New(), Title: board.Name}
+	exported := []tasks.ExportedTask{root}
+	for _, list := range board.Lists {
+		if list.Closed {
+			continue
+		}
+
+		listTask := tasks.ExportedTask{ID: uuid.New(), ParentID: root.ID, Title: list.Name}
+		exported = append(exported, listTask)
+
+		for _, card := range board.Cards {
+			if card.Closed || card.IDList != list.ID {
+				continue
+			}
+
+			cardTask := tasks.ExportedTask{
+				ID:        uuid.New(),
+				ParentID:  listTask.ID,
+				Title:     card.Name,
+				Completed: card.DueComplete,
+			}
+			if card.Due != nil {
+				cardTask.DueAt = card.Due.UTC()
+			}
+
+			for _, item := range card.CustomFieldItems {
+				if !costFields[item.IDCustomField] && !budgetFields[item.IDCustomField] {
+					continue
+				}
+
+				amount, err := money.ParseDecimal(item.Value.Number, currency)
+				if err != nil {
+					return nil, fmt.Errorf("%w: card %q: %w", ErrInvalidFile, card.Name, err)
+				}
+
+				if budgetFields[item.IDCustomField] {
+					cardTask.Budget = amount
+				} else {
+					cardTask.Cost = amount
+				}
+			}
+			exported = append(exported, cardTask)
+
+			cardChecklists := checklists[card.ID]
+			slices.SortStableFunc(cardChecklists, func(a, b trelloChecklist) int { return byPos(a.Pos, b.Pos) })
+			for _, checklist := range cardChecklists {
+				parentID := cardTask.ID
+				if len(cardChecklists) > 1 {
+					parentID = uuid.New()
+					exported = append(exported, tasks.ExportedTask{ID: parentID, ParentID: cardTask.ID, Title: checklist.Name})
+				}
+
+				slices.SortStableFunc(checklist.CheckItems, func(a, b trelloCheckItem) int { return byPos(a.Pos, b.Pos) })
+				for _, item := range checklist.CheckItems {
+					exported = append(exported, tasks.ExportedTask{
+						ID:        uuid.New(),
+						ParentID:  parentID,
+						Title:     item.Name,
+						Completed: item.State == "complete",
+					})
+				}
+			}
+		}
+	}
+
+	return exported, nil
+}
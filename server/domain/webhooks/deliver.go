@@ -0,0 +1,188 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+const (
+	// batchSize is how many deliveries are posted at the same time.
+	batchSize = 20
+	// maxBackoff is the longest wait between two attempts.
+	maxBackoff = 24 * time.Hour
+	// maxResponseSize is how much of a response is read before the
+	// connection is dropped.
+	maxResponseSize = 64 << 10
+)
+
+// Deliverer posts the pending deliveries whenever something is queued, and
+// periodically for the retries. A delivery that isn't accepted with a 2xx
+// status is retried after the backoff, which doubles with every attempt,
+// until it runs out of attempts.
+type Deliverer struct {
+	webhookRepo  *storage.WebhookRepository
+	client       *http.Client
+	maxAttempts  int
+	backoff      time.Duration
+	pollInterval time.Duration
+	wake         chan struct{}
+}
+
+func NewDeliverer(
+	webhookRepo *storage.WebhookRepository,
+	client *http.Client,
+	maxAttempts int,
+	backoff time.Duration,
+	pollInterval time.Duration,
+) *Deliverer {
+	return &Deliverer{
+		webhookRepo:  webhookRepo,
+		client:       client,
+		maxAttempts:  max(maxAttempts, 1),
+		backoff:      backoff,
+		pollInterval: pollInterval,
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// Wake makes Run post the pending deliveries without waiting for the next
+// poll.
+func (d *Deliverer) Wake() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run blocks until the context is cancelled.
+func (d *Deliverer) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.DeliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// DeliverDue posts every delivery whose attempt is due, a batch at a time.
+func (d *Deliverer) DeliverDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		records, err := d.webhookRepo.ListDueDeliveries(time.Now(), batchSize)
+		if err != nil {
+			log.Println("failed to list due webhook deliveries ", err)
+			return
+		}
+
+		var wg sync.WaitGroup
+		for _, record := range records {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.attempt(ctx, mapDeliveryFromDB(record))
+			}()
+		}
+		wg.Wait()
+
+		if len(records) < batchSize {
+			return
+		}
+	}
+}
+
+func (d *Deliverer) attempt(ctx context.Context, delivery Delivery) {
+	record, err := d.webhookRepo.Find(delivery.WebhookID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		// The webhook was deleted together with its deliveries.
+		return
+	}
+	if err != nil {
+		log.Println("failed to find webhook of delivery ", err)
+		return
+	}
+
+	webhook := mapWebhookFromDB(record)
+	delivery.Attempts++
+	delivery.ResponseStatus, err = d.post(ctx, webhook, delivery)
+	now := time.Now().UTC()
+	switch {
+	case err == nil:
+		delivery.Status = StatusDelivered
+		delivery.NextAttemptAt = time.Time{}
+		delivery.DeliveredAt = now
+		delivery.Error = ""
+	case delivery.Attempts >= d.maxAttempts:
+		delivery.Status = StatusFailed
+		delivery.NextAttemptAt = time.Time{}
+		delivery.Error = err.Error()
+	default:
+		delivery.NextAttemptAt = now.Add(d.retryAfter(delivery.Attempts))
+		delivery.Error = err.Error()
+	}
+
+	if err != nil {
+		log.Printf("attempt %d to deliver %s to webhook %s failed: %s\n", delivery.Attempts, delivery.ID, webhook.ID, err)
+	}
+
+	if err := d.webhookRepo.UpdateDelivery(mapDeliveryToDB(delivery)); err != nil {
+		log.Println("failed to save webhook delivery ", err)
+	}
+}
+
+// post returns the status of the response, 0 when there was none.
+func (d *Deliverer) post(ctx context.Context, webhook Webhook, delivery Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ubiquitest-webhooks")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Reading the body lets the connection be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// retryAfter is how long to wait after the given number of attempts.
+func (d *Deliverer) retryAfter(attempts int) time.Duration {
+	backoff := d.backoff
+	for range attempts - 1 {
+		if backoff >= maxBackoff/2 {
+			return maxBackoff
+		}
+		backoff *= 2
+	}
+
+	return min(backoff, maxBackoff)
+}
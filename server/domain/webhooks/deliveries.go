@@ -0,0 +1,69 @@
+package webhooks
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type ListDeliveries struct {
+	webhookRepo *storage.WebhookRepository
+}
+
+func NewListDeliveries(webhookRepo *storage.WebhookRepository) *ListDeliveries {
+	return &ListDeliveries{webhookRepo: webhookRepo}
+}
+
+// Run returns the deliveries of the webhook, newest first.
+func (l *ListDeliveries) Run(webhookID uuid.UUID) ([]Delivery, error) {
+	if _, err := findWebhook(l.webhookRepo, webhookID); err != nil {
+		return nil, err
+	}
+
+	records, err := l.webhookRepo.ListDeliveries(webhookID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]Delivery, 0, len(records))
+	for _, record := range records {
+		deliveries = append(deliveries, mapDeliveryFromDB(record))
+	}
+
+	return deliveries, nil
+}
+
+type Redeliver struct {
+	webhookRepo *storage.WebhookRepository
+	deliverer   *Deliverer
+}
+
+func NewRedeliver(webhookRepo *storage.WebhookRepository, deliverer *Deliverer) *Redeliver {
+	return &Redeliver{webhookRepo: webhookRepo, deliverer: deliverer}
+}
+
+// Run queues the payload of the delivery again as a new delivery, which keeps
+// the log of the old one. The new delivery is posted in the background.
+func (r *Redeliver) Run(webhookID uuid.UUID, deliveryID uuid.UUID) (Delivery, error) {
+	record, err := r.webhookRepo.FindDelivery(deliveryID.String())
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && record.WebhookID != webhookID.String()) {
+		return Delivery{}, fmt.Errorf("%w: delivery %s", ErrNotFound, deliveryID)
+	}
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	old := mapDeliveryFromDB(record)
+	delivery := newDelivery(old.WebhookID, old.EventType, old.Payload, time.Now().UTC())
+	if err := r.webhookRepo.CreateDeliveries([]storage.WebhookDelivery{mapDeliveryToDB(delivery)}); err != nil {
+		return Delivery{}, err
+	}
+
+	r.deliverer.Wake()
+
+	return delivery, nil
+}
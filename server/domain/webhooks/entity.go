@@ -0,0 +1,193 @@
+// Package webhooks posts the events that are sent over the websocket to the
+// URLs that subscribed to them. Every post is a delivery, which is queued in
+// the DB and retried with an exponential backoff until the URL accepts it.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+// secretSize is the size in bytes of the generated secrets.
+const secretSize = 32
+
+// Headers of the posted deliveries.
+const (
+	HeaderEvent     = "X-Ubiquitest-Event"
+	HeaderDelivery  = "X-Ubiquitest-Delivery"
+	HeaderSignature = "X-Ubiquitest-Signature"
+)
+
+var (
+	// ErrInvalidWebhook is returned when the URL or the event types of a
+	// webhook don't make sense.
+	ErrInvalidWebhook = errors.New("invalid webhook")
+	// ErrNotFound is returned when the webhook or the delivery doesn't exist.
+	ErrNotFound = errors.New("not found")
+)
+
+// Webhook is a URL the events are posted to. It gets every event when
+// EventTypes is empty.
+type Webhook struct {
+	ID         uuid.UUID
+	URL        string
+	EventTypes []string
+	Secret     string
+	CreatedBy  uint
+	CreatedAt  time.Time
+}
+
+func (w Webhook) Subscribed(eventType string) bool {
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType)
+}
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusDelivered Status = "delivered"
+	StatusFailed    Status = "failed"
+)
+
+// Delivery is an event posted to a webhook. NextAttemptAt is only set while
+// it's pending, ResponseStatus and Error are the result of the last attempt.
+type Delivery struct {
+	ID             uuid.UUID
+	WebhookID      uuid.UUID
+	EventType      string
+	Payload        []byte
+	Status         Status
+	Attempts       int
+	NextAttemptAt  time.Time
+	ResponseStatus int
+	Error          string
+	CreatedAt      time.Time
+	DeliveredAt    time.Time
+}
+
+func newDelivery(webhookID uuid.UUID, eventType string, payload []byte, now time.Time) Delivery {
+	return Delivery{
+		ID:            uuid.New(),
+		WebhookID:     webhookID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        StatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// Sign returns the signature of the payload sent in the HeaderSignature
+// header, the hex encoded HMAC-SHA256 of the body prefixed with "sha256=".
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func findWebhook(webhookRepo *storage.WebhookRepository, id uuid.UUID) (Webhook, error) {
+	record, err := webhookRepo.Find(id.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return Webhook{}, fmt.Errorf("%w: webhook %s", ErrNotFound, id)
+	}
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	return mapWebhookFromDB(record), nil
+}
+
+func mapWebhookToDB(webhook Webhook) storage.Webhook {
+	return storage.Webhook{
+		ID:         webhook.ID.String(),
+		URL:        webhook.URL,
+		EventTypes: strings.Join(webhook.EventTypes, ","),
+		Secret:     webhook.Secret,
+		CreatedBy:  webhook.CreatedBy,
+		CreatedAt:  webhook.CreatedAt.UTC(),
+	}
+}
+
+func mapWebhookFromDB(record storage.Webhook) Webhook {
+	webhook := Webhook{
+		ID:         uuid.MustParse(record.ID),
+		URL:        record.URL,
+		EventTypes: []string{},
+		Secret:     record.Secret,
+		CreatedBy:  record.CreatedBy,
+		CreatedAt:  record.CreatedAt.UTC(),
+	}
+	if record.EventTypes != "" {
+		webhook.EventTypes = strings.Split(record.EventTypes, ",")
+	}
+
+	return webhook
+}
+
+func mapDeliveryToDB(delivery Delivery) storage.WebhookDelivery {
+	record := storage.WebhookDelivery{
+		ID:        delivery.ID.String(),
+		WebhookID: delivery.WebhookID.String(),
+		EventType: delivery.EventType,
+		Payload:   string(delivery.Payload),
+		Status:    string(delivery.Status),
+		Attempts:  delivery.Attempts,
+		Error:     delivery.Error,
+		CreatedAt: delivery.CreatedAt.UTC(),
+	}
+	if !delivery.NextAttemptAt.IsZero() {
+		record.NextAttemptAt = sql.Null[time.Time]{V: delivery.NextAttemptAt.UTC(), Valid: true}
+	}
+	if delivery.ResponseStatus != 0 {
+		record.ResponseStatus = sql.Null[int]{V: delivery.ResponseStatus, Valid: true}
+	}
+	if !delivery.DeliveredAt.IsZero() {
+		record.DeliveredAt = sql.Null[time.Time]{V: delivery.DeliveredAt.UTC(), Valid: true}
+	}
+
+	return record
+}
+
+func mapDeliveryFromDB(record storage.WebhookDelivery) Delivery {
+	delivery := Delivery{
+		ID:             uuid.MustParse(record.ID),
+		WebhookID:      uuid.MustParse(record.WebhookID),
+		EventType:      record.EventType,
+		Payload:        []byte(record.Payload),
+		Status:         Status(record.Status),
+		Attempts:       record.Attempts,
+		ResponseStatus: record.ResponseStatus.V,
+		Error:          record.Error,
+		CreatedAt:      record.CreatedAt.UTC(),
+	}
+	if record.NextAttemptAt.Valid {
+		delivery.NextAttemptAt = record.NextAttemptAt.V.UTC()
+	}
+	if record.DeliveredAt.Valid {
+		delivery.DeliveredAt = record.DeliveredAt.V.UTC()
+	}
+
+	return delivery
+}
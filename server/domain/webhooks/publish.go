@@ -0,0 +1,49 @@
+package webhooks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Publish struct {
+	webhookRepo *storage.WebhookRepository
+	deliverer   *Deliverer
+}
+
+func NewPublish(webhookRepo *storage.WebhookRepository, deliverer *Deliverer) *Publish {
+	return &Publish{webhookRepo: webhookRepo, deliverer: deliverer}
+}
+
+// Run queues a delivery of the payload for every webhook subscribed to the
+// event type, they are posted in the background.
+func (p *Publish) Run(eventType string, payload []byte) error {
+	records, err := p.webhookRepo.List()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	deliveries := make([]storage.WebhookDelivery, 0)
+	for _, record := range records {
+		webhook := mapWebhookFromDB(record)
+		if !webhook.Subscribed(eventType) {
+			continue
+		}
+
+		deliveries = append(deliveries, mapDeliveryToDB(newDelivery(webhook.ID, eventType, payload, now)))
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := p.webhookRepo.CreateDeliveries(deliveries); err != nil {
+		return fmt.Errorf("failed to queue %s deliveries: %w", eventType, err)
+	}
+
+	p.deliverer.Wake()
+
+	return nil
+}
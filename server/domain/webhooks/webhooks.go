@@ -0,0 +1,100 @@
+package webhooks
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type Create struct {
+	webhookRepo *storage.WebhookRepository
+}
+
+func NewCreate(webhookRepo *storage.WebhookRepository) *Create {
+	return &Create{webhookRepo: webhookRepo}
+}
+
+// Run subscribes the URL to the event types, a secret is generated when the
+// webhook has none. The returned webhook is the only one with the secret the
+// user gets to see.
+func (c *Create) Run(webhook Webhook) (Webhook, error) {
+	parsed, err := url.Parse(strings.TrimSpace(webhook.URL))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return Webhook{}, fmt.Errorf("%w: url has to be an absolute http or https URL", ErrInvalidWebhook)
+	}
+
+	eventTypes := make([]string, 0, len(webhook.EventTypes))
+	for _, eventType := range webhook.EventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if eventType == "" || strings.Contains(eventType, ",") {
+			return Webhook{}, fmt.Errorf("%w: invalid event type %q", ErrInvalidWebhook, eventType)
+		}
+
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	if webhook.Secret == "" {
+		if webhook.Secret, err = newSecret(); err != nil {
+			return Webhook{}, err
+		}
+	}
+
+	webhook.ID = uuid.New()
+	webhook.URL = parsed.String()
+	webhook.EventTypes = eventTypes
+	webhook.CreatedAt = time.Now().UTC()
+	if err := c.webhookRepo.Create(mapWebhookToDB(webhook)); err != nil {
+		return Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+type List struct {
+	webhookRepo *storage.WebhookRepository
+}
+
+func NewList(webhookRepo *storage.WebhookRepository) *List {
+	return &List{webhookRepo: webhookRepo}
+}
+
+// Run returns all the webhooks without their secrets.
+func (l *List) Run() ([]Webhook, error) {
+	records, err := l.webhookRepo.List()
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]Webhook, 0, len(records))
+	for _, record := range records {
+		webhook := mapWebhookFromDB(record)
+		webhook.Secret = ""
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+type Delete struct {
+	webhookRepo *storage.WebhookRepository
+}
+
+func NewDelete(webhookRepo *storage.WebhookRepository) *Delete {
+	return &Delete{webhookRepo: webhookRepo}
+}
+
+// Run deletes the webhook with its deliveries, the pending ones are dropped.
+func (d *Delete) Run(id uuid.UUID) error {
+	if _, err := findWebhook(d.webhookRepo, id); err != nil {
+		return err
+	}
+
+	return d.webhookRepo.Delete(id.String())
+}
@@ -0,0 +1,160 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+type received struct {
+	header http.Header
+	body   string
+}
+
+func TestWebhooks(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	var (
+		mu       sync.Mutex
+		requests []received
+		status   = http.StatusInternalServerError
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, received{header: r.Header, body: string(body)})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	webhookRepo := storage.NewWebhookRepository(db)
+	deliverer := NewDeliverer(webhookRepo, server.Client(), 2, time.Hour, time.Hour)
+	publish := NewPublish(webhookRepo, deliverer)
+	listDeliveries := NewListDeliveries(webhookRepo)
+
+	for _, give := range []Webhook{
+		{URL: "ftp://example.com"},
+		{URL: "/relative"},
+		{URL: server.URL, EventTypes: []string{" "}},
+	} {
+		_, err := NewCreate(webhookRepo).Run(give)
+		assert.ErrorIs(t, err, ErrInvalidWebhook, "url %q", give.URL)
+	}
+
+	webhook, err := NewCreate(webhookRepo).Run(Webhook{
+		URL: server.URL, EventTypes: []string{"task_created", " task_created"}, CreatedBy: 1,
+	})
+	require.NoError(t, err, "failed to create webhook")
+	assert.NotEmpty(t, webhook.Secret, "a secret is generated")
+	assert.Equal(t, []string{"task_created"}, webhook.EventTypes)
+
+	all, err := NewCreate(webhookRepo).Run(Webhook{URL: server.URL + "/all", Secret: "shh", CreatedBy: 1})
+	require.NoError(t, err)
+
+	listed, err := NewList(webhookRepo).Run()
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Empty(t, listed[0].Secret, "secrets are not listed")
+
+	payload := []byte(`{"type":"task_updated","data":{}}`)
+	require.NoError(t, publish.Run("task_updated", payload))
+
+	deliveries, err := listDeliveries.Run(webhook.ID)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries, "only the subscribed events are delivered")
+
+	deliverer.DeliverDue(context.Background())
+	require.Len(t, requests, 1)
+	assert.Equal(t, string(payload), requests[0].body)
+	assert.Equal(t, "task_updated", requests[0].header.Get(HeaderEvent))
+	assert.Equal(t, Sign("shh", payload), requests[0].header.Get(HeaderSignature))
+
+	deliveries, err = listDeliveries.Run(all.ID)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	failed := deliveries[0]
+	assert.Equal(t, failed.ID.String(), requests[0].header.Get(HeaderDelivery))
+	assert.Equal(t, StatusPending, failed.Status, "failed attempts are retried")
+	assert.Equal(t, 1, failed.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, failed.ResponseStatus)
+	assert.Equal(t, "unexpected status 500", failed.Error)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), failed.NextAttemptAt, time.Minute)
+
+	deliverer.DeliverDue(context.Background())
+	assert.Len(t, requests, 1, "retries wait for the backoff")
+
+	_, err = db.Exec("UPDATE webhook_deliveries SET next_attempt_at = ?", time.Now().Add(-time.Second).UTC())
+	require.NoError(t, err)
+	deliverer.DeliverDue(context.Background())
+	require.Len(t, requests, 2)
+
+	deliveries, err = listDeliveries.Run(all.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusFailed, deliveries[0].Status, "deliveries fail when the attempts run out")
+	assert.True(t, deliveries[0].NextAttemptAt.IsZero())
+
+	mu.Lock()
+	status = http.StatusNoContent
+	mu.Unlock()
+	redelivered, err := NewRedeliver(webhookRepo, deliverer).Run(all.ID, failed.ID)
+	require.NoError(t, err, "failed to redeliver")
+	deliverer.DeliverDue(context.Background())
+	require.Len(t, requests, 3)
+	assert.Equal(t, string(payload), requests[2].body)
+
+	deliveries, err = listDeliveries.Run(all.ID)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, redelivered.ID, deliveries[0].ID, "newest first")
+	assert.Equal(t, StatusDelivered, deliveries[0].Status)
+	assert.Equal(t, http.StatusNoContent, deliveries[0].ResponseStatus)
+	assert.Empty(t, deliveries[0].Error)
+	assert.False(t, deliveries[0].DeliveredAt.IsZero())
+	assert.Equal(t, StatusFailed, deliveries[1].Status, "the old delivery is kept")
+
+	_, err = NewRedeliver(webhookRepo, deliverer).Run(webhook.ID, failed.ID)
+	assert.ErrorIs(t, err, ErrNotFound, "deliveries of other webhooks can't be redelivered")
+
+	require.NoError(t, NewDelete(webhookRepo).Run(all.ID))
+	_, err = listDeliveries.Run(all.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, NewDelete(webhookRepo).Run(uuid.New()), ErrNotFound)
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	deliverer := NewDeliverer(nil, nil, 10, 30*time.Second, time.Minute)
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 5, want: 8 * time.Minute},
+		{attempts: 100, want: maxBackoff},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, deliverer.retryAfter(tt.attempts), "after %d attempts", tt.attempts)
+	}
+}
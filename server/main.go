@@ -3,11 +3,15 @@ package main
 import (
 	"fmt"
 	"os"
+	// Recurrence rules are evaluated in the task's timezone, so don't depend
+	// on the container having the tz database installed.
+	_ "time/tzdata"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/samber/do"
 	"github.com/zemzale/ubiquitest/container"
+	"github.com/zemzale/ubiquitest/domain/money"
 	"github.com/zemzale/ubiquitest/router"
 	"github.com/zemzale/ubiquitest/storage"
 )
@@ -31,6 +35,20 @@ func run() error {
 		return err
 	}
 
+	rates, err := do.Invoke[*money.Rates](nil)
+	if err != nil {
+		return err
+	}
+
+	minorUnits, err := money.MinorUnits(rates.Base())
+	if err != nil {
+		return err
+	}
+
+	if err := storage.MigrateCosts(db, rates.Base(), minorUnits); err != nil {
+		return err
+	}
+
 	r, err := do.Invoke[*router.Router](nil)
 	if err != nil {
 		return err
@@ -7,7 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
@@ -15,500 +18,7053 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// Error defines model for Error.
-type Error struct {
-	// Error The error message
-	Error *string `json:"error,omitempty"`
-}
+// Defines values for ReportBucket.
+const (
+	Day   ReportBucket = "day"
+	Month ReportBucket = "month"
+	Week  ReportBucket = "week"
+)
 
-// LoginResponse defines model for LoginResponse.
-type LoginResponse struct {
-	// Id The ID of the user
-	Id uint `json:"id"`
+// Defines values for ReportGroupBy.
+const (
+	Completer ReportGroupBy = "completer"
+	Creator   ReportGroupBy = "creator"
+	Root      ReportGroupBy = "root"
+)
 
-	// Username The username of the logged in user
-	Username string `json:"username"`
+// Defines values for TodoUpdateClear.
+const (
+	TodoUpdateClearBudget     TodoUpdateClear = "budget"
+	TodoUpdateClearDueAt      TodoUpdateClear = "due_at"
+	TodoUpdateClearRecurrence TodoUpdateClear = "recurrence"
+)
+
+// Defines values for TransferFormat.
+const (
+	Csv      TransferFormat = "csv"
+	Json     TransferFormat = "json"
+	Markdown TransferFormat = "markdown"
+	Todoist  TransferFormat = "todoist"
+	Trello   TransferFormat = "trello"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Delivered WebhookDeliveryStatus = "delivered"
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// ArchivedTasks defines model for ArchivedTasks.
+type ArchivedTasks struct {
+	// Ids The IDs of the archived todo item and its subtasks
+	Ids []openapi_types.UUID `json:"ids"`
 }
 
-// Todo defines model for Todo.
-type Todo struct {
-	// Completed Whether the todo item is completed
-	Completed bool `json:"completed"`
+// Attachment defines model for Attachment.
+type Attachment struct {
+	// ContentType The MIME type of the file
+	ContentType string `json:"content_type"`
 
-	// Cost The cost of the todo item
-	Cost *uint `json:"cost,omitempty"`
+	// CreatedAt When the file was uploaded
+	CreatedAt time.Time `json:"created_at"`
 
-	// CreatedBy The user id of the user who create the todo item
-	CreatedBy uint `json:"created_by"`
+	// Filename The original name of the file
+	Filename string `json:"filename"`
 
-	// Id The ID of the todo item
+	// Id The ID of the attachment
 	Id openapi_types.UUID `json:"id"`
 
-	// ParentId The ID of the parent todo item
-	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+	// Size The size of the file in bytes
+	Size int64 `json:"size"`
 
-	// Title The title of the todo item
-	Title string `json:"title"`
-}
+	// TaskId The ID of the todo item the file is attached to
+	TaskId openapi_types.UUID `json:"task_id"`
 
-// PostLoginJSONBody defines parameters for PostLogin.
-type PostLoginJSONBody struct {
-	// Username The username to login with
-	Username string `json:"username"`
+	// UploadedBy The user id of the user who uploaded the file
+	UploadedBy uint `json:"uploaded_by"`
 }
 
-// PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
-type PostLoginJSONRequestBody PostLoginJSONBody
+// BurndownPoint defines model for BurndownPoint.
+type BurndownPoint struct {
+	// At The end of the bucket
+	At time.Time `json:"at"`
 
-// PostTasksJSONRequestBody defines body for PostTasks for application/json ContentType.
-type PostTasksJSONRequestBody = Todo
+	// OpenCost The own cost of the open todo items
+	OpenCost Money `json:"open_cost"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Login the user with the given username
-	// (POST /login)
-	PostLogin(w http.ResponseWriter, r *http.Request)
-	// Get all todo items
-	// (GET /tasks)
-	GetTasks(w http.ResponseWriter, r *http.Request)
-	// Create a new todo item
-	// (POST /tasks)
-	PostTasks(w http.ResponseWriter, r *http.Request)
-	// Get user by id
-	// (GET /user/{id})
-	GetUserId(w http.ResponseWriter, r *http.Request, id uint)
+	// OpenTasks How many todo items were open
+	OpenTasks int `json:"open_tasks"`
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
-
-type Unimplemented struct{}
+// CalendarToken defines model for CalendarToken.
+type CalendarToken struct {
+	// Path The path of the feed to subscribe to
+	Path string `json:"path"`
 
-// Login the user with the given username
-// (POST /login)
-func (_ Unimplemented) PostLogin(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Token The secret token of the feed, anyone with it can read the feed
+	Token string `json:"token"`
 }
 
-// Get all todo items
-// (GET /tasks)
-func (_ Unimplemented) GetTasks(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Comment defines model for Comment.
+type Comment struct {
+	// AuthorId The user id of the author
+	AuthorId uint `json:"author_id"`
 
-// Create a new todo item
-// (POST /tasks)
-func (_ Unimplemented) PostTasks(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Body The text of the comment
+	Body string `json:"body"`
 
-// Get user by id
-// (GET /user/{id})
-func (_ Unimplemented) GetUserId(w http.ResponseWriter, r *http.Request, id uint) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// CreatedAt When the comment was written
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id The ID of the comment
+	Id openapi_types.UUID `json:"id"`
+
+	// TaskId The ID of the todo item the comment belongs to
+	TaskId openapi_types.UUID `json:"task_id"`
+
+	// UpdatedAt When the comment was last edited
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// CommentInput defines model for CommentInput.
+type CommentInput struct {
+	// Body The text of the comment
+	Body string `json:"body"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// CompletionReport defines model for CompletionReport.
+type CompletionReport struct {
+	// Buckets The start of every bucket, the counts of the groups and the burndown are in the same order
+	Buckets  []time.Time             `json:"buckets"`
+	Burndown []BurndownPoint         `json:"burndown"`
+	Groups   []CompletionReportGroup `json:"groups"`
+}
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Request) {
+// CompletionReportGroup defines model for CompletionReportGroup.
+type CompletionReportGroup struct {
+	// Completed How many todo items were completed in every bucket
+	Completed []int `json:"completed"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostLogin(w, r)
-	}))
+	// Created How many todo items were created in every bucket, always 0 when grouped by completer
+	Created []int `json:"created"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Key The ID of the user or the root todo item
+	Key string `json:"key"`
 
-	handler.ServeHTTP(w, r)
+	// Name The username or the title of the root todo item
+	Name string `json:"name"`
 }
 
-// GetTasks operation middleware
-func (siw *ServerInterfaceWrapper) GetTasks(w http.ResponseWriter, r *http.Request) {
+// CostReport defines model for CostReport.
+type CostReport struct {
+	// Buckets The start of every bucket, the costs of the groups are in the same order
+	Buckets []time.Time `json:"buckets"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTasks(w, r)
-	}))
+	// Currency The currency all the costs are in
+	Currency string `json:"currency"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Groups The groups that spent something, the ones that spent the most first
+	Groups []CostReportGroup `json:"groups"`
 
-	handler.ServeHTTP(w, r)
+	// Total How much was spent in the whole period
+	Total Money `json:"total"`
 }
 
-// PostTasks operation middleware
-func (siw *ServerInterfaceWrapper) PostTasks(w http.ResponseWriter, r *http.Request) {
+// CostReportGroup defines model for CostReportGroup.
+type CostReportGroup struct {
+	// Costs How much was spent in every bucket
+	Costs []Money `json:"costs"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTasks(w, r)
-	}))
+	// Key The ID of the user or the root todo item
+	Key string `json:"key"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Name The username or the title of the root todo item
+	Name string `json:"name"`
 
-	handler.ServeHTTP(w, r)
+	// Total How much was spent in the whole period
+	Total Money `json:"total"`
 }
 
-// GetUserId operation middleware
-func (siw *ServerInterfaceWrapper) GetUserId(w http.ResponseWriter, r *http.Request) {
+// DeletedTasks defines model for DeletedTasks.
+type DeletedTasks struct {
+	// Ids The IDs of the deleted todo item and its subtasks
+	Ids []openapi_types.UUID `json:"ids"`
+}
 
-	var err error
+// Error defines model for Error.
+type Error struct {
+	// Error The error message
+	Error *string `json:"error,omitempty"`
+}
 
-	// ------------- Path parameter "id" -------------
-	var id uint
+// HistoryEntry defines model for HistoryEntry.
+type HistoryEntry struct {
+	// ActorId The user id of who made the change, 0 when it's not known
+	ActorId uint `json:"actor_id"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// CreatedAt When the change was made
+	CreatedAt time.Time `json:"created_at"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserId(w, r, id)
-	}))
+	// Field What was changed, e.g. title, cost, assignee or comment
+	Field string `json:"field"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Id The ID of the change
+	Id int64 `json:"id"`
 
-	handler.ServeHTTP(w, r)
-}
+	// NewValue The value after the change, empty when something was removed
+	NewValue string `json:"new_value"`
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	// OldValue The value before the change, empty when something was added
+	OldValue string `json:"old_value"`
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+	// TaskId The ID of the changed todo item
+	TaskId openapi_types.UUID `json:"task_id"`
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	// Ids The IDs in the file mapped to the IDs of the imported items, which differ when the ID was already taken
+	Ids map[string]openapi_types.UUID `json:"ids"`
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+	// Tasks The imported todo items, parents before their children
+	Tasks []Todo `json:"tasks"`
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// InboxAddress defines model for InboxAddress.
+type InboxAddress struct {
+	// Address The address that creates top level todo items, add "+" and the ID of a board before the "@" to create them in the board
+	Address string `json:"address"`
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+	// Token The secret token in the address, anyone with it can create todo items
+	Token string `json:"token"`
 }
 
-type RequiredParamError struct {
-	ParamName string
-}
+// Label defines model for Label.
+type Label struct {
+	// BoardId The ID of the top level todo item the label belongs to
+	BoardId openapi_types.UUID `json:"board_id"`
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// Color The color of the label as a hex string
+	Color string `json:"color"`
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// Id The ID of the label
+	Id openapi_types.UUID `json:"id"`
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	// Name The name of the label
+	Name string `json:"name"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// LabelInput defines model for LabelInput.
+type LabelInput struct {
+	// Color The color of the label as a hex string
+	Color string `json:"color"`
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	// Name The name of the label
+	Name string `json:"name"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// LoginResponse defines model for LoginResponse.
+type LoginResponse struct {
+	// Id The ID of the user
+	Id uint `json:"id"`
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	// Username The username of the logged in user
+	Username string `json:"username"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// Money An amount of money
+type Money struct {
+	// Amount The amount in the minor units of the currency, e.g. cents
+	Amount int64 `json:"amount"`
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	// Currency ISO 4217 currency code, defaults to the configured currency
+	Currency *string `json:"currency,omitempty"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+// Recurrence Repeats the todo item, the next occurrence is created when it's completed
+type Recurrence struct {
+	// CopySubtree Whether the subtasks are copied to the next occurrence
+	CopySubtree *bool `json:"copy_subtree,omitempty"`
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// Rule RFC 5545 RRULE, the series starts at the due date
+	Rule string `json:"rule"`
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+	// Timezone IANA timezone the rule is evaluated in, defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// ReorderedTasks defines model for ReorderedTasks.
+type ReorderedTasks struct {
+	// ParentId The ID of the parent of the reordered todo items
+	ParentId  *openapi_types.UUID `json:"parent_id,omitempty"`
+	Positions []TaskPosition      `json:"positions"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// ReportBucket How long every point of a report is, in UTC with weeks starting on Monday
+type ReportBucket string
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+// ReportGroupBy What the todo items of a report are grouped by, the root is the top level todo item they are under
+type ReportGroupBy string
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/login", wrapper.PostLogin)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/tasks", wrapper.GetTasks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/tasks", wrapper.PostTasks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/user/{id}", wrapper.GetUserId)
-	})
+// Rollup Metrics of the whole subtree of the todo item, the item included, archived subtasks too
+type Rollup struct {
+	// Cost The sum of the costs in the currency of the todo item
+	Cost Money `json:"cost"`
 
-	return r
-}
+	// Descendants How many todo items are below the todo item
+	Descendants int `json:"descendants"`
 
-type PostLoginRequestObject struct {
-	Body *PostLoginJSONRequestBody
-}
+	// MaxDueAt The latest due date
+	MaxDueAt *time.Time `json:"max_due_at,omitempty"`
 
-type PostLoginResponseObject interface {
-	VisitPostLoginResponse(w http.ResponseWriter) error
+	// PercentComplete The share of completed todo items, from 0 to 100
+	PercentComplete float64 `json:"percent_complete"`
+
+	// RemainingCost The sum of the costs of the incomplete todo items
+	RemainingCost Money `json:"remaining_cost"`
 }
 
-type PostLogin200JSONResponse LoginResponse
+// TaskDependencies defines model for TaskDependencies.
+type TaskDependencies struct {
+	// Blocked Whether any of the todo items in blocked_by is incomplete
+	Blocked bool `json:"blocked"`
 
-func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// BlockedBy The IDs of the todo items that have to be completed first
+	BlockedBy []openapi_types.UUID `json:"blocked_by"`
 
-	return json.NewEncoder(w).Encode(response)
+	// TaskId The ID of the todo item
+	TaskId openapi_types.UUID `json:"task_id"`
 }
 
-type PostLogin400JSONResponse Error
-
-func (response PostLogin400JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// TaskLabels defines model for TaskLabels.
+type TaskLabels struct {
+	// Labels The IDs of the labels of the todo item
+	Labels []openapi_types.UUID `json:"labels"`
 
-	return json.NewEncoder(w).Encode(response)
+	// TaskId The ID of the todo item
+	TaskId openapi_types.UUID `json:"task_id"`
 }
 
-type PostLogin401JSONResponse Error
+// TaskPosition defines model for TaskPosition.
+type TaskPosition struct {
+	// Id The ID of the todo item
+	Id openapi_types.UUID `json:"id"`
+
+	// Position The new position of the todo item
+	Position string `json:"position"`
+}
+
+// TimeEntry defines model for TimeEntry.
+type TimeEntry struct {
+	// DurationSeconds How long the work took, 0 while the timer is running
+	DurationSeconds int64 `json:"duration_seconds"`
+
+	// EndedAt When the work ended, missing while the timer is running
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+
+	// Id The ID of the time entry
+	Id openapi_types.UUID `json:"id"`
+
+	// Note What was done
+	Note *string `json:"note,omitempty"`
+
+	// StartedAt When the work started
+	StartedAt time.Time `json:"started_at"`
+
+	// TaskId The ID of the todo item the time was logged on
+	TaskId openapi_types.UUID `json:"task_id"`
+
+	// UserId The user id of the user that logged the time
+	UserId uint `json:"user_id"`
+}
+
+// TimeEntryInput defines model for TimeEntryInput.
+type TimeEntryInput struct {
+	// DurationSeconds How long the work took, used when ended_at is missing
+	DurationSeconds *int64 `json:"duration_seconds,omitempty"`
+
+	// EndedAt When the work ended, either this or duration_seconds is required
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+
+	// Note What was done
+	Note *string `json:"note,omitempty"`
+
+	// StartedAt When the work started
+	StartedAt time.Time `json:"started_at"`
+}
+
+// Todo defines model for Todo.
+type Todo struct {
+	// ArchivedAt When the todo item was archived, only set for archived items
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// Assignees The IDs of the users the todo item is assigned to
+	Assignees *[]uint `json:"assignees,omitempty"`
+
+	// Attachments The files attached to the todo item
+	Attachments *[]Attachment `json:"attachments,omitempty"`
+
+	// Blocked Whether any of the todo items in blocked_by is incomplete
+	Blocked *bool `json:"blocked,omitempty"`
+
+	// BlockedBy The IDs of the todo items that have to be completed first
+	BlockedBy *[]openapi_types.UUID `json:"blocked_by,omitempty"`
+
+	// Budget The budget of the todo item, in its currency when it's read back
+	Budget *Money `json:"budget,omitempty"`
+
+	// BudgetRemaining The budget left after the cost of the whole subtree, negative when it's over budget
+	BudgetRemaining *Money `json:"budget_remaining,omitempty"`
+
+	// Completed Whether the todo item is completed
+	Completed bool `json:"completed"`
+
+	// Cost The cost of the todo item, the whole subtree when it's read back
+	Cost *Money `json:"cost,omitempty"`
+
+	// CreatedBy The user id of the user who create the todo item
+	CreatedBy uint `json:"created_by"`
+
+	// DeletedAt When the todo item was moved to the trash, only set for items in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// DueAt When the todo item is due
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// Id The ID of the todo item
+	Id openapi_types.UUID `json:"id"`
+
+	// Labels The IDs of the labels of the todo item
+	Labels *[]openapi_types.UUID `json:"labels,omitempty"`
+
+	// LoggedSeconds The time logged on the whole subtree in seconds
+	LoggedSeconds *int64 `json:"logged_seconds,omitempty"`
+
+	// OverBudget Whether the cost of the whole subtree is over the budget
+	OverBudget *bool `json:"over_budget,omitempty"`
+
+	// ParentId The ID of the parent todo item
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+
+	// Position Orders the todo item among its siblings, compared as a string
+	Position *string `json:"position,omitempty"`
+
+	// Recurrence Repeats the todo item, the next occurrence is created when it's completed
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+
+	// Rollup Metrics of the whole subtree of the todo item, the item included, archived subtasks too
+	Rollup *Rollup `json:"rollup,omitempty"`
+
+	// Title The title of the todo item
+	Title string `json:"title"`
+}
+
+// TodoUpdate defines model for TodoUpdate.
+type TodoUpdate struct {
+	// Budget An amount of money
+	Budget *Money `json:"budget,omitempty"`
+
+	// Clear The fields to remove from the todo item
+	Clear *[]TodoUpdateClear `json:"clear,omitempty"`
+
+	// Completed Whether the todo item is completed
+	Completed bool `json:"completed"`
+
+	// Cost The own cost of the todo item
+	Cost *Money `json:"cost,omitempty"`
+
+	// DueAt When the todo item is due
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// Recurrence Repeats the todo item, the next occurrence is created when it's completed
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+
+	// Title The title of the todo item
+	Title string `json:"title"`
+}
+
+// TodoUpdateClear defines model for TodoUpdate.Clear.
+type TodoUpdateClear string
+
+// TransferFormat The format todo items are exported and imported in, trello (a board's JSON export), todoist (a project's CSV export) and markdown (a nested checklist) can only be imported
+type TransferFormat string
+
+// UndoChanges defines model for UndoChanges.
+type UndoChanges struct {
+	// DeletedIds The IDs of the todo items that were moved to the trash
+	DeletedIds []openapi_types.UUID `json:"deleted_ids"`
+
+	// Reordered The todo items that were moved back among their siblings
+	Reordered []ReorderedTasks `json:"reordered"`
+
+	// Restored The todo items that were taken out of the trash
+	Restored []Todo `json:"restored"`
+
+	// Updated The todo items that were put back to an earlier state
+	Updated []Todo `json:"updated"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	// CreatedAt When the webhook was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy The user id of the user that created the webhook
+	CreatedBy uint `json:"created_by"`
+
+	// EventTypes The types of the events that are posted, all of them when empty
+	EventTypes []string `json:"event_types"`
+
+	// Id The ID of the webhook
+	Id openapi_types.UUID `json:"id"`
+
+	// Secret The key of the HMAC-SHA256 signature in the X-Ubiquitest-Signature header, only returned when the webhook is created
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Where the events are posted
+	Url string `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts How many times the payload was posted
+	Attempts int `json:"attempts"`
+
+	// CreatedAt When the event happened
+	CreatedAt time.Time `json:"created_at"`
+
+	// DeliveredAt When the URL accepted the payload
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+
+	// Error Why the last attempt failed
+	Error *string `json:"error,omitempty"`
+
+	// EventType The type of the event
+	EventType string `json:"event_type"`
+
+	// Id The ID of the delivery, sent in the X-Ubiquitest-Delivery header
+	Id openapi_types.UUID `json:"id"`
+
+	// NextAttemptAt When the payload is posted again, only while pending
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+
+	// Payload The posted body, the event as it's sent over the websocket
+	Payload json.RawMessage `json:"payload"`
+
+	// ResponseStatus The HTTP status of the last attempt, missing when there was no response
+	ResponseStatus *int `json:"response_status,omitempty"`
+
+	// Status Pending until the URL responds with a 2xx status or the attempts run out
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// WebhookId The ID of the webhook
+	WebhookId openapi_types.UUID `json:"webhook_id"`
+}
+
+// WebhookDeliveryStatus Pending until the URL responds with a 2xx status or the attempts run out
+type WebhookDeliveryStatus string
+
+// WebhookInput defines model for WebhookInput.
+type WebhookInput struct {
+	// EventTypes The types of the websocket events to post, all of them when missing or empty
+	EventTypes *[]string `json:"event_types,omitempty"`
+
+	// Secret The key to sign the events with, a random one is generated when missing
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Where the events are posted, an http or https URL
+	Url string `json:"url"`
+}
+
+// GetActivityParams defines parameters for GetActivity.
+type GetActivityParams struct {
+	// Limit How many changes to return, defaults to 50
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Before Only return changes older than this change id, used to get the next page
+	Before *int64 `form:"before,omitempty" json:"before,omitempty"`
+}
+
+// GetCalendarTokenParams defines parameters for GetCalendarToken.
+type GetCalendarTokenParams struct {
+	// XUserId The ID of the user whose feed it is
+	XUserId uint `json:"X-User-Id"`
+}
+
+// PostCalendarTokenParams defines parameters for PostCalendarToken.
+type PostCalendarTokenParams struct {
+	// XUserId The ID of the user whose feed it is
+	XUserId uint `json:"X-User-Id"`
+}
+
+// GetExportParams defines parameters for GetExport.
+type GetExportParams struct {
+	// Format The format of the file, defaults to json
+	Format *TransferFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PostImportParams defines parameters for PostImport.
+type PostImportParams struct {
+	// Format The format of the file, defaults to json
+	Format *TransferFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// ParentId The todo item to import the top level items under, they are imported at the top level without it
+	ParentId *openapi_types.UUID `form:"parent_id,omitempty" json:"parent_id,omitempty"`
+
+	// DryRun Only return the items that would be imported, without creating them
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// XUserId The ID of the user importing, who also creates the items of users that don't exist
+	XUserId uint `json:"X-User-Id"`
+}
+
+// GetInboxAddressParams defines parameters for GetInboxAddress.
+type GetInboxAddressParams struct {
+	// XUserId The ID of the user whose address it is
+	XUserId uint `json:"X-User-Id"`
+}
+
+// PostInboxAddressParams defines parameters for PostInboxAddress.
+type PostInboxAddressParams struct {
+	// XUserId The ID of the user whose address it is
+	XUserId uint `json:"X-User-Id"`
+}
+
+// GetLabelsParams defines parameters for GetLabels.
+type GetLabelsParams struct {
+	// BoardId Only return the labels of this board
+	BoardId *openapi_types.UUID `form:"board_id,omitempty" json:"board_id,omitempty"`
+}
+
+// PostLabelsJSONBody defines parameters for PostLabels.
+type PostLabelsJSONBody struct {
+	// BoardId The ID of the top level todo item the label belongs to
+	BoardId openapi_types.UUID `json:"board_id"`
+
+	// Color The color of the label as a hex string
+	Color string `json:"color"`
+
+	// Name The name of the label, unique on the board
+	Name string `json:"name"`
+}
+
+// DeleteLabelsIdParams defines parameters for DeleteLabelsId.
+type DeleteLabelsIdParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// PostLoginJSONBody defines parameters for PostLogin.
+type PostLoginJSONBody struct {
+	// Username The username to login with
+	Username string `json:"username"`
+}
+
+// PostRedoParams defines parameters for PostRedo.
+type PostRedoParams struct {
+	// XUserId The ID of the user making the request
+	XUserId uint `json:"X-User-Id"`
+}
+
+// GetReportsCompletionParams defines parameters for GetReportsCompletion.
+type GetReportsCompletionParams struct {
+	// From The start of the report, defaults to 30 days before to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To The end of the report, defaults to now
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Bucket How long every point of the report is, defaults to day
+	Bucket *ReportBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// GroupBy What the todo items are grouped by, defaults to creator
+	GroupBy *ReportGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetReportsCostParams defines parameters for GetReportsCost.
+type GetReportsCostParams struct {
+	// From The start of the report, defaults to 30 days before to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To The end of the report, defaults to now
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Bucket How long every point of the report is, defaults to day
+	Bucket *ReportBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// GroupBy What the todo items are grouped by, defaults to creator
+	GroupBy *ReportGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetTasksParams defines parameters for GetTasks.
+type GetTasksParams struct {
+	// Overdue Only return incomplete items whose due date has passed
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
+
+	// Archived Return the archived items instead of the ones that are not archived
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
+
+	// Assignee Only return items assigned to this user id, or `me` for the user in X-User-Id
+	Assignee *string `form:"assignee,omitempty" json:"assignee,omitempty"`
+
+	// Label Only return items that have all of these labels
+	Label *[]openapi_types.UUID `form:"label,omitempty" json:"label,omitempty"`
+
+	// XUserId The ID of the user making the request
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// DeleteTasksIdParams defines parameters for DeleteTasksId.
+type DeleteTasksIdParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// PutTasksIdParams defines parameters for PutTasksId.
+type PutTasksIdParams struct {
+	// XUserId The ID of the user making the request
+	XUserId uint `json:"X-User-Id"`
+}
+
+// PostTasksIdArchiveParams defines parameters for PostTasksIdArchive.
+type PostTasksIdArchiveParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// PostTasksIdAssigneesJSONBody defines parameters for PostTasksIdAssignees.
+type PostTasksIdAssigneesJSONBody struct {
+	// UserId The ID of the user to assign
+	UserId uint `json:"user_id"`
+}
+
+// PostTasksIdAssigneesParams defines parameters for PostTasksIdAssignees.
+type PostTasksIdAssigneesParams struct {
+	// XUserId The ID of the user making the request
+	XUserId uint `json:"X-User-Id"`
+}
+
+// DeleteTasksIdAssigneesUserIdParams defines parameters for DeleteTasksIdAssigneesUserId.
+type DeleteTasksIdAssigneesUserIdParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// PostTasksIdAttachmentsMultipartBody defines parameters for PostTasksIdAttachments.
+type PostTasksIdAttachmentsMultipartBody struct {
+	// File The file to attach
+	File openapi_types.File `json:"file"`
+}
+
+// PostTasksIdAttachmentsParams defines parameters for PostTasksIdAttachments.
+type PostTasksIdAttachmentsParams struct {
+	// XUserId The ID of the user uploading the file
+	XUserId uint `json:"X-User-Id"`
+}
+
+// DeleteTasksIdAttachmentsAttachmentIdParams defines parameters for DeleteTasksIdAttachmentsAttachmentId.
+type DeleteTasksIdAttachmentsAttachmentIdParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// PostTasksIdCommentsParams defines parameters for PostTasksIdComments.
+type PostTasksIdCommentsParams struct {
+	// XUserId The ID of the user writing the comment
+	XUserId uint `json:"X-User-Id"`
+}
+
+// DeleteTasksIdCommentsCommentIdParams defines parameters for DeleteTasksIdCommentsCommentId.
+type DeleteTasksIdCommentsCommentIdParams struct {
+	// XUserId The ID of the user making the request
+	XUserId uint `json:"X-User-Id"`
+}
+
+// PutTasksIdCommentsCommentIdParams defines parameters for PutTasksIdCommentsCommentId.
+type PutTasksIdCommentsCommentIdParams struct {
+	// XUserId The ID of the user making the request
+	XUserId uint `json:"X-User-Id"`
+}
+
+// PostTasksIdDependenciesJSONBody defines parameters for PostTasksIdDependencies.
+type PostTasksIdDependenciesJSONBody struct {
+	// BlockedBy The ID of the todo item that has to be completed first
+	BlockedBy openapi_types.UUID `json:"blocked_by"`
+}
+
+// PostTasksIdDependenciesParams defines parameters for PostTasksIdDependencies.
+type PostTasksIdDependenciesParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// DeleteTasksIdDependenciesBlockedByIdParams defines parameters for DeleteTasksIdDependenciesBlockedById.
+type DeleteTasksIdDependenciesBlockedByIdParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// PostTasksIdLabelsJSONBody defines parameters for PostTasksIdLabels.
+type PostTasksIdLabelsJSONBody struct {
+	// LabelId The ID of the label to add
+	LabelId openapi_types.UUID `json:"label_id"`
+}
+
+// PostTasksIdLabelsParams defines parameters for PostTasksIdLabels.
+type PostTasksIdLabelsParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// DeleteTasksIdLabelsLabelIdParams defines parameters for DeleteTasksIdLabelsLabelId.
+type DeleteTasksIdLabelsLabelIdParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// PostTasksIdReorderJSONBody defines parameters for PostTasksIdReorder.
+type PostTasksIdReorderJSONBody struct {
+	// AfterId The sibling the todo item goes right after
+	AfterId *openapi_types.UUID `json:"after_id,omitempty"`
+
+	// BeforeId The sibling the todo item goes right before, used when after_id is not set. With neither set the item goes to the end
+	BeforeId *openapi_types.UUID `json:"before_id,omitempty"`
+}
+
+// PostTasksIdReorderParams defines parameters for PostTasksIdReorder.
+type PostTasksIdReorderParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// PostTasksIdRestoreParams defines parameters for PostTasksIdRestore.
+type PostTasksIdRestoreParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// PostTasksIdTimeEntriesParams defines parameters for PostTasksIdTimeEntries.
+type PostTasksIdTimeEntriesParams struct {
+	// XUserId The ID of the user logging the time
+	XUserId uint `json:"X-User-Id"`
+}
+
+// DeleteTasksIdTimeEntriesEntryIdParams defines parameters for DeleteTasksIdTimeEntriesEntryId.
+type DeleteTasksIdTimeEntriesEntryIdParams struct {
+	// XUserId The ID of the user making the request
+	XUserId uint `json:"X-User-Id"`
+}
+
+// PostTasksIdUnarchiveParams defines parameters for PostTasksIdUnarchive.
+type PostTasksIdUnarchiveParams struct {
+	// XUserId The ID of the user making the request, recorded in the history
+	XUserId *uint `json:"X-User-Id,omitempty"`
+}
+
+// PostUndoParams defines parameters for PostUndo.
+type PostUndoParams struct {
+	// XUserId The ID of the user making the request
+	XUserId uint `json:"X-User-Id"`
+}
+
+// PostWebhooksParams defines parameters for PostWebhooks.
+type PostWebhooksParams struct {
+	// XUserId The ID of the user creating the webhook
+	XUserId uint `json:"X-User-Id"`
+}
+
+// PostLabelsJSONRequestBody defines body for PostLabels for application/json ContentType.
+type PostLabelsJSONRequestBody PostLabelsJSONBody
+
+// PutLabelsIdJSONRequestBody defines body for PutLabelsId for application/json ContentType.
+type PutLabelsIdJSONRequestBody = LabelInput
+
+// PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
+type PostLoginJSONRequestBody PostLoginJSONBody
+
+// PostTasksJSONRequestBody defines body for PostTasks for application/json ContentType.
+type PostTasksJSONRequestBody = Todo
+
+// PutTasksIdJSONRequestBody defines body for PutTasksId for application/json ContentType.
+type PutTasksIdJSONRequestBody = TodoUpdate
+
+// PostTasksIdAssigneesJSONRequestBody defines body for PostTasksIdAssignees for application/json ContentType.
+type PostTasksIdAssigneesJSONRequestBody PostTasksIdAssigneesJSONBody
+
+// PostTasksIdAttachmentsMultipartRequestBody defines body for PostTasksIdAttachments for multipart/form-data ContentType.
+type PostTasksIdAttachmentsMultipartRequestBody PostTasksIdAttachmentsMultipartBody
+
+// PostTasksIdCommentsJSONRequestBody defines body for PostTasksIdComments for application/json ContentType.
+type PostTasksIdCommentsJSONRequestBody = CommentInput
+
+// PutTasksIdCommentsCommentIdJSONRequestBody defines body for PutTasksIdCommentsCommentId for application/json ContentType.
+type PutTasksIdCommentsCommentIdJSONRequestBody = CommentInput
+
+// PostTasksIdDependenciesJSONRequestBody defines body for PostTasksIdDependencies for application/json ContentType.
+type PostTasksIdDependenciesJSONRequestBody PostTasksIdDependenciesJSONBody
+
+// PostTasksIdLabelsJSONRequestBody defines body for PostTasksIdLabels for application/json ContentType.
+type PostTasksIdLabelsJSONRequestBody PostTasksIdLabelsJSONBody
+
+// PostTasksIdReorderJSONRequestBody defines body for PostTasksIdReorder for application/json ContentType.
+type PostTasksIdReorderJSONRequestBody PostTasksIdReorderJSONBody
+
+// PostTasksIdTimeEntriesJSONRequestBody defines body for PostTasksIdTimeEntries for application/json ContentType.
+type PostTasksIdTimeEntriesJSONRequestBody = TimeEntryInput
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = WebhookInput
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the latest changes made to all todo items, newest first
+	// (GET /activity)
+	GetActivity(w http.ResponseWriter, r *http.Request, params GetActivityParams)
+	// Get the token of the user's calendar feed, creating it the first time
+	// (GET /calendar/token)
+	GetCalendarToken(w http.ResponseWriter, r *http.Request, params GetCalendarTokenParams)
+	// Replace the token of the user's calendar feed, the old feed URL stops working
+	// (POST /calendar/token)
+	PostCalendarToken(w http.ResponseWriter, r *http.Request, params PostCalendarTokenParams)
+	// iCalendar feed of the todo items with a due date that the user created or is assigned to, as both to-dos and events
+	// (GET /calendar/{token}.ics)
+	GetCalendarTokenIcs(w http.ResponseWriter, r *http.Request, token string)
+	// Export all todo items that are not in the trash, to back them up or move them to another server
+	// (GET /export)
+	GetExport(w http.ResponseWriter, r *http.Request, params GetExportParams)
+	// Import todo items from an exported file, all of them or none
+	// (POST /import)
+	PostImport(w http.ResponseWriter, r *http.Request, params PostImportParams)
+	// Get the email address that creates todo items for the user, creating it the first time
+	// (GET /inbox/address)
+	GetInboxAddress(w http.ResponseWriter, r *http.Request, params GetInboxAddressParams)
+	// Replace the token in the user's email address, the old address stops working
+	// (POST /inbox/address)
+	PostInboxAddress(w http.ResponseWriter, r *http.Request, params PostInboxAddressParams)
+	// Get all labels
+	// (GET /labels)
+	GetLabels(w http.ResponseWriter, r *http.Request, params GetLabelsParams)
+	// Create a new label on a board
+	// (POST /labels)
+	PostLabels(w http.ResponseWriter, r *http.Request)
+	// Delete a label and remove it from all todo items
+	// (DELETE /labels/{id})
+	DeleteLabelsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteLabelsIdParams)
+	// Update a label
+	// (PUT /labels/{id})
+	PutLabelsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Login the user with the given username
+	// (POST /login)
+	PostLogin(w http.ResponseWriter, r *http.Request)
+	// Redo the last undone operation of the user
+	// (POST /redo)
+	PostRedo(w http.ResponseWriter, r *http.Request, params PostRedoParams)
+	// Get how many todo items were created and completed over time, with the burndown of the open cost
+	// (GET /reports/completion)
+	GetReportsCompletion(w http.ResponseWriter, r *http.Request, params GetReportsCompletionParams)
+	// Get how much was spent over time, the own cost of todo items counted when they were completed
+	// (GET /reports/cost)
+	GetReportsCost(w http.ResponseWriter, r *http.Request, params GetReportsCostParams)
+	// Get all todo items
+	// (GET /tasks)
+	GetTasks(w http.ResponseWriter, r *http.Request, params GetTasksParams)
+	// Create a new todo item
+	// (POST /tasks)
+	PostTasks(w http.ResponseWriter, r *http.Request)
+	// Get all todo items ordered so every item comes after the items blocking it
+	// (GET /tasks/ordered)
+	GetTasksOrdered(w http.ResponseWriter, r *http.Request)
+	// Move the todo item and all of its subtasks to the trash
+	// (DELETE /tasks/{id})
+	DeleteTasksId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteTasksIdParams)
+	// Update the todo item
+	// (PUT /tasks/{id})
+	PutTasksId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutTasksIdParams)
+	// Archive the todo item and all of its subtasks
+	// (POST /tasks/{id}/archive)
+	PostTasksIdArchive(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdArchiveParams)
+	// Assign a user to the todo item
+	// (POST /tasks/{id}/assignees)
+	PostTasksIdAssignees(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdAssigneesParams)
+	// Unassign a user from the todo item
+	// (DELETE /tasks/{id}/assignees/{user_id})
+	DeleteTasksIdAssigneesUserId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, userId uint, params DeleteTasksIdAssigneesUserIdParams)
+	// Upload a file and attach it to the todo item
+	// (POST /tasks/{id}/attachments)
+	PostTasksIdAttachments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdAttachmentsParams)
+	// Delete the attachment
+	// (DELETE /tasks/{id}/attachments/{attachment_id})
+	DeleteTasksIdAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, attachmentId openapi_types.UUID, params DeleteTasksIdAttachmentsAttachmentIdParams)
+	// Download the attached file
+	// (GET /tasks/{id}/attachments/{attachment_id})
+	GetTasksIdAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, attachmentId openapi_types.UUID)
+	// Get the comments of the todo item
+	// (GET /tasks/{id}/comments)
+	GetTasksIdComments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Add a comment to the todo item
+	// (POST /tasks/{id}/comments)
+	PostTasksIdComments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdCommentsParams)
+	// Delete a comment, only the author can do this
+	// (DELETE /tasks/{id}/comments/{comment_id})
+	DeleteTasksIdCommentsCommentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID, params DeleteTasksIdCommentsCommentIdParams)
+	// Edit a comment, only the author can do this
+	// (PUT /tasks/{id}/comments/{comment_id})
+	PutTasksIdCommentsCommentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID, params PutTasksIdCommentsCommentIdParams)
+	// Make another todo item block the todo item
+	// (POST /tasks/{id}/dependencies)
+	PostTasksIdDependencies(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdDependenciesParams)
+	// Stop another todo item from blocking the todo item
+	// (DELETE /tasks/{id}/dependencies/{blocked_by_id})
+	DeleteTasksIdDependenciesBlockedById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, blockedById openapi_types.UUID, params DeleteTasksIdDependenciesBlockedByIdParams)
+	// Get every change made to the todo item, oldest first
+	// (GET /tasks/{id}/history)
+	GetTasksIdHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Add a label to the todo item
+	// (POST /tasks/{id}/labels)
+	PostTasksIdLabels(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdLabelsParams)
+	// Remove a label from the todo item
+	// (DELETE /tasks/{id}/labels/{label_id})
+	DeleteTasksIdLabelsLabelId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, labelId openapi_types.UUID, params DeleteTasksIdLabelsLabelIdParams)
+	// Move the todo item among its siblings
+	// (POST /tasks/{id}/reorder)
+	PostTasksIdReorder(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdReorderParams)
+	// Take the todo item and the subtasks deleted with it out of the trash
+	// (POST /tasks/{id}/restore)
+	PostTasksIdRestore(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdRestoreParams)
+	// Get the time logged on the todo item, running timers included
+	// (GET /tasks/{id}/time-entries)
+	GetTasksIdTimeEntries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Log time on the todo item without a timer
+	// (POST /tasks/{id}/time-entries)
+	PostTasksIdTimeEntries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdTimeEntriesParams)
+	// Delete a time entry, only the user that logged it can do this
+	// (DELETE /tasks/{id}/time-entries/{entry_id})
+	DeleteTasksIdTimeEntriesEntryId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, entryId openapi_types.UUID, params DeleteTasksIdTimeEntriesEntryIdParams)
+	// Unarchive the todo item and the subtasks archived with it
+	// (POST /tasks/{id}/unarchive)
+	PostTasksIdUnarchive(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdUnarchiveParams)
+	// Get the todo items in the trash, the most recently deleted first
+	// (GET /trash)
+	GetTrash(w http.ResponseWriter, r *http.Request)
+	// Undo the last operation of the user
+	// (POST /undo)
+	PostUndo(w http.ResponseWriter, r *http.Request, params PostUndoParams)
+	// Get user by id
+	// (GET /user/{id})
+	GetUserId(w http.ResponseWriter, r *http.Request, id uint)
+	// Get all webhooks, without their secrets
+	// (GET /webhooks)
+	GetWebhooks(w http.ResponseWriter, r *http.Request)
+	// Subscribe a URL to the task events, which are posted to it signed with the secret of the webhook
+	// (POST /webhooks)
+	PostWebhooks(w http.ResponseWriter, r *http.Request, params PostWebhooksParams)
+	// Delete a webhook with its deliveries, pending ones are not sent
+	// (DELETE /webhooks/{id})
+	DeleteWebhooksId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get the deliveries of a webhook, with the result of their last attempt
+	// (GET /webhooks/{id}/deliveries)
+	GetWebhooksIdDeliveries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Send the payload of a delivery again, as a new delivery
+	// (POST /webhooks/{id}/deliveries/{delivery_id}/redeliver)
+	PostWebhooksIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, deliveryId openapi_types.UUID)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Get the latest changes made to all todo items, newest first
+// (GET /activity)
+func (_ Unimplemented) GetActivity(w http.ResponseWriter, r *http.Request, params GetActivityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the token of the user's calendar feed, creating it the first time
+// (GET /calendar/token)
+func (_ Unimplemented) GetCalendarToken(w http.ResponseWriter, r *http.Request, params GetCalendarTokenParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the token of the user's calendar feed, the old feed URL stops working
+// (POST /calendar/token)
+func (_ Unimplemented) PostCalendarToken(w http.ResponseWriter, r *http.Request, params PostCalendarTokenParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// iCalendar feed of the todo items with a due date that the user created or is assigned to, as both to-dos and events
+// (GET /calendar/{token}.ics)
+func (_ Unimplemented) GetCalendarTokenIcs(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export all todo items that are not in the trash, to back them up or move them to another server
+// (GET /export)
+func (_ Unimplemented) GetExport(w http.ResponseWriter, r *http.Request, params GetExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import todo items from an exported file, all of them or none
+// (POST /import)
+func (_ Unimplemented) PostImport(w http.ResponseWriter, r *http.Request, params PostImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the email address that creates todo items for the user, creating it the first time
+// (GET /inbox/address)
+func (_ Unimplemented) GetInboxAddress(w http.ResponseWriter, r *http.Request, params GetInboxAddressParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the token in the user's email address, the old address stops working
+// (POST /inbox/address)
+func (_ Unimplemented) PostInboxAddress(w http.ResponseWriter, r *http.Request, params PostInboxAddressParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all labels
+// (GET /labels)
+func (_ Unimplemented) GetLabels(w http.ResponseWriter, r *http.Request, params GetLabelsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new label on a board
+// (POST /labels)
+func (_ Unimplemented) PostLabels(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a label and remove it from all todo items
+// (DELETE /labels/{id})
+func (_ Unimplemented) DeleteLabelsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteLabelsIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a label
+// (PUT /labels/{id})
+func (_ Unimplemented) PutLabelsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Login the user with the given username
+// (POST /login)
+func (_ Unimplemented) PostLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Redo the last undone operation of the user
+// (POST /redo)
+func (_ Unimplemented) PostRedo(w http.ResponseWriter, r *http.Request, params PostRedoParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get how many todo items were created and completed over time, with the burndown of the open cost
+// (GET /reports/completion)
+func (_ Unimplemented) GetReportsCompletion(w http.ResponseWriter, r *http.Request, params GetReportsCompletionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get how much was spent over time, the own cost of todo items counted when they were completed
+// (GET /reports/cost)
+func (_ Unimplemented) GetReportsCost(w http.ResponseWriter, r *http.Request, params GetReportsCostParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all todo items
+// (GET /tasks)
+func (_ Unimplemented) GetTasks(w http.ResponseWriter, r *http.Request, params GetTasksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new todo item
+// (POST /tasks)
+func (_ Unimplemented) PostTasks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all todo items ordered so every item comes after the items blocking it
+// (GET /tasks/ordered)
+func (_ Unimplemented) GetTasksOrdered(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move the todo item and all of its subtasks to the trash
+// (DELETE /tasks/{id})
+func (_ Unimplemented) DeleteTasksId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteTasksIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the todo item
+// (PUT /tasks/{id})
+func (_ Unimplemented) PutTasksId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutTasksIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Archive the todo item and all of its subtasks
+// (POST /tasks/{id}/archive)
+func (_ Unimplemented) PostTasksIdArchive(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Assign a user to the todo item
+// (POST /tasks/{id}/assignees)
+func (_ Unimplemented) PostTasksIdAssignees(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdAssigneesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unassign a user from the todo item
+// (DELETE /tasks/{id}/assignees/{user_id})
+func (_ Unimplemented) DeleteTasksIdAssigneesUserId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, userId uint, params DeleteTasksIdAssigneesUserIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a file and attach it to the todo item
+// (POST /tasks/{id}/attachments)
+func (_ Unimplemented) PostTasksIdAttachments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdAttachmentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete the attachment
+// (DELETE /tasks/{id}/attachments/{attachment_id})
+func (_ Unimplemented) DeleteTasksIdAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, attachmentId openapi_types.UUID, params DeleteTasksIdAttachmentsAttachmentIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download the attached file
+// (GET /tasks/{id}/attachments/{attachment_id})
+func (_ Unimplemented) GetTasksIdAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, attachmentId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the comments of the todo item
+// (GET /tasks/{id}/comments)
+func (_ Unimplemented) GetTasksIdComments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a comment to the todo item
+// (POST /tasks/{id}/comments)
+func (_ Unimplemented) PostTasksIdComments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdCommentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a comment, only the author can do this
+// (DELETE /tasks/{id}/comments/{comment_id})
+func (_ Unimplemented) DeleteTasksIdCommentsCommentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID, params DeleteTasksIdCommentsCommentIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Edit a comment, only the author can do this
+// (PUT /tasks/{id}/comments/{comment_id})
+func (_ Unimplemented) PutTasksIdCommentsCommentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID, params PutTasksIdCommentsCommentIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Make another todo item block the todo item
+// (POST /tasks/{id}/dependencies)
+func (_ Unimplemented) PostTasksIdDependencies(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdDependenciesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop another todo item from blocking the todo item
+// (DELETE /tasks/{id}/dependencies/{blocked_by_id})
+func (_ Unimplemented) DeleteTasksIdDependenciesBlockedById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, blockedById openapi_types.UUID, params DeleteTasksIdDependenciesBlockedByIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get every change made to the todo item, oldest first
+// (GET /tasks/{id}/history)
+func (_ Unimplemented) GetTasksIdHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a label to the todo item
+// (POST /tasks/{id}/labels)
+func (_ Unimplemented) PostTasksIdLabels(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdLabelsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a label from the todo item
+// (DELETE /tasks/{id}/labels/{label_id})
+func (_ Unimplemented) DeleteTasksIdLabelsLabelId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, labelId openapi_types.UUID, params DeleteTasksIdLabelsLabelIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move the todo item among its siblings
+// (POST /tasks/{id}/reorder)
+func (_ Unimplemented) PostTasksIdReorder(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdReorderParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Take the todo item and the subtasks deleted with it out of the trash
+// (POST /tasks/{id}/restore)
+func (_ Unimplemented) PostTasksIdRestore(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdRestoreParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the time logged on the todo item, running timers included
+// (GET /tasks/{id}/time-entries)
+func (_ Unimplemented) GetTasksIdTimeEntries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log time on the todo item without a timer
+// (POST /tasks/{id}/time-entries)
+func (_ Unimplemented) PostTasksIdTimeEntries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdTimeEntriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a time entry, only the user that logged it can do this
+// (DELETE /tasks/{id}/time-entries/{entry_id})
+func (_ Unimplemented) DeleteTasksIdTimeEntriesEntryId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, entryId openapi_types.UUID, params DeleteTasksIdTimeEntriesEntryIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unarchive the todo item and the subtasks archived with it
+// (POST /tasks/{id}/unarchive)
+func (_ Unimplemented) PostTasksIdUnarchive(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdUnarchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the todo items in the trash, the most recently deleted first
+// (GET /trash)
+func (_ Unimplemented) GetTrash(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Undo the last operation of the user
+// (POST /undo)
+func (_ Unimplemented) PostUndo(w http.ResponseWriter, r *http.Request, params PostUndoParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get user by id
+// (GET /user/{id})
+func (_ Unimplemented) GetUserId(w http.ResponseWriter, r *http.Request, id uint) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all webhooks, without their secrets
+// (GET /webhooks)
+func (_ Unimplemented) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Subscribe a URL to the task events, which are posted to it signed with the secret of the webhook
+// (POST /webhooks)
+func (_ Unimplemented) PostWebhooks(w http.ResponseWriter, r *http.Request, params PostWebhooksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a webhook with its deliveries, pending ones are not sent
+// (DELETE /webhooks/{id})
+func (_ Unimplemented) DeleteWebhooksId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the deliveries of a webhook, with the result of their last attempt
+// (GET /webhooks/{id}/deliveries)
+func (_ Unimplemented) GetWebhooksIdDeliveries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send the payload of a delivery again, as a new delivery
+// (POST /webhooks/{id}/deliveries/{delivery_id}/redeliver)
+func (_ Unimplemented) PostWebhooksIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, deliveryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetActivity operation middleware
+func (siw *ServerInterfaceWrapper) GetActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetActivityParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetActivity(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCalendarToken operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarTokenParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendarToken(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostCalendarToken operation middleware
+func (siw *ServerInterfaceWrapper) PostCalendarToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostCalendarTokenParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCalendarToken(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCalendarTokenIcs operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarTokenIcs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendarTokenIcs(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetExport operation middleware
+func (siw *ServerInterfaceWrapper) GetExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostImport operation middleware
+func (siw *ServerInterfaceWrapper) PostImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostImportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "parent_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent_id", r.URL.Query(), &params.ParentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInboxAddress operation middleware
+func (siw *ServerInterfaceWrapper) GetInboxAddress(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInboxAddressParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInboxAddress(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostInboxAddress operation middleware
+func (siw *ServerInterfaceWrapper) PostInboxAddress(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostInboxAddressParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostInboxAddress(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLabels operation middleware
+func (siw *ServerInterfaceWrapper) GetLabels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLabelsParams
+
+	// ------------- Optional query parameter "board_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "board_id", r.URL.Query(), &params.BoardId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "board_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLabels(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostLabels operation middleware
+func (siw *ServerInterfaceWrapper) PostLabels(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLabels(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteLabelsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLabelsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteLabelsIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLabelsId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutLabelsId operation middleware
+func (siw *ServerInterfaceWrapper) PutLabelsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutLabelsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRedo operation middleware
+func (siw *ServerInterfaceWrapper) PostRedo(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostRedoParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRedo(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReportsCompletion operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsCompletion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsCompletionParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", r.URL.Query(), &params.Bucket)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bucket", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReportsCompletion(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReportsCost operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsCost(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsCostParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", r.URL.Query(), &params.Bucket)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bucket", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReportsCost(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetTasks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTasksParams
+
+	// ------------- Optional query parameter "overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", r.URL.Query(), &params.Overdue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "overdue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", r.URL.Query(), &params.Archived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "archived", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "assignee" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assignee", r.URL.Query(), &params.Assignee)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "assignee", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTasks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasks operation middleware
+func (siw *ServerInterfaceWrapper) PostTasks(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTasksOrdered operation middleware
+func (siw *ServerInterfaceWrapper) GetTasksOrdered(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTasksOrdered(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTasksId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTasksId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTasksIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTasksId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutTasksId operation middleware
+func (siw *ServerInterfaceWrapper) PutTasksId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTasksIdParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutTasksId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasksIdArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostTasksIdArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdArchiveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasksIdArchive(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasksIdAssignees operation middleware
+func (siw *ServerInterfaceWrapper) PostTasksIdAssignees(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdAssigneesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasksIdAssignees(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTasksIdAssigneesUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTasksIdAssigneesUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTasksIdAssigneesUserIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTasksIdAssigneesUserId(w, r, id, userId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasksIdAttachments operation middleware
+func (siw *ServerInterfaceWrapper) PostTasksIdAttachments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdAttachmentsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasksIdAttachments(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTasksIdAttachmentsAttachmentId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTasksIdAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", chi.URLParam(r, "attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachment_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTasksIdAttachmentsAttachmentIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTasksIdAttachmentsAttachmentId(w, r, id, attachmentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTasksIdAttachmentsAttachmentId operation middleware
+func (siw *ServerInterfaceWrapper) GetTasksIdAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", chi.URLParam(r, "attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachment_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTasksIdAttachmentsAttachmentId(w, r, id, attachmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTasksIdComments operation middleware
+func (siw *ServerInterfaceWrapper) GetTasksIdComments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTasksIdComments(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasksIdComments operation middleware
+func (siw *ServerInterfaceWrapper) PostTasksIdComments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdCommentsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasksIdComments(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTasksIdCommentsCommentId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTasksIdCommentsCommentId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", chi.URLParam(r, "comment_id"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "comment_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTasksIdCommentsCommentIdParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTasksIdCommentsCommentId(w, r, id, commentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutTasksIdCommentsCommentId operation middleware
+func (siw *ServerInterfaceWrapper) PutTasksIdCommentsCommentId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", chi.URLParam(r, "comment_id"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "comment_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTasksIdCommentsCommentIdParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutTasksIdCommentsCommentId(w, r, id, commentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasksIdDependencies operation middleware
+func (siw *ServerInterfaceWrapper) PostTasksIdDependencies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdDependenciesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasksIdDependencies(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTasksIdDependenciesBlockedById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTasksIdDependenciesBlockedById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "blocked_by_id" -------------
+	var blockedById openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blocked_by_id", chi.URLParam(r, "blocked_by_id"), &blockedById, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "blocked_by_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTasksIdDependenciesBlockedByIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTasksIdDependenciesBlockedById(w, r, id, blockedById, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTasksIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetTasksIdHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTasksIdHistory(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasksIdLabels operation middleware
+func (siw *ServerInterfaceWrapper) PostTasksIdLabels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdLabelsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasksIdLabels(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTasksIdLabelsLabelId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTasksIdLabelsLabelId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "label_id" -------------
+	var labelId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "label_id", chi.URLParam(r, "label_id"), &labelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTasksIdLabelsLabelIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTasksIdLabelsLabelId(w, r, id, labelId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasksIdReorder operation middleware
+func (siw *ServerInterfaceWrapper) PostTasksIdReorder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdReorderParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasksIdReorder(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasksIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostTasksIdRestore(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdRestoreParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasksIdRestore(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTasksIdTimeEntries operation middleware
+func (siw *ServerInterfaceWrapper) GetTasksIdTimeEntries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTasksIdTimeEntries(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasksIdTimeEntries operation middleware
+func (siw *ServerInterfaceWrapper) PostTasksIdTimeEntries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdTimeEntriesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasksIdTimeEntries(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTasksIdTimeEntriesEntryId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTasksIdTimeEntriesEntryId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entry_id" -------------
+	var entryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entry_id", chi.URLParam(r, "entry_id"), &entryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entry_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTasksIdTimeEntriesEntryIdParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTasksIdTimeEntriesEntryId(w, r, id, entryId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTasksIdUnarchive operation middleware
+func (siw *ServerInterfaceWrapper) PostTasksIdUnarchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdUnarchiveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTasksIdUnarchive(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetTrash(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrash(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUndo operation middleware
+func (siw *ServerInterfaceWrapper) PostUndo(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUndoParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUndo(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserId operation middleware
+func (siw *ServerInterfaceWrapper) GetUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWebhooksParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId uint
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-Id", Err: err})
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-Id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-Id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhooksId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhooksId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksIdDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksIdDeliveries(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksIdDeliveriesDeliveryIdRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "delivery_id" -------------
+	var deliveryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "delivery_id", chi.URLParam(r, "delivery_id"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delivery_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksIdDeliveriesDeliveryIdRedeliver(w, r, id, deliveryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/activity", wrapper.GetActivity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendar/token", wrapper.GetCalendarToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/calendar/token", wrapper.PostCalendarToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendar/{token}.ics", wrapper.GetCalendarTokenIcs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export", wrapper.GetExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/import", wrapper.PostImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/inbox/address", wrapper.GetInboxAddress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/inbox/address", wrapper.PostInboxAddress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/labels", wrapper.GetLabels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/labels", wrapper.PostLabels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/labels/{id}", wrapper.DeleteLabelsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/labels/{id}", wrapper.PutLabelsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/login", wrapper.PostLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/redo", wrapper.PostRedo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/completion", wrapper.GetReportsCompletion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/cost", wrapper.GetReportsCost)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tasks", wrapper.GetTasks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks", wrapper.PostTasks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tasks/ordered", wrapper.GetTasksOrdered)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tasks/{id}", wrapper.DeleteTasksId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/tasks/{id}", wrapper.PutTasksId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks/{id}/archive", wrapper.PostTasksIdArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks/{id}/assignees", wrapper.PostTasksIdAssignees)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tasks/{id}/assignees/{user_id}", wrapper.DeleteTasksIdAssigneesUserId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks/{id}/attachments", wrapper.PostTasksIdAttachments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tasks/{id}/attachments/{attachment_id}", wrapper.DeleteTasksIdAttachmentsAttachmentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tasks/{id}/attachments/{attachment_id}", wrapper.GetTasksIdAttachmentsAttachmentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tasks/{id}/comments", wrapper.GetTasksIdComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks/{id}/comments", wrapper.PostTasksIdComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tasks/{id}/comments/{comment_id}", wrapper.DeleteTasksIdCommentsCommentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/tasks/{id}/comments/{comment_id}", wrapper.PutTasksIdCommentsCommentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks/{id}/dependencies", wrapper.PostTasksIdDependencies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tasks/{id}/dependencies/{blocked_by_id}", wrapper.DeleteTasksIdDependenciesBlockedById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tasks/{id}/history", wrapper.GetTasksIdHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks/{id}/labels", wrapper.PostTasksIdLabels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tasks/{id}/labels/{label_id}", wrapper.DeleteTasksIdLabelsLabelId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks/{id}/reorder", wrapper.PostTasksIdReorder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks/{id}/restore", wrapper.PostTasksIdRestore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tasks/{id}/time-entries", wrapper.GetTasksIdTimeEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks/{id}/time-entries", wrapper.PostTasksIdTimeEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tasks/{id}/time-entries/{entry_id}", wrapper.DeleteTasksIdTimeEntriesEntryId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tasks/{id}/unarchive", wrapper.PostTasksIdUnarchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trash", wrapper.GetTrash)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/undo", wrapper.PostUndo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/{id}", wrapper.GetUserId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks", wrapper.PostWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{id}", wrapper.DeleteWebhooksId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{id}/deliveries", wrapper.GetWebhooksIdDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/{id}/deliveries/{delivery_id}/redeliver", wrapper.PostWebhooksIdDeliveriesDeliveryIdRedeliver)
+	})
+
+	return r
+}
+
+type GetActivityRequestObject struct {
+	Params GetActivityParams
+}
+
+type GetActivityResponseObject interface {
+	VisitGetActivityResponse(w http.ResponseWriter) error
+}
+
+type GetActivity200JSONResponse []HistoryEntry
+
+func (response GetActivity200JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivity500JSONResponse Error
+
+func (response GetActivity500JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendarTokenRequestObject struct {
+	Params GetCalendarTokenParams
+}
+
+type GetCalendarTokenResponseObject interface {
+	VisitGetCalendarTokenResponse(w http.ResponseWriter) error
+}
+
+type GetCalendarToken200JSONResponse CalendarToken
+
+func (response GetCalendarToken200JSONResponse) VisitGetCalendarTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendarToken400JSONResponse Error
+
+func (response GetCalendarToken400JSONResponse) VisitGetCalendarTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendarToken500JSONResponse Error
+
+func (response GetCalendarToken500JSONResponse) VisitGetCalendarTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCalendarTokenRequestObject struct {
+	Params PostCalendarTokenParams
+}
+
+type PostCalendarTokenResponseObject interface {
+	VisitPostCalendarTokenResponse(w http.ResponseWriter) error
+}
+
+type PostCalendarToken201JSONResponse CalendarToken
+
+func (response PostCalendarToken201JSONResponse) VisitPostCalendarTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCalendarToken400JSONResponse Error
+
+func (response PostCalendarToken400JSONResponse) VisitPostCalendarTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCalendarToken500JSONResponse Error
+
+func (response PostCalendarToken500JSONResponse) VisitPostCalendarTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendarTokenIcsRequestObject struct {
+	Token string `json:"token"`
+}
+
+type GetCalendarTokenIcsResponseObject interface {
+	VisitGetCalendarTokenIcsResponse(w http.ResponseWriter) error
+}
+
+type GetCalendarTokenIcs200TextcalendarResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetCalendarTokenIcs200TextcalendarResponse) VisitGetCalendarTokenIcsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/calendar")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCalendarTokenIcs404JSONResponse Error
+
+func (response GetCalendarTokenIcs404JSONResponse) VisitGetCalendarTokenIcsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendarTokenIcs500JSONResponse Error
+
+func (response GetCalendarTokenIcs500JSONResponse) VisitGetCalendarTokenIcsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportRequestObject struct {
+	Params GetExportParams
+}
+
+type GetExportResponseObject interface {
+	VisitGetExportResponse(w http.ResponseWriter) error
+}
+
+type GetExport200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetExport200AsteriskResponse struct {
+	Body          io.Reader
+	Headers       GetExport200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetExport200AsteriskResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExport400JSONResponse Error
+
+func (response GetExport400JSONResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExport500JSONResponse Error
+
+func (response GetExport500JSONResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImportRequestObject struct {
+	Params      PostImportParams
+	ContentType string
+	Body        io.Reader
+}
+
+type PostImportResponseObject interface {
+	VisitPostImportResponse(w http.ResponseWriter) error
+}
+
+type PostImport200JSONResponse ImportResult
+
+func (response PostImport200JSONResponse) VisitPostImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImport201JSONResponse ImportResult
+
+func (response PostImport201JSONResponse) VisitPostImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImport400JSONResponse Error
+
+func (response PostImport400JSONResponse) VisitPostImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImport500JSONResponse Error
+
+func (response PostImport500JSONResponse) VisitPostImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInboxAddressRequestObject struct {
+	Params GetInboxAddressParams
+}
+
+type GetInboxAddressResponseObject interface {
+	VisitGetInboxAddressResponse(w http.ResponseWriter) error
+}
+
+type GetInboxAddress200JSONResponse InboxAddress
+
+func (response GetInboxAddress200JSONResponse) VisitGetInboxAddressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInboxAddress400JSONResponse Error
+
+func (response GetInboxAddress400JSONResponse) VisitGetInboxAddressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInboxAddress500JSONResponse Error
+
+func (response GetInboxAddress500JSONResponse) VisitGetInboxAddressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostInboxAddressRequestObject struct {
+	Params PostInboxAddressParams
+}
+
+type PostInboxAddressResponseObject interface {
+	VisitPostInboxAddressResponse(w http.ResponseWriter) error
+}
+
+type PostInboxAddress201JSONResponse InboxAddress
+
+func (response PostInboxAddress201JSONResponse) VisitPostInboxAddressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostInboxAddress400JSONResponse Error
+
+func (response PostInboxAddress400JSONResponse) VisitPostInboxAddressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostInboxAddress500JSONResponse Error
+
+func (response PostInboxAddress500JSONResponse) VisitPostInboxAddressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLabelsRequestObject struct {
+	Params GetLabelsParams
+}
+
+type GetLabelsResponseObject interface {
+	VisitGetLabelsResponse(w http.ResponseWriter) error
+}
+
+type GetLabels200JSONResponse []Label
+
+func (response GetLabels200JSONResponse) VisitGetLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLabels500JSONResponse Error
+
+func (response GetLabels500JSONResponse) VisitGetLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLabelsRequestObject struct {
+	Body *PostLabelsJSONRequestBody
+}
+
+type PostLabelsResponseObject interface {
+	VisitPostLabelsResponse(w http.ResponseWriter) error
+}
+
+type PostLabels201JSONResponse Label
+
+func (response PostLabels201JSONResponse) VisitPostLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLabels400JSONResponse Error
+
+func (response PostLabels400JSONResponse) VisitPostLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLabels409JSONResponse Error
+
+func (response PostLabels409JSONResponse) VisitPostLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLabels500JSONResponse Error
+
+func (response PostLabels500JSONResponse) VisitPostLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLabelsIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params DeleteLabelsIdParams
+}
+
+type DeleteLabelsIdResponseObject interface {
+	VisitDeleteLabelsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteLabelsId204Response struct {
+}
+
+func (response DeleteLabelsId204Response) VisitDeleteLabelsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteLabelsId500JSONResponse Error
+
+func (response DeleteLabelsId500JSONResponse) VisitDeleteLabelsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutLabelsIdRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *PutLabelsIdJSONRequestBody
+}
+
+type PutLabelsIdResponseObject interface {
+	VisitPutLabelsIdResponse(w http.ResponseWriter) error
+}
+
+type PutLabelsId200JSONResponse Label
+
+func (response PutLabelsId200JSONResponse) VisitPutLabelsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutLabelsId400JSONResponse Error
+
+func (response PutLabelsId400JSONResponse) VisitPutLabelsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutLabelsId404JSONResponse Error
+
+func (response PutLabelsId404JSONResponse) VisitPutLabelsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutLabelsId409JSONResponse Error
+
+func (response PutLabelsId409JSONResponse) VisitPutLabelsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutLabelsId500JSONResponse Error
+
+func (response PutLabelsId500JSONResponse) VisitPutLabelsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginRequestObject struct {
+	Body *PostLoginJSONRequestBody
+}
+
+type PostLoginResponseObject interface {
+	VisitPostLoginResponse(w http.ResponseWriter) error
+}
+
+type PostLogin200JSONResponse LoginResponse
+
+func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin400JSONResponse Error
+
+func (response PostLogin400JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin401JSONResponse Error
 
 func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin500JSONResponse Error
+
+func (response PostLogin500JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRedoRequestObject struct {
+	Params PostRedoParams
+}
+
+type PostRedoResponseObject interface {
+	VisitPostRedoResponse(w http.ResponseWriter) error
+}
+
+type PostRedo200JSONResponse UndoChanges
+
+func (response PostRedo200JSONResponse) VisitPostRedoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRedo409JSONResponse Error
+
+func (response PostRedo409JSONResponse) VisitPostRedoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRedo500JSONResponse Error
+
+func (response PostRedo500JSONResponse) VisitPostRedoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsCompletionRequestObject struct {
+	Params GetReportsCompletionParams
+}
+
+type GetReportsCompletionResponseObject interface {
+	VisitGetReportsCompletionResponse(w http.ResponseWriter) error
+}
+
+type GetReportsCompletion200JSONResponse CompletionReport
+
+func (response GetReportsCompletion200JSONResponse) VisitGetReportsCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsCompletion400JSONResponse Error
+
+func (response GetReportsCompletion400JSONResponse) VisitGetReportsCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsCompletion500JSONResponse Error
+
+func (response GetReportsCompletion500JSONResponse) VisitGetReportsCompletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsCostRequestObject struct {
+	Params GetReportsCostParams
+}
+
+type GetReportsCostResponseObject interface {
+	VisitGetReportsCostResponse(w http.ResponseWriter) error
+}
+
+type GetReportsCost200JSONResponse CostReport
+
+func (response GetReportsCost200JSONResponse) VisitGetReportsCostResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsCost400JSONResponse Error
+
+func (response GetReportsCost400JSONResponse) VisitGetReportsCostResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsCost500JSONResponse Error
+
+func (response GetReportsCost500JSONResponse) VisitGetReportsCostResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksRequestObject struct {
+	Params GetTasksParams
+}
+
+type GetTasksResponseObject interface {
+	VisitGetTasksResponse(w http.ResponseWriter) error
+}
+
+type GetTasks200JSONResponse []Todo
+
+func (response GetTasks200JSONResponse) VisitGetTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasks400JSONResponse Error
+
+func (response GetTasks400JSONResponse) VisitGetTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasks500JSONResponse Error
+
+func (response GetTasks500JSONResponse) VisitGetTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksRequestObject struct {
+	Body *PostTasksJSONRequestBody
+}
+
+type PostTasksResponseObject interface {
+	VisitPostTasksResponse(w http.ResponseWriter) error
+}
+
+type PostTasks201Response struct {
+}
+
+func (response PostTasks201Response) VisitPostTasksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type PostTasks400JSONResponse Error
+
+func (response PostTasks400JSONResponse) VisitPostTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasks500JSONResponse Error
+
+func (response PostTasks500JSONResponse) VisitPostTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksOrderedRequestObject struct {
+}
+
+type GetTasksOrderedResponseObject interface {
+	VisitGetTasksOrderedResponse(w http.ResponseWriter) error
+}
+
+type GetTasksOrdered200JSONResponse []Todo
+
+func (response GetTasksOrdered200JSONResponse) VisitGetTasksOrderedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksOrdered500JSONResponse Error
+
+func (response GetTasksOrdered500JSONResponse) VisitGetTasksOrderedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params DeleteTasksIdParams
+}
+
+type DeleteTasksIdResponseObject interface {
+	VisitDeleteTasksIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTasksId200JSONResponse DeletedTasks
+
+func (response DeleteTasksId200JSONResponse) VisitDeleteTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksId409JSONResponse Error
+
+func (response DeleteTasksId409JSONResponse) VisitDeleteTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksId500JSONResponse Error
+
+func (response DeleteTasksId500JSONResponse) VisitDeleteTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PutTasksIdParams
+	Body   *PutTasksIdJSONRequestBody
+}
+
+type PutTasksIdResponseObject interface {
+	VisitPutTasksIdResponse(w http.ResponseWriter) error
+}
+
+type PutTasksId204Response struct {
+}
+
+func (response PutTasksId204Response) VisitPutTasksIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PutTasksId400JSONResponse Error
+
+func (response PutTasksId400JSONResponse) VisitPutTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksId404JSONResponse Error
+
+func (response PutTasksId404JSONResponse) VisitPutTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksId409JSONResponse Error
+
+func (response PutTasksId409JSONResponse) VisitPutTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksId500JSONResponse Error
+
+func (response PutTasksId500JSONResponse) VisitPutTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdArchiveRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostTasksIdArchiveParams
+}
+
+type PostTasksIdArchiveResponseObject interface {
+	VisitPostTasksIdArchiveResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdArchive200JSONResponse ArchivedTasks
+
+func (response PostTasksIdArchive200JSONResponse) VisitPostTasksIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdArchive409JSONResponse Error
+
+func (response PostTasksIdArchive409JSONResponse) VisitPostTasksIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdArchive500JSONResponse Error
+
+func (response PostTasksIdArchive500JSONResponse) VisitPostTasksIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdAssigneesRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostTasksIdAssigneesParams
+	Body   *PostTasksIdAssigneesJSONRequestBody
+}
+
+type PostTasksIdAssigneesResponseObject interface {
+	VisitPostTasksIdAssigneesResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdAssignees200JSONResponse Todo
+
+func (response PostTasksIdAssignees200JSONResponse) VisitPostTasksIdAssigneesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdAssignees500JSONResponse Error
+
+func (response PostTasksIdAssignees500JSONResponse) VisitPostTasksIdAssigneesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdAssigneesUserIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	UserId uint               `json:"user_id"`
+	Params DeleteTasksIdAssigneesUserIdParams
+}
+
+type DeleteTasksIdAssigneesUserIdResponseObject interface {
+	VisitDeleteTasksIdAssigneesUserIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTasksIdAssigneesUserId200JSONResponse Todo
+
+func (response DeleteTasksIdAssigneesUserId200JSONResponse) VisitDeleteTasksIdAssigneesUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdAssigneesUserId500JSONResponse Error
+
+func (response DeleteTasksIdAssigneesUserId500JSONResponse) VisitDeleteTasksIdAssigneesUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdAttachmentsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostTasksIdAttachmentsParams
+	Body   *multipart.Reader
+}
+
+type PostTasksIdAttachmentsResponseObject interface {
+	VisitPostTasksIdAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdAttachments201JSONResponse Attachment
+
+func (response PostTasksIdAttachments201JSONResponse) VisitPostTasksIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdAttachments400JSONResponse Error
+
+func (response PostTasksIdAttachments400JSONResponse) VisitPostTasksIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdAttachments413JSONResponse Error
+
+func (response PostTasksIdAttachments413JSONResponse) VisitPostTasksIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdAttachments415JSONResponse Error
+
+func (response PostTasksIdAttachments415JSONResponse) VisitPostTasksIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdAttachments500JSONResponse Error
+
+func (response PostTasksIdAttachments500JSONResponse) VisitPostTasksIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdAttachmentsAttachmentIdRequestObject struct {
+	Id           openapi_types.UUID `json:"id"`
+	AttachmentId openapi_types.UUID `json:"attachment_id"`
+	Params       DeleteTasksIdAttachmentsAttachmentIdParams
+}
+
+type DeleteTasksIdAttachmentsAttachmentIdResponseObject interface {
+	VisitDeleteTasksIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTasksIdAttachmentsAttachmentId204Response struct {
+}
+
+func (response DeleteTasksIdAttachmentsAttachmentId204Response) VisitDeleteTasksIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTasksIdAttachmentsAttachmentId404JSONResponse Error
+
+func (response DeleteTasksIdAttachmentsAttachmentId404JSONResponse) VisitDeleteTasksIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdAttachmentsAttachmentId500JSONResponse Error
+
+func (response DeleteTasksIdAttachmentsAttachmentId500JSONResponse) VisitDeleteTasksIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksIdAttachmentsAttachmentIdRequestObject struct {
+	Id           openapi_types.UUID `json:"id"`
+	AttachmentId openapi_types.UUID `json:"attachment_id"`
+}
+
+type GetTasksIdAttachmentsAttachmentIdResponseObject interface {
+	VisitGetTasksIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error
+}
+
+type GetTasksIdAttachmentsAttachmentId200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetTasksIdAttachmentsAttachmentId200AsteriskResponse struct {
+	Body          io.Reader
+	Headers       GetTasksIdAttachmentsAttachmentId200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetTasksIdAttachmentsAttachmentId200AsteriskResponse) VisitGetTasksIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTasksIdAttachmentsAttachmentId404JSONResponse Error
+
+func (response GetTasksIdAttachmentsAttachmentId404JSONResponse) VisitGetTasksIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksIdAttachmentsAttachmentId500JSONResponse Error
+
+func (response GetTasksIdAttachmentsAttachmentId500JSONResponse) VisitGetTasksIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksIdCommentsRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetTasksIdCommentsResponseObject interface {
+	VisitGetTasksIdCommentsResponse(w http.ResponseWriter) error
+}
+
+type GetTasksIdComments200JSONResponse []Comment
+
+func (response GetTasksIdComments200JSONResponse) VisitGetTasksIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksIdComments500JSONResponse Error
+
+func (response GetTasksIdComments500JSONResponse) VisitGetTasksIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdCommentsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostTasksIdCommentsParams
+	Body   *PostTasksIdCommentsJSONRequestBody
+}
+
+type PostTasksIdCommentsResponseObject interface {
+	VisitPostTasksIdCommentsResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdComments201JSONResponse Comment
+
+func (response PostTasksIdComments201JSONResponse) VisitPostTasksIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdComments400JSONResponse Error
+
+func (response PostTasksIdComments400JSONResponse) VisitPostTasksIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdComments500JSONResponse Error
+
+func (response PostTasksIdComments500JSONResponse) VisitPostTasksIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdCommentsCommentIdRequestObject struct {
+	Id        openapi_types.UUID `json:"id"`
+	CommentId openapi_types.UUID `json:"comment_id"`
+	Params    DeleteTasksIdCommentsCommentIdParams
+}
+
+type DeleteTasksIdCommentsCommentIdResponseObject interface {
+	VisitDeleteTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTasksIdCommentsCommentId204Response struct {
+}
+
+func (response DeleteTasksIdCommentsCommentId204Response) VisitDeleteTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTasksIdCommentsCommentId403JSONResponse Error
+
+func (response DeleteTasksIdCommentsCommentId403JSONResponse) VisitDeleteTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdCommentsCommentId404JSONResponse Error
+
+func (response DeleteTasksIdCommentsCommentId404JSONResponse) VisitDeleteTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdCommentsCommentId500JSONResponse Error
+
+func (response DeleteTasksIdCommentsCommentId500JSONResponse) VisitDeleteTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksIdCommentsCommentIdRequestObject struct {
+	Id        openapi_types.UUID `json:"id"`
+	CommentId openapi_types.UUID `json:"comment_id"`
+	Params    PutTasksIdCommentsCommentIdParams
+	Body      *PutTasksIdCommentsCommentIdJSONRequestBody
+}
+
+type PutTasksIdCommentsCommentIdResponseObject interface {
+	VisitPutTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error
+}
+
+type PutTasksIdCommentsCommentId200JSONResponse Comment
+
+func (response PutTasksIdCommentsCommentId200JSONResponse) VisitPutTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksIdCommentsCommentId400JSONResponse Error
+
+func (response PutTasksIdCommentsCommentId400JSONResponse) VisitPutTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksIdCommentsCommentId403JSONResponse Error
+
+func (response PutTasksIdCommentsCommentId403JSONResponse) VisitPutTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksIdCommentsCommentId404JSONResponse Error
+
+func (response PutTasksIdCommentsCommentId404JSONResponse) VisitPutTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksIdCommentsCommentId500JSONResponse Error
+
+func (response PutTasksIdCommentsCommentId500JSONResponse) VisitPutTasksIdCommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdDependenciesRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostTasksIdDependenciesParams
+	Body   *PostTasksIdDependenciesJSONRequestBody
+}
+
+type PostTasksIdDependenciesResponseObject interface {
+	VisitPostTasksIdDependenciesResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdDependencies200JSONResponse TaskDependencies
+
+func (response PostTasksIdDependencies200JSONResponse) VisitPostTasksIdDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdDependencies400JSONResponse Error
+
+func (response PostTasksIdDependencies400JSONResponse) VisitPostTasksIdDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdDependencies409JSONResponse Error
+
+func (response PostTasksIdDependencies409JSONResponse) VisitPostTasksIdDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdDependencies500JSONResponse Error
+
+func (response PostTasksIdDependencies500JSONResponse) VisitPostTasksIdDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdDependenciesBlockedByIdRequestObject struct {
+	Id          openapi_types.UUID `json:"id"`
+	BlockedById openapi_types.UUID `json:"blocked_by_id"`
+	Params      DeleteTasksIdDependenciesBlockedByIdParams
+}
+
+type DeleteTasksIdDependenciesBlockedByIdResponseObject interface {
+	VisitDeleteTasksIdDependenciesBlockedByIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTasksIdDependenciesBlockedById200JSONResponse TaskDependencies
+
+func (response DeleteTasksIdDependenciesBlockedById200JSONResponse) VisitDeleteTasksIdDependenciesBlockedByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdDependenciesBlockedById500JSONResponse Error
+
+func (response DeleteTasksIdDependenciesBlockedById500JSONResponse) VisitDeleteTasksIdDependenciesBlockedByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksIdHistoryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetTasksIdHistoryResponseObject interface {
+	VisitGetTasksIdHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetTasksIdHistory200JSONResponse []HistoryEntry
+
+func (response GetTasksIdHistory200JSONResponse) VisitGetTasksIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksIdHistory500JSONResponse Error
+
+func (response GetTasksIdHistory500JSONResponse) VisitGetTasksIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdLabelsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostTasksIdLabelsParams
+	Body   *PostTasksIdLabelsJSONRequestBody
+}
+
+type PostTasksIdLabelsResponseObject interface {
+	VisitPostTasksIdLabelsResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdLabels200JSONResponse TaskLabels
+
+func (response PostTasksIdLabels200JSONResponse) VisitPostTasksIdLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdLabels400JSONResponse Error
+
+func (response PostTasksIdLabels400JSONResponse) VisitPostTasksIdLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdLabels404JSONResponse Error
+
+func (response PostTasksIdLabels404JSONResponse) VisitPostTasksIdLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdLabels500JSONResponse Error
+
+func (response PostTasksIdLabels500JSONResponse) VisitPostTasksIdLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdLabelsLabelIdRequestObject struct {
+	Id      openapi_types.UUID `json:"id"`
+	LabelId openapi_types.UUID `json:"label_id"`
+	Params  DeleteTasksIdLabelsLabelIdParams
+}
+
+type DeleteTasksIdLabelsLabelIdResponseObject interface {
+	VisitDeleteTasksIdLabelsLabelIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTasksIdLabelsLabelId200JSONResponse TaskLabels
+
+func (response DeleteTasksIdLabelsLabelId200JSONResponse) VisitDeleteTasksIdLabelsLabelIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdLabelsLabelId500JSONResponse Error
+
+func (response DeleteTasksIdLabelsLabelId500JSONResponse) VisitDeleteTasksIdLabelsLabelIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdReorderRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostTasksIdReorderParams
+	Body   *PostTasksIdReorderJSONRequestBody
+}
+
+type PostTasksIdReorderResponseObject interface {
+	VisitPostTasksIdReorderResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdReorder200JSONResponse ReorderedTasks
+
+func (response PostTasksIdReorder200JSONResponse) VisitPostTasksIdReorderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdReorder400JSONResponse Error
+
+func (response PostTasksIdReorder400JSONResponse) VisitPostTasksIdReorderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdReorder409JSONResponse Error
+
+func (response PostTasksIdReorder409JSONResponse) VisitPostTasksIdReorderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdReorder500JSONResponse Error
+
+func (response PostTasksIdReorder500JSONResponse) VisitPostTasksIdReorderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdRestoreRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostTasksIdRestoreParams
+}
+
+type PostTasksIdRestoreResponseObject interface {
+	VisitPostTasksIdRestoreResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdRestore200JSONResponse []Todo
+
+func (response PostTasksIdRestore200JSONResponse) VisitPostTasksIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdRestore409JSONResponse Error
+
+func (response PostTasksIdRestore409JSONResponse) VisitPostTasksIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdRestore500JSONResponse Error
+
+func (response PostTasksIdRestore500JSONResponse) VisitPostTasksIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksIdTimeEntriesRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetTasksIdTimeEntriesResponseObject interface {
+	VisitGetTasksIdTimeEntriesResponse(w http.ResponseWriter) error
+}
+
+type GetTasksIdTimeEntries200JSONResponse []TimeEntry
+
+func (response GetTasksIdTimeEntries200JSONResponse) VisitGetTasksIdTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksIdTimeEntries500JSONResponse Error
+
+func (response GetTasksIdTimeEntries500JSONResponse) VisitGetTasksIdTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdTimeEntriesRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostTasksIdTimeEntriesParams
+	Body   *PostTasksIdTimeEntriesJSONRequestBody
+}
+
+type PostTasksIdTimeEntriesResponseObject interface {
+	VisitPostTasksIdTimeEntriesResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdTimeEntries201JSONResponse TimeEntry
+
+func (response PostTasksIdTimeEntries201JSONResponse) VisitPostTasksIdTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdTimeEntries400JSONResponse Error
+
+func (response PostTasksIdTimeEntries400JSONResponse) VisitPostTasksIdTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdTimeEntries500JSONResponse Error
+
+func (response PostTasksIdTimeEntries500JSONResponse) VisitPostTasksIdTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdTimeEntriesEntryIdRequestObject struct {
+	Id      openapi_types.UUID `json:"id"`
+	EntryId openapi_types.UUID `json:"entry_id"`
+	Params  DeleteTasksIdTimeEntriesEntryIdParams
+}
+
+type DeleteTasksIdTimeEntriesEntryIdResponseObject interface {
+	VisitDeleteTasksIdTimeEntriesEntryIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTasksIdTimeEntriesEntryId204Response struct {
+}
+
+func (response DeleteTasksIdTimeEntriesEntryId204Response) VisitDeleteTasksIdTimeEntriesEntryIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTasksIdTimeEntriesEntryId403JSONResponse Error
+
+func (response DeleteTasksIdTimeEntriesEntryId403JSONResponse) VisitDeleteTasksIdTimeEntriesEntryIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdTimeEntriesEntryId500JSONResponse Error
+
+func (response DeleteTasksIdTimeEntriesEntryId500JSONResponse) VisitDeleteTasksIdTimeEntriesEntryIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdUnarchiveRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostTasksIdUnarchiveParams
+}
+
+type PostTasksIdUnarchiveResponseObject interface {
+	VisitPostTasksIdUnarchiveResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdUnarchive200JSONResponse []Todo
+
+func (response PostTasksIdUnarchive200JSONResponse) VisitPostTasksIdUnarchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdUnarchive409JSONResponse Error
+
+func (response PostTasksIdUnarchive409JSONResponse) VisitPostTasksIdUnarchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdUnarchive500JSONResponse Error
+
+func (response PostTasksIdUnarchive500JSONResponse) VisitPostTasksIdUnarchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrashRequestObject struct {
+}
+
+type GetTrashResponseObject interface {
+	VisitGetTrashResponse(w http.ResponseWriter) error
+}
+
+type GetTrash200JSONResponse []Todo
+
+func (response GetTrash200JSONResponse) VisitGetTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrash500JSONResponse Error
+
+func (response GetTrash500JSONResponse) VisitGetTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUndoRequestObject struct {
+	Params PostUndoParams
+}
+
+type PostUndoResponseObject interface {
+	VisitPostUndoResponse(w http.ResponseWriter) error
+}
+
+type PostUndo200JSONResponse UndoChanges
+
+func (response PostUndo200JSONResponse) VisitPostUndoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUndo409JSONResponse Error
+
+func (response PostUndo409JSONResponse) VisitPostUndoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUndo500JSONResponse Error
+
+func (response PostUndo500JSONResponse) VisitPostUndoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserIdRequestObject struct {
+	Id uint `json:"id"`
+}
+
+type GetUserIdResponseObject interface {
+	VisitGetUserIdResponse(w http.ResponseWriter) error
+}
+
+type GetUserId200JSONResponse LoginResponse
+
+func (response GetUserId200JSONResponse) VisitGetUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserId400JSONResponse Error
+
+func (response GetUserId400JSONResponse) VisitGetUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserId401JSONResponse Error
+
+func (response GetUserId401JSONResponse) VisitGetUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserId500JSONResponse Error
+
+func (response GetUserId500JSONResponse) VisitGetUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksRequestObject struct {
+}
+
+type GetWebhooksResponseObject interface {
+	VisitGetWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooks200JSONResponse []Webhook
+
+func (response GetWebhooks200JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks500JSONResponse Error
+
+func (response GetWebhooks500JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksRequestObject struct {
+	Params PostWebhooksParams
+	Body   *PostWebhooksJSONRequestBody
+}
+
+type PostWebhooksResponseObject interface {
+	VisitPostWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooks201JSONResponse Webhook
+
+func (response PostWebhooks201JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks400JSONResponse Error
+
+func (response PostWebhooks400JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks500JSONResponse Error
+
+func (response PostWebhooks500JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhooksIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteWebhooksIdResponseObject interface {
+	VisitDeleteWebhooksIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhooksId204Response struct {
+}
+
+func (response DeleteWebhooksId204Response) VisitDeleteWebhooksIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhooksId404JSONResponse Error
+
+func (response DeleteWebhooksId404JSONResponse) VisitDeleteWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhooksId500JSONResponse Error
+
+func (response DeleteWebhooksId500JSONResponse) VisitDeleteWebhooksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksIdDeliveriesRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetWebhooksIdDeliveriesResponseObject interface {
+	VisitGetWebhooksIdDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksIdDeliveries200JSONResponse []WebhookDelivery
+
+func (response GetWebhooksIdDeliveries200JSONResponse) VisitGetWebhooksIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksIdDeliveries404JSONResponse Error
+
+func (response GetWebhooksIdDeliveries404JSONResponse) VisitGetWebhooksIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksIdDeliveries500JSONResponse Error
+
+func (response GetWebhooksIdDeliveries500JSONResponse) VisitGetWebhooksIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksIdDeliveriesDeliveryIdRedeliverRequestObject struct {
+	Id         openapi_types.UUID `json:"id"`
+	DeliveryId openapi_types.UUID `json:"delivery_id"`
+}
+
+type PostWebhooksIdDeliveriesDeliveryIdRedeliverResponseObject interface {
+	VisitPostWebhooksIdDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksIdDeliveriesDeliveryIdRedeliver202JSONResponse WebhookDelivery
+
+func (response PostWebhooksIdDeliveriesDeliveryIdRedeliver202JSONResponse) VisitPostWebhooksIdDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksIdDeliveriesDeliveryIdRedeliver404JSONResponse Error
+
+func (response PostWebhooksIdDeliveriesDeliveryIdRedeliver404JSONResponse) VisitPostWebhooksIdDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksIdDeliveriesDeliveryIdRedeliver500JSONResponse Error
+
+func (response PostWebhooksIdDeliveriesDeliveryIdRedeliver500JSONResponse) VisitPostWebhooksIdDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get the latest changes made to all todo items, newest first
+	// (GET /activity)
+	GetActivity(ctx context.Context, request GetActivityRequestObject) (GetActivityResponseObject, error)
+	// Get the token of the user's calendar feed, creating it the first time
+	// (GET /calendar/token)
+	GetCalendarToken(ctx context.Context, request GetCalendarTokenRequestObject) (GetCalendarTokenResponseObject, error)
+	// Replace the token of the user's calendar feed, the old feed URL stops working
+	// (POST /calendar/token)
+	PostCalendarToken(ctx context.Context, request PostCalendarTokenRequestObject) (PostCalendarTokenResponseObject, error)
+	// iCalendar feed of the todo items with a due date that the user created or is assigned to, as both to-dos and events
+	// (GET /calendar/{token}.ics)
+	GetCalendarTokenIcs(ctx context.Context, request GetCalendarTokenIcsRequestObject) (GetCalendarTokenIcsResponseObject, error)
+	// Export all todo items that are not in the trash, to back them up or move them to another server
+	// (GET /export)
+	GetExport(ctx context.Context, request GetExportRequestObject) (GetExportResponseObject, error)
+	// Import todo items from an exported file, all of them or none
+	// (POST /import)
+	PostImport(ctx context.Context, request PostImportRequestObject) (PostImportResponseObject, error)
+	// Get the email address that creates todo items for the user, creating it the first time
+	// (GET /inbox/address)
+	GetInboxAddress(ctx context.Context, request GetInboxAddressRequestObject) (GetInboxAddressResponseObject, error)
+	// Replace the token in the user's email address, the old address stops working
+	// (POST /inbox/address)
+	PostInboxAddress(ctx context.Context, request PostInboxAddressRequestObject) (PostInboxAddressResponseObject, error)
+	// Get all labels
+	// (GET /labels)
+	GetLabels(ctx context.Context, request GetLabelsRequestObject) (GetLabelsResponseObject, error)
+	// Create a new label on a board
+	// (POST /labels)
+	PostLabels(ctx context.Context, request PostLabelsRequestObject) (PostLabelsResponseObject, error)
+	// Delete a label and remove it from all todo items
+	// (DELETE /labels/{id})
+	DeleteLabelsId(ctx context.Context, request DeleteLabelsIdRequestObject) (DeleteLabelsIdResponseObject, error)
+	// Update a label
+	// (PUT /labels/{id})
+	PutLabelsId(ctx context.Context, request PutLabelsIdRequestObject) (PutLabelsIdResponseObject, error)
+	// Login the user with the given username
+	// (POST /login)
+	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
+	// Redo the last undone operation of the user
+	// (POST /redo)
+	PostRedo(ctx context.Context, request PostRedoRequestObject) (PostRedoResponseObject, error)
+	// Get how many todo items were created and completed over time, with the burndown of the open cost
+	// (GET /reports/completion)
+	GetReportsCompletion(ctx context.Context, request GetReportsCompletionRequestObject) (GetReportsCompletionResponseObject, error)
+	// Get how much was spent over time, the own cost of todo items counted when they were completed
+	// (GET /reports/cost)
+	GetReportsCost(ctx context.Context, request GetReportsCostRequestObject) (GetReportsCostResponseObject, error)
+	// Get all todo items
+	// (GET /tasks)
+	GetTasks(ctx context.Context, request GetTasksRequestObject) (GetTasksResponseObject, error)
+	// Create a new todo item
+	// (POST /tasks)
+	PostTasks(ctx context.Context, request PostTasksRequestObject) (PostTasksResponseObject, error)
+	// Get all todo items ordered so every item comes after the items blocking it
+	// (GET /tasks/ordered)
+	GetTasksOrdered(ctx context.Context, request GetTasksOrderedRequestObject) (GetTasksOrderedResponseObject, error)
+	// Move the todo item and all of its subtasks to the trash
+	// (DELETE /tasks/{id})
+	DeleteTasksId(ctx context.Context, request DeleteTasksIdRequestObject) (DeleteTasksIdResponseObject, error)
+	// Update the todo item
+	// (PUT /tasks/{id})
+	PutTasksId(ctx context.Context, request PutTasksIdRequestObject) (PutTasksIdResponseObject, error)
+	// Archive the todo item and all of its subtasks
+	// (POST /tasks/{id}/archive)
+	PostTasksIdArchive(ctx context.Context, request PostTasksIdArchiveRequestObject) (PostTasksIdArchiveResponseObject, error)
+	// Assign a user to the todo item
+	// (POST /tasks/{id}/assignees)
+	PostTasksIdAssignees(ctx context.Context, request PostTasksIdAssigneesRequestObject) (PostTasksIdAssigneesResponseObject, error)
+	// Unassign a user from the todo item
+	// (DELETE /tasks/{id}/assignees/{user_id})
+	DeleteTasksIdAssigneesUserId(ctx context.Context, request DeleteTasksIdAssigneesUserIdRequestObject) (DeleteTasksIdAssigneesUserIdResponseObject, error)
+	// Upload a file and attach it to the todo item
+	// (POST /tasks/{id}/attachments)
+	PostTasksIdAttachments(ctx context.Context, request PostTasksIdAttachmentsRequestObject) (PostTasksIdAttachmentsResponseObject, error)
+	// Delete the attachment
+	// (DELETE /tasks/{id}/attachments/{attachment_id})
+	DeleteTasksIdAttachmentsAttachmentId(ctx context.Context, request DeleteTasksIdAttachmentsAttachmentIdRequestObject) (DeleteTasksIdAttachmentsAttachmentIdResponseObject, error)
+	// Download the attached file
+	// (GET /tasks/{id}/attachments/{attachment_id})
+	GetTasksIdAttachmentsAttachmentId(ctx context.Context, request GetTasksIdAttachmentsAttachmentIdRequestObject) (GetTasksIdAttachmentsAttachmentIdResponseObject, error)
+	// Get the comments of the todo item
+	// (GET /tasks/{id}/comments)
+	GetTasksIdComments(ctx context.Context, request GetTasksIdCommentsRequestObject) (GetTasksIdCommentsResponseObject, error)
+	// Add a comment to the todo item
+	// (POST /tasks/{id}/comments)
+	PostTasksIdComments(ctx context.Context, request PostTasksIdCommentsRequestObject) (PostTasksIdCommentsResponseObject, error)
+	// Delete a comment, only the author can do this
+	// (DELETE /tasks/{id}/comments/{comment_id})
+	DeleteTasksIdCommentsCommentId(ctx context.Context, request DeleteTasksIdCommentsCommentIdRequestObject) (DeleteTasksIdCommentsCommentIdResponseObject, error)
+	// Edit a comment, only the author can do this
+	// (PUT /tasks/{id}/comments/{comment_id})
+	PutTasksIdCommentsCommentId(ctx context.Context, request PutTasksIdCommentsCommentIdRequestObject) (PutTasksIdCommentsCommentIdResponseObject, error)
+	// Make another todo item block the todo item
+	// (POST /tasks/{id}/dependencies)
+	PostTasksIdDependencies(ctx context.Context, request PostTasksIdDependenciesRequestObject) (PostTasksIdDependenciesResponseObject, error)
+	// Stop another todo item from blocking the todo item
+	// (DELETE /tasks/{id}/dependencies/{blocked_by_id})
+	DeleteTasksIdDependenciesBlockedById(ctx context.Context, request DeleteTasksIdDependenciesBlockedByIdRequestObject) (DeleteTasksIdDependenciesBlockedByIdResponseObject, error)
+	// Get every change made to the todo item, oldest first
+	// (GET /tasks/{id}/history)
+	GetTasksIdHistory(ctx context.Context, request GetTasksIdHistoryRequestObject) (GetTasksIdHistoryResponseObject, error)
+	// Add a label to the todo item
+	// (POST /tasks/{id}/labels)
+	PostTasksIdLabels(ctx context.Context, request PostTasksIdLabelsRequestObject) (PostTasksIdLabelsResponseObject, error)
+	// Remove a label from the todo item
+	// (DELETE /tasks/{id}/labels/{label_id})
+	DeleteTasksIdLabelsLabelId(ctx context.Context, request DeleteTasksIdLabelsLabelIdRequestObject) (DeleteTasksIdLabelsLabelIdResponseObject, error)
+	// Move the todo item among its siblings
+	// (POST /tasks/{id}/reorder)
+	PostTasksIdReorder(ctx context.Context, request PostTasksIdReorderRequestObject) (PostTasksIdReorderResponseObject, error)
+	// Take the todo item and the subtasks deleted with it out of the trash
+	// (POST /tasks/{id}/restore)
+	PostTasksIdRestore(ctx context.Context, request PostTasksIdRestoreRequestObject) (PostTasksIdRestoreResponseObject, error)
+	// Get the time logged on the todo item, running timers included
+	// (GET /tasks/{id}/time-entries)
+	GetTasksIdTimeEntries(ctx context.Context, request GetTasksIdTimeEntriesRequestObject) (GetTasksIdTimeEntriesResponseObject, error)
+	// Log time on the todo item without a timer
+	// (POST /tasks/{id}/time-entries)
+	PostTasksIdTimeEntries(ctx context.Context, request PostTasksIdTimeEntriesRequestObject) (PostTasksIdTimeEntriesResponseObject, error)
+	// Delete a time entry, only the user that logged it can do this
+	// (DELETE /tasks/{id}/time-entries/{entry_id})
+	DeleteTasksIdTimeEntriesEntryId(ctx context.Context, request DeleteTasksIdTimeEntriesEntryIdRequestObject) (DeleteTasksIdTimeEntriesEntryIdResponseObject, error)
+	// Unarchive the todo item and the subtasks archived with it
+	// (POST /tasks/{id}/unarchive)
+	PostTasksIdUnarchive(ctx context.Context, request PostTasksIdUnarchiveRequestObject) (PostTasksIdUnarchiveResponseObject, error)
+	// Get the todo items in the trash, the most recently deleted first
+	// (GET /trash)
+	GetTrash(ctx context.Context, request GetTrashRequestObject) (GetTrashResponseObject, error)
+	// Undo the last operation of the user
+	// (POST /undo)
+	PostUndo(ctx context.Context, request PostUndoRequestObject) (PostUndoResponseObject, error)
+	// Get user by id
+	// (GET /user/{id})
+	GetUserId(ctx context.Context, request GetUserIdRequestObject) (GetUserIdResponseObject, error)
+	// Get all webhooks, without their secrets
+	// (GET /webhooks)
+	GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error)
+	// Subscribe a URL to the task events, which are posted to it signed with the secret of the webhook
+	// (POST /webhooks)
+	PostWebhooks(ctx context.Context, request PostWebhooksRequestObject) (PostWebhooksResponseObject, error)
+	// Delete a webhook with its deliveries, pending ones are not sent
+	// (DELETE /webhooks/{id})
+	DeleteWebhooksId(ctx context.Context, request DeleteWebhooksIdRequestObject) (DeleteWebhooksIdResponseObject, error)
+	// Get the deliveries of a webhook, with the result of their last attempt
+	// (GET /webhooks/{id}/deliveries)
+	GetWebhooksIdDeliveries(ctx context.Context, request GetWebhooksIdDeliveriesRequestObject) (GetWebhooksIdDeliveriesResponseObject, error)
+	// Send the payload of a delivery again, as a new delivery
+	// (POST /webhooks/{id}/deliveries/{delivery_id}/redeliver)
+	PostWebhooksIdDeliveriesDeliveryIdRedeliver(ctx context.Context, request PostWebhooksIdDeliveriesDeliveryIdRedeliverRequestObject) (PostWebhooksIdDeliveriesDeliveryIdRedeliverResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetActivity operation middleware
+func (sh *strictHandler) GetActivity(w http.ResponseWriter, r *http.Request, params GetActivityParams) {
+	var request GetActivityRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetActivity(ctx, request.(GetActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetActivity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetActivityResponseObject); ok {
+		if err := validResponse.VisitGetActivityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCalendarToken operation middleware
+func (sh *strictHandler) GetCalendarToken(w http.ResponseWriter, r *http.Request, params GetCalendarTokenParams) {
+	var request GetCalendarTokenRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalendarToken(ctx, request.(GetCalendarTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalendarToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCalendarTokenResponseObject); ok {
+		if err := validResponse.VisitGetCalendarTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCalendarToken operation middleware
+func (sh *strictHandler) PostCalendarToken(w http.ResponseWriter, r *http.Request, params PostCalendarTokenParams) {
+	var request PostCalendarTokenRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCalendarToken(ctx, request.(PostCalendarTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCalendarToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostCalendarTokenResponseObject); ok {
+		if err := validResponse.VisitPostCalendarTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCalendarTokenIcs operation middleware
+func (sh *strictHandler) GetCalendarTokenIcs(w http.ResponseWriter, r *http.Request, token string) {
+	var request GetCalendarTokenIcsRequestObject
+
+	request.Token = token
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalendarTokenIcs(ctx, request.(GetCalendarTokenIcsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalendarTokenIcs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCalendarTokenIcsResponseObject); ok {
+		if err := validResponse.VisitGetCalendarTokenIcsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExport operation middleware
+func (sh *strictHandler) GetExport(w http.ResponseWriter, r *http.Request, params GetExportParams) {
+	var request GetExportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExport(ctx, request.(GetExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExportResponseObject); ok {
+		if err := validResponse.VisitGetExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostImport operation middleware
+func (sh *strictHandler) PostImport(w http.ResponseWriter, r *http.Request, params PostImportParams) {
+	var request PostImportRequestObject
+
+	request.Params = params
+	request.ContentType = r.Header.Get("Content-Type")
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostImport(ctx, request.(PostImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostImport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostImportResponseObject); ok {
+		if err := validResponse.VisitPostImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetInboxAddress operation middleware
+func (sh *strictHandler) GetInboxAddress(w http.ResponseWriter, r *http.Request, params GetInboxAddressParams) {
+	var request GetInboxAddressRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInboxAddress(ctx, request.(GetInboxAddressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInboxAddress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetInboxAddressResponseObject); ok {
+		if err := validResponse.VisitGetInboxAddressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostInboxAddress operation middleware
+func (sh *strictHandler) PostInboxAddress(w http.ResponseWriter, r *http.Request, params PostInboxAddressParams) {
+	var request PostInboxAddressRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostInboxAddress(ctx, request.(PostInboxAddressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostInboxAddress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostInboxAddressResponseObject); ok {
+		if err := validResponse.VisitPostInboxAddressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetLabels operation middleware
+func (sh *strictHandler) GetLabels(w http.ResponseWriter, r *http.Request, params GetLabelsParams) {
+	var request GetLabelsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLabels(ctx, request.(GetLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLabels")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLabelsResponseObject); ok {
+		if err := validResponse.VisitGetLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLabels operation middleware
+func (sh *strictHandler) PostLabels(w http.ResponseWriter, r *http.Request) {
+	var request PostLabelsRequestObject
+
+	var body PostLabelsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLabels(ctx, request.(PostLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLabels")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostLabelsResponseObject); ok {
+		if err := validResponse.VisitPostLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteLabelsId operation middleware
+func (sh *strictHandler) DeleteLabelsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteLabelsIdParams) {
+	var request DeleteLabelsIdRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteLabelsId(ctx, request.(DeleteLabelsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteLabelsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteLabelsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteLabelsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutLabelsId operation middleware
+func (sh *strictHandler) PutLabelsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PutLabelsIdRequestObject
+
+	request.Id = id
+
+	var body PutLabelsIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutLabelsId(ctx, request.(PutLabelsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutLabelsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutLabelsIdResponseObject); ok {
+		if err := validResponse.VisitPutLabelsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLogin operation middleware
+func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
+	var request PostLoginRequestObject
+
+	var body PostLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
+		if err := validResponse.VisitPostLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostRedo operation middleware
+func (sh *strictHandler) PostRedo(w http.ResponseWriter, r *http.Request, params PostRedoParams) {
+	var request PostRedoRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRedo(ctx, request.(PostRedoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRedo")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostRedoResponseObject); ok {
+		if err := validResponse.VisitPostRedoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReportsCompletion operation middleware
+func (sh *strictHandler) GetReportsCompletion(w http.ResponseWriter, r *http.Request, params GetReportsCompletionParams) {
+	var request GetReportsCompletionRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReportsCompletion(ctx, request.(GetReportsCompletionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReportsCompletion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReportsCompletionResponseObject); ok {
+		if err := validResponse.VisitGetReportsCompletionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReportsCost operation middleware
+func (sh *strictHandler) GetReportsCost(w http.ResponseWriter, r *http.Request, params GetReportsCostParams) {
+	var request GetReportsCostRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReportsCost(ctx, request.(GetReportsCostRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReportsCost")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReportsCostResponseObject); ok {
+		if err := validResponse.VisitGetReportsCostResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTasks operation middleware
+func (sh *strictHandler) GetTasks(w http.ResponseWriter, r *http.Request, params GetTasksParams) {
+	var request GetTasksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasks(ctx, request.(GetTasksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTasksResponseObject); ok {
+		if err := validResponse.VisitGetTasksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTasks operation middleware
+func (sh *strictHandler) PostTasks(w http.ResponseWriter, r *http.Request) {
+	var request PostTasksRequestObject
+
+	var body PostTasksJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasks(ctx, request.(PostTasksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksResponseObject); ok {
+		if err := validResponse.VisitPostTasksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTasksOrdered operation middleware
+func (sh *strictHandler) GetTasksOrdered(w http.ResponseWriter, r *http.Request) {
+	var request GetTasksOrderedRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasksOrdered(ctx, request.(GetTasksOrderedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasksOrdered")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTasksOrderedResponseObject); ok {
+		if err := validResponse.VisitGetTasksOrderedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTasksId operation middleware
+func (sh *strictHandler) DeleteTasksId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteTasksIdParams) {
+	var request DeleteTasksIdRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTasksId(ctx, request.(DeleteTasksIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTasksId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTasksIdResponseObject); ok {
+		if err := validResponse.VisitDeleteTasksIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutTasksId operation middleware
+func (sh *strictHandler) PutTasksId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutTasksIdParams) {
+	var request PutTasksIdRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PutTasksIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutTasksId(ctx, request.(PutTasksIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutTasksId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutTasksIdResponseObject); ok {
+		if err := validResponse.VisitPutTasksIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTasksIdArchive operation middleware
+func (sh *strictHandler) PostTasksIdArchive(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdArchiveParams) {
+	var request PostTasksIdArchiveRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdArchive(ctx, request.(PostTasksIdArchiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdArchive")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksIdArchiveResponseObject); ok {
+		if err := validResponse.VisitPostTasksIdArchiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTasksIdAssignees operation middleware
+func (sh *strictHandler) PostTasksIdAssignees(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdAssigneesParams) {
+	var request PostTasksIdAssigneesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PostTasksIdAssigneesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdAssignees(ctx, request.(PostTasksIdAssigneesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdAssignees")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksIdAssigneesResponseObject); ok {
+		if err := validResponse.VisitPostTasksIdAssigneesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTasksIdAssigneesUserId operation middleware
+func (sh *strictHandler) DeleteTasksIdAssigneesUserId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, userId uint, params DeleteTasksIdAssigneesUserIdParams) {
+	var request DeleteTasksIdAssigneesUserIdRequestObject
+
+	request.Id = id
+	request.UserId = userId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTasksIdAssigneesUserId(ctx, request.(DeleteTasksIdAssigneesUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTasksIdAssigneesUserId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTasksIdAssigneesUserIdResponseObject); ok {
+		if err := validResponse.VisitDeleteTasksIdAssigneesUserIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTasksIdAttachments operation middleware
+func (sh *strictHandler) PostTasksIdAttachments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdAttachmentsParams) {
+	var request PostTasksIdAttachmentsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdAttachments(ctx, request.(PostTasksIdAttachmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdAttachments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksIdAttachmentsResponseObject); ok {
+		if err := validResponse.VisitPostTasksIdAttachmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTasksIdAttachmentsAttachmentId operation middleware
+func (sh *strictHandler) DeleteTasksIdAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, attachmentId openapi_types.UUID, params DeleteTasksIdAttachmentsAttachmentIdParams) {
+	var request DeleteTasksIdAttachmentsAttachmentIdRequestObject
+
+	request.Id = id
+	request.AttachmentId = attachmentId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTasksIdAttachmentsAttachmentId(ctx, request.(DeleteTasksIdAttachmentsAttachmentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTasksIdAttachmentsAttachmentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTasksIdAttachmentsAttachmentIdResponseObject); ok {
+		if err := validResponse.VisitDeleteTasksIdAttachmentsAttachmentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTasksIdAttachmentsAttachmentId operation middleware
+func (sh *strictHandler) GetTasksIdAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, attachmentId openapi_types.UUID) {
+	var request GetTasksIdAttachmentsAttachmentIdRequestObject
+
+	request.Id = id
+	request.AttachmentId = attachmentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasksIdAttachmentsAttachmentId(ctx, request.(GetTasksIdAttachmentsAttachmentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasksIdAttachmentsAttachmentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTasksIdAttachmentsAttachmentIdResponseObject); ok {
+		if err := validResponse.VisitGetTasksIdAttachmentsAttachmentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostLogin500JSONResponse Error
+// GetTasksIdComments operation middleware
+func (sh *strictHandler) GetTasksIdComments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetTasksIdCommentsRequestObject
 
-func (response PostLogin500JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasksIdComments(ctx, request.(GetTasksIdCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasksIdComments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTasksIdCommentsResponseObject); ok {
+		if err := validResponse.VisitGetTasksIdCommentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTasksIdComments operation middleware
+func (sh *strictHandler) PostTasksIdComments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdCommentsParams) {
+	var request PostTasksIdCommentsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PostTasksIdCommentsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdComments(ctx, request.(PostTasksIdCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdComments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksIdCommentsResponseObject); ok {
+		if err := validResponse.VisitPostTasksIdCommentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTasksIdCommentsCommentId operation middleware
+func (sh *strictHandler) DeleteTasksIdCommentsCommentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID, params DeleteTasksIdCommentsCommentIdParams) {
+	var request DeleteTasksIdCommentsCommentIdRequestObject
+
+	request.Id = id
+	request.CommentId = commentId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTasksIdCommentsCommentId(ctx, request.(DeleteTasksIdCommentsCommentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTasksIdCommentsCommentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTasksIdCommentsCommentIdResponseObject); ok {
+		if err := validResponse.VisitDeleteTasksIdCommentsCommentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutTasksIdCommentsCommentId operation middleware
+func (sh *strictHandler) PutTasksIdCommentsCommentId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID, params PutTasksIdCommentsCommentIdParams) {
+	var request PutTasksIdCommentsCommentIdRequestObject
+
+	request.Id = id
+	request.CommentId = commentId
+	request.Params = params
+
+	var body PutTasksIdCommentsCommentIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutTasksIdCommentsCommentId(ctx, request.(PutTasksIdCommentsCommentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutTasksIdCommentsCommentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutTasksIdCommentsCommentIdResponseObject); ok {
+		if err := validResponse.VisitPutTasksIdCommentsCommentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTasksIdDependencies operation middleware
+func (sh *strictHandler) PostTasksIdDependencies(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdDependenciesParams) {
+	var request PostTasksIdDependenciesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PostTasksIdDependenciesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdDependencies(ctx, request.(PostTasksIdDependenciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdDependencies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksIdDependenciesResponseObject); ok {
+		if err := validResponse.VisitPostTasksIdDependenciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTasksIdDependenciesBlockedById operation middleware
+func (sh *strictHandler) DeleteTasksIdDependenciesBlockedById(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, blockedById openapi_types.UUID, params DeleteTasksIdDependenciesBlockedByIdParams) {
+	var request DeleteTasksIdDependenciesBlockedByIdRequestObject
+
+	request.Id = id
+	request.BlockedById = blockedById
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTasksIdDependenciesBlockedById(ctx, request.(DeleteTasksIdDependenciesBlockedByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTasksIdDependenciesBlockedById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTasksIdDependenciesBlockedByIdResponseObject); ok {
+		if err := validResponse.VisitDeleteTasksIdDependenciesBlockedByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTasksIdHistory operation middleware
+func (sh *strictHandler) GetTasksIdHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetTasksIdHistoryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasksIdHistory(ctx, request.(GetTasksIdHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasksIdHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTasksIdHistoryResponseObject); ok {
+		if err := validResponse.VisitGetTasksIdHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTasksIdLabels operation middleware
+func (sh *strictHandler) PostTasksIdLabels(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdLabelsParams) {
+	var request PostTasksIdLabelsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PostTasksIdLabelsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdLabels(ctx, request.(PostTasksIdLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdLabels")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksIdLabelsResponseObject); ok {
+		if err := validResponse.VisitPostTasksIdLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTasksIdLabelsLabelId operation middleware
+func (sh *strictHandler) DeleteTasksIdLabelsLabelId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, labelId openapi_types.UUID, params DeleteTasksIdLabelsLabelIdParams) {
+	var request DeleteTasksIdLabelsLabelIdRequestObject
+
+	request.Id = id
+	request.LabelId = labelId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTasksIdLabelsLabelId(ctx, request.(DeleteTasksIdLabelsLabelIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTasksIdLabelsLabelId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTasksIdLabelsLabelIdResponseObject); ok {
+		if err := validResponse.VisitDeleteTasksIdLabelsLabelIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTasksIdReorder operation middleware
+func (sh *strictHandler) PostTasksIdReorder(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdReorderParams) {
+	var request PostTasksIdReorderRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PostTasksIdReorderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdReorder(ctx, request.(PostTasksIdReorderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdReorder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksIdReorderResponseObject); ok {
+		if err := validResponse.VisitPostTasksIdReorderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTasksIdRestore operation middleware
+func (sh *strictHandler) PostTasksIdRestore(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdRestoreParams) {
+	var request PostTasksIdRestoreRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdRestore(ctx, request.(PostTasksIdRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdRestore")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksIdRestoreResponseObject); ok {
+		if err := validResponse.VisitPostTasksIdRestoreResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTasksRequestObject struct {
-}
+// GetTasksIdTimeEntries operation middleware
+func (sh *strictHandler) GetTasksIdTimeEntries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetTasksIdTimeEntriesRequestObject
 
-type GetTasksResponseObject interface {
-	VisitGetTasksResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type GetTasks200JSONResponse []Todo
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasksIdTimeEntries(ctx, request.(GetTasksIdTimeEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasksIdTimeEntries")
+	}
 
-func (response GetTasks200JSONResponse) VisitGetTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTasksIdTimeEntriesResponseObject); ok {
+		if err := validResponse.VisitGetTasksIdTimeEntriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTasks500JSONResponse Error
+// PostTasksIdTimeEntries operation middleware
+func (sh *strictHandler) PostTasksIdTimeEntries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdTimeEntriesParams) {
+	var request PostTasksIdTimeEntriesRequestObject
 
-func (response GetTasks500JSONResponse) VisitGetTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostTasksIdTimeEntriesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostTasksRequestObject struct {
-	Body *PostTasksJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdTimeEntries(ctx, request.(PostTasksIdTimeEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdTimeEntries")
+	}
 
-type PostTasksResponseObject interface {
-	VisitPostTasksResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostTasks201Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksIdTimeEntriesResponseObject); ok {
+		if err := validResponse.VisitPostTasksIdTimeEntriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostTasks201Response) VisitPostTasksResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+// DeleteTasksIdTimeEntriesEntryId operation middleware
+func (sh *strictHandler) DeleteTasksIdTimeEntriesEntryId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, entryId openapi_types.UUID, params DeleteTasksIdTimeEntriesEntryIdParams) {
+	var request DeleteTasksIdTimeEntriesEntryIdRequestObject
 
-type PostTasks400JSONResponse Error
+	request.Id = id
+	request.EntryId = entryId
+	request.Params = params
 
-func (response PostTasks400JSONResponse) VisitPostTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTasksIdTimeEntriesEntryId(ctx, request.(DeleteTasksIdTimeEntriesEntryIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTasksIdTimeEntriesEntryId")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTasksIdTimeEntriesEntryIdResponseObject); ok {
+		if err := validResponse.VisitDeleteTasksIdTimeEntriesEntryIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostTasks500JSONResponse Error
+// PostTasksIdUnarchive operation middleware
+func (sh *strictHandler) PostTasksIdUnarchive(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostTasksIdUnarchiveParams) {
+	var request PostTasksIdUnarchiveRequestObject
 
-func (response PostTasks500JSONResponse) VisitPostTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdUnarchive(ctx, request.(PostTasksIdUnarchiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdUnarchive")
+	}
 
-type GetUserIdRequestObject struct {
-	Id uint `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUserIdResponseObject interface {
-	VisitGetUserIdResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTasksIdUnarchiveResponseObject); ok {
+		if err := validResponse.VisitPostTasksIdUnarchiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserId200JSONResponse LoginResponse
+// GetTrash operation middleware
+func (sh *strictHandler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	var request GetTrashRequestObject
 
-func (response GetUserId200JSONResponse) VisitGetUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTrash(ctx, request.(GetTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTrash")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUserId400JSONResponse Error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTrashResponseObject); ok {
+		if err := validResponse.VisitGetTrashResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetUserId400JSONResponse) VisitGetUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// PostUndo operation middleware
+func (sh *strictHandler) PostUndo(w http.ResponseWriter, r *http.Request, params PostUndoParams) {
+	var request PostUndoRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type GetUserId401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUndo(ctx, request.(PostUndoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUndo")
+	}
 
-func (response GetUserId401JSONResponse) VisitGetUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUndoResponseObject); ok {
+		if err := validResponse.VisitPostUndoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserId500JSONResponse Error
+// GetUserId operation middleware
+func (sh *strictHandler) GetUserId(w http.ResponseWriter, r *http.Request, id uint) {
+	var request GetUserIdRequestObject
 
-func (response GetUserId500JSONResponse) VisitGetUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserId(ctx, request.(GetUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserId")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Login the user with the given username
-	// (POST /login)
-	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
-	// Get all todo items
-	// (GET /tasks)
-	GetTasks(ctx context.Context, request GetTasksRequestObject) (GetTasksResponseObject, error)
-	// Create a new todo item
-	// (POST /tasks)
-	PostTasks(ctx context.Context, request PostTasksRequestObject) (PostTasksResponseObject, error)
-	// Get user by id
-	// (GET /user/{id})
-	GetUserId(ctx context.Context, request GetUserIdRequestObject) (GetUserIdResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserIdResponseObject); ok {
+		if err := validResponse.VisitGetUserIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetWebhooks operation middleware
+func (sh *strictHandler) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request GetWebhooksRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhooks(ctx, request.(GetWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhooks")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebhooksResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// PostWebhooks operation middleware
+func (sh *strictHandler) PostWebhooks(w http.ResponseWriter, r *http.Request, params PostWebhooksParams) {
+	var request PostWebhooksRequestObject
 
-// PostLogin operation middleware
-func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
-	var request PostLoginRequestObject
+	request.Params = params
 
-	var body PostLoginJSONRequestBody
+	var body PostWebhooksJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -516,18 +7072,18 @@ func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+		return sh.ssi.PostWebhooks(ctx, request.(PostWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogin")
+		handler = middleware(handler, "PostWebhooks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
-		if err := validResponse.VisitPostLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -535,23 +7091,25 @@ func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTasks operation middleware
-func (sh *strictHandler) GetTasks(w http.ResponseWriter, r *http.Request) {
-	var request GetTasksRequestObject
+// DeleteWebhooksId operation middleware
+func (sh *strictHandler) DeleteWebhooksId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteWebhooksIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTasks(ctx, request.(GetTasksRequestObject))
+		return sh.ssi.DeleteWebhooksId(ctx, request.(DeleteWebhooksIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTasks")
+		handler = middleware(handler, "DeleteWebhooksId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTasksResponseObject); ok {
-		if err := validResponse.VisitGetTasksResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhooksIdResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhooksIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -559,30 +7117,25 @@ func (sh *strictHandler) GetTasks(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostTasks operation middleware
-func (sh *strictHandler) PostTasks(w http.ResponseWriter, r *http.Request) {
-	var request PostTasksRequestObject
+// GetWebhooksIdDeliveries operation middleware
+func (sh *strictHandler) GetWebhooksIdDeliveries(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetWebhooksIdDeliveriesRequestObject
 
-	var body PostTasksJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTasks(ctx, request.(PostTasksRequestObject))
+		return sh.ssi.GetWebhooksIdDeliveries(ctx, request.(GetWebhooksIdDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTasks")
+		handler = middleware(handler, "GetWebhooksIdDeliveries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTasksResponseObject); ok {
-		if err := validResponse.VisitPostTasksResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksIdDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksIdDeliveriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -590,25 +7143,26 @@ func (sh *strictHandler) PostTasks(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetUserId operation middleware
-func (sh *strictHandler) GetUserId(w http.ResponseWriter, r *http.Request, id uint) {
-	var request GetUserIdRequestObject
+// PostWebhooksIdDeliveriesDeliveryIdRedeliver operation middleware
+func (sh *strictHandler) PostWebhooksIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, deliveryId openapi_types.UUID) {
+	var request PostWebhooksIdDeliveriesDeliveryIdRedeliverRequestObject
 
 	request.Id = id
+	request.DeliveryId = deliveryId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUserId(ctx, request.(GetUserIdRequestObject))
+		return sh.ssi.PostWebhooksIdDeliveriesDeliveryIdRedeliver(ctx, request.(PostWebhooksIdDeliveriesDeliveryIdRedeliverRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUserId")
+		handler = middleware(handler, "PostWebhooksIdDeliveriesDeliveryIdRedeliver")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUserIdResponseObject); ok {
-		if err := validResponse.VisitGetUserIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksIdDeliveriesDeliveryIdRedeliverResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksIdDeliveriesDeliveryIdRedeliverResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -0,0 +1,44 @@
+package router
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) PostTasksIdArchive(
+	ctx context.Context, request oapi.PostTasksIdArchiveRequestObject,
+) (oapi.PostTasksIdArchiveResponseObject, error) {
+	archived, err := r.tasksArchive.Run(request.Id, lo.FromPtr(request.Params.XUserId))
+	if errors.Is(err, tasks.ErrArchived) || errors.Is(err, tasks.ErrTrashed) {
+		return oapi.PostTasksIdArchive409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostTasksIdArchive500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.websocketServer.BroadcastTaskArchived(archived)
+
+	return oapi.PostTasksIdArchive200JSONResponse{Ids: archived.IDs}, nil
+}
+
+func (r *Router) PostTasksIdUnarchive(
+	ctx context.Context, request oapi.PostTasksIdUnarchiveRequestObject,
+) (oapi.PostTasksIdUnarchiveResponseObject, error) {
+	unarchived, err := r.tasksUnarchive.Run(request.Id, lo.FromPtr(request.Params.XUserId))
+	if errors.Is(err, tasks.ErrNotArchived) || errors.Is(err, tasks.ErrParentArchived) || errors.Is(err, tasks.ErrTrashed) {
+		return oapi.PostTasksIdUnarchive409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostTasksIdUnarchive500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.websocketServer.BroadcastTaskUnarchived(unarchived)
+
+	return oapi.PostTasksIdUnarchive200JSONResponse(lo.Map(unarchived, func(t tasks.Task, _ int) oapi.Todo {
+		return mapTaskToAPI(t)
+	})), nil
+}
@@ -0,0 +1,38 @@
+package router
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) PostTasksIdAssignees(
+	ctx context.Context, request oapi.PostTasksIdAssigneesRequestObject,
+) (oapi.PostTasksIdAssigneesResponseObject, error) {
+	task, assigned, err := r.tasksAssign.Run(request.Id, request.Body.UserId, request.Params.XUserId)
+	if err != nil {
+		return oapi.PostTasksIdAssignees500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	if assigned {
+		r.websocketServer.NotifyTaskAssigned(task, request.Body.UserId, request.Params.XUserId)
+	}
+
+	return oapi.PostTasksIdAssignees200JSONResponse(mapTaskToAPI(task)), nil
+}
+
+func (r *Router) DeleteTasksIdAssigneesUserId(
+	ctx context.Context, request oapi.DeleteTasksIdAssigneesUserIdRequestObject,
+) (oapi.DeleteTasksIdAssigneesUserIdResponseObject, error) {
+	task, unassigned, err := r.tasksUnassign.Run(request.Id, request.UserId, lo.FromPtr(request.Params.XUserId))
+	if err != nil {
+		return oapi.DeleteTasksIdAssigneesUserId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	if unassigned {
+		r.websocketServer.NotifyTaskUnassigned(task, request.UserId)
+	}
+
+	return oapi.DeleteTasksIdAssigneesUserId200JSONResponse(mapTaskToAPI(task)), nil
+}
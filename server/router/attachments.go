@@ -0,0 +1,89 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"mime"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/attachments"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) PostTasksIdAttachments(
+	ctx context.Context, request oapi.PostTasksIdAttachmentsRequestObject,
+) (oapi.PostTasksIdAttachmentsResponseObject, error) {
+	for {
+		part, err := request.Body.NextPart()
+		if err != nil {
+			return oapi.PostTasksIdAttachments400JSONResponse{Error: lo.ToPtr("missing file part")}, nil
+		}
+
+		if part.FormName() != "file" {
+			continue
+		}
+
+		attachment, err := r.attachmentsUpload.Run(ctx, request.Id, request.Params.XUserId, part.FileName(), part)
+		part.Close()
+		switch {
+		case errors.Is(err, attachments.ErrTooLarge):
+			return oapi.PostTasksIdAttachments413JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		case errors.Is(err, attachments.ErrUnsupportedType):
+			return oapi.PostTasksIdAttachments415JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		case errors.Is(err, attachments.ErrEmpty):
+			return oapi.PostTasksIdAttachments400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		case err != nil:
+			return oapi.PostTasksIdAttachments500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
+		return oapi.PostTasksIdAttachments201JSONResponse(mapAttachmentToAPI(attachment)), nil
+	}
+}
+
+func (r *Router) GetTasksIdAttachmentsAttachmentId(
+	ctx context.Context, request oapi.GetTasksIdAttachmentsAttachmentIdRequestObject,
+) (oapi.GetTasksIdAttachmentsAttachmentIdResponseObject, error) {
+	attachment, content, err := r.attachmentsDownload.Run(ctx, request.Id, request.AttachmentId)
+	if errors.Is(err, attachments.ErrNotFound) {
+		return oapi.GetTasksIdAttachmentsAttachmentId404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.GetTasksIdAttachmentsAttachmentId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetTasksIdAttachmentsAttachmentId200AsteriskResponse{
+		Body:          content,
+		ContentType:   attachment.ContentType,
+		ContentLength: attachment.Size,
+		Headers: oapi.GetTasksIdAttachmentsAttachmentId200ResponseHeaders{
+			// Always download instead of rendering it in the browser.
+			ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}),
+		},
+	}, nil
+}
+
+func (r *Router) DeleteTasksIdAttachmentsAttachmentId(
+	ctx context.Context, request oapi.DeleteTasksIdAttachmentsAttachmentIdRequestObject,
+) (oapi.DeleteTasksIdAttachmentsAttachmentIdResponseObject, error) {
+	err := r.attachmentsDelete.Run(ctx, request.Id, request.AttachmentId, lo.FromPtr(request.Params.XUserId))
+	if errors.Is(err, attachments.ErrNotFound) {
+		return oapi.DeleteTasksIdAttachmentsAttachmentId404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.DeleteTasksIdAttachmentsAttachmentId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.DeleteTasksIdAttachmentsAttachmentId204Response{}, nil
+}
+
+func mapAttachmentToAPI(attachment attachments.Attachment) oapi.Attachment {
+	return oapi.Attachment{
+		Id:          attachment.ID,
+		TaskId:      attachment.TaskID,
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		UploadedBy:  attachment.UploadedBy,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
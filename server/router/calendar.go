@@ -0,0 +1,66 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/calendar"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) GetCalendarToken(
+	ctx context.Context, request oapi.GetCalendarTokenRequestObject,
+) (oapi.GetCalendarTokenResponseObject, error) {
+	token, err := r.calendarGetToken.Run(request.Params.XUserId)
+	if err != nil {
+		if errors.Is(err, calendar.ErrUnknownUser) {
+			return oapi.GetCalendarToken400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
+		return oapi.GetCalendarToken500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetCalendarToken200JSONResponse(mapCalendarTokenToAPI(token)), nil
+}
+
+func (r *Router) PostCalendarToken(
+	ctx context.Context, request oapi.PostCalendarTokenRequestObject,
+) (oapi.PostCalendarTokenResponseObject, error) {
+	token, err := r.calendarRotateToken.Run(request.Params.XUserId)
+	if err != nil {
+		if errors.Is(err, calendar.ErrUnknownUser) {
+			return oapi.PostCalendarToken400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
+		return oapi.PostCalendarToken500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.PostCalendarToken201JSONResponse(mapCalendarTokenToAPI(token)), nil
+}
+
+func (r *Router) GetCalendarTokenIcs(
+	ctx context.Context, request oapi.GetCalendarTokenIcsRequestObject,
+) (oapi.GetCalendarTokenIcsResponseObject, error) {
+	feed, err := r.calendarFeed.Run(request.Token)
+	if err != nil {
+		if errors.Is(err, calendar.ErrUnknownToken) {
+			return oapi.GetCalendarTokenIcs404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
+		return oapi.GetCalendarTokenIcs500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	var buf bytes.Buffer
+	if err := feed.Encode(&buf, time.Now()); err != nil {
+		return oapi.GetCalendarTokenIcs500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetCalendarTokenIcs200TextcalendarResponse{Body: &buf, ContentLength: int64(buf.Len())}, nil
+}
+
+func mapCalendarTokenToAPI(token string) oapi.CalendarToken {
+	return oapi.CalendarToken{Token: token, Path: "/calendar/" + token + ".ics"}
+}
@@ -0,0 +1,91 @@
+package router
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/comments"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) GetTasksIdComments(
+	ctx context.Context, request oapi.GetTasksIdCommentsRequestObject,
+) (oapi.GetTasksIdCommentsResponseObject, error) {
+	commentList, err := r.commentsList.Run(request.Id)
+	if err != nil {
+		return oapi.GetTasksIdComments500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetTasksIdComments200JSONResponse(lo.Map(commentList, func(c comments.Comment, _ int) oapi.Comment {
+		return mapCommentToAPI(c)
+	})), nil
+}
+
+func (r *Router) PostTasksIdComments(
+	ctx context.Context, request oapi.PostTasksIdCommentsRequestObject,
+) (oapi.PostTasksIdCommentsResponseObject, error) {
+	comment, err := r.commentsAdd.Run(request.Id, request.Params.XUserId, request.Body.Body)
+	if errors.Is(err, comments.ErrInvalidComment) {
+		return oapi.PostTasksIdComments400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostTasksIdComments500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.websocketServer.BroadcastCommentAdded(comment)
+
+	return oapi.PostTasksIdComments201JSONResponse(mapCommentToAPI(comment)), nil
+}
+
+func (r *Router) PutTasksIdCommentsCommentId(
+	ctx context.Context, request oapi.PutTasksIdCommentsCommentIdRequestObject,
+) (oapi.PutTasksIdCommentsCommentIdResponseObject, error) {
+	comment, err := r.commentsEdit.Run(request.Id, request.CommentId, request.Params.XUserId, request.Body.Body)
+	if errors.Is(err, comments.ErrInvalidComment) {
+		return oapi.PutTasksIdCommentsCommentId400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if errors.Is(err, comments.ErrNotAuthor) {
+		return oapi.PutTasksIdCommentsCommentId403JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if errors.Is(err, comments.ErrNotFound) {
+		return oapi.PutTasksIdCommentsCommentId404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PutTasksIdCommentsCommentId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.websocketServer.BroadcastCommentEdited(comment)
+
+	return oapi.PutTasksIdCommentsCommentId200JSONResponse(mapCommentToAPI(comment)), nil
+}
+
+func (r *Router) DeleteTasksIdCommentsCommentId(
+	ctx context.Context, request oapi.DeleteTasksIdCommentsCommentIdRequestObject,
+) (oapi.DeleteTasksIdCommentsCommentIdResponseObject, error) {
+	err := r.commentsDelete.Run(request.Id, request.CommentId, request.Params.XUserId)
+	if errors.Is(err, comments.ErrNotAuthor) {
+		return oapi.DeleteTasksIdCommentsCommentId403JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if errors.Is(err, comments.ErrNotFound) {
+		return oapi.DeleteTasksIdCommentsCommentId404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.DeleteTasksIdCommentsCommentId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.websocketServer.BroadcastCommentDeleted(request.Id, request.CommentId)
+
+	return oapi.DeleteTasksIdCommentsCommentId204Response{}, nil
+}
+
+func mapCommentToAPI(comment comments.Comment) oapi.Comment {
+	return oapi.Comment{
+		Id:        comment.ID,
+		TaskId:    comment.TaskID,
+		AuthorId:  comment.AuthorID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
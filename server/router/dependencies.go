@@ -0,0 +1,69 @@
+package router
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/dependencies"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) PostTasksIdDependencies(
+	ctx context.Context, request oapi.PostTasksIdDependenciesRequestObject,
+) (oapi.PostTasksIdDependenciesResponseObject, error) {
+	deps, added, err := r.dependenciesAdd.Run(request.Id, request.Body.BlockedBy, lo.FromPtr(request.Params.XUserId))
+	if errors.Is(err, dependencies.ErrSelfDependency) {
+		return oapi.PostTasksIdDependencies400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if errors.Is(err, dependencies.ErrCycle) || errors.Is(err, tasks.ErrTrashed) {
+		return oapi.PostTasksIdDependencies409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostTasksIdDependencies500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	if added {
+		r.websocketServer.BroadcastTaskDependenciesChanged(deps)
+	}
+
+	return oapi.PostTasksIdDependencies200JSONResponse(mapDependenciesToAPI(deps)), nil
+}
+
+func (r *Router) DeleteTasksIdDependenciesBlockedById(
+	ctx context.Context, request oapi.DeleteTasksIdDependenciesBlockedByIdRequestObject,
+) (oapi.DeleteTasksIdDependenciesBlockedByIdResponseObject, error) {
+	deps, removed, err := r.dependenciesRemove.Run(request.Id, request.BlockedById, lo.FromPtr(request.Params.XUserId))
+	if err != nil {
+		return oapi.DeleteTasksIdDependenciesBlockedById500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	if removed {
+		r.websocketServer.BroadcastTaskDependenciesChanged(deps)
+	}
+
+	return oapi.DeleteTasksIdDependenciesBlockedById200JSONResponse(mapDependenciesToAPI(deps)), nil
+}
+
+func (r *Router) GetTasksOrdered(
+	ctx context.Context, request oapi.GetTasksOrderedRequestObject,
+) (oapi.GetTasksOrderedResponseObject, error) {
+	taskList, err := r.dependenciesListOrdered.Run(tasks.ListFilter{})
+	if err != nil {
+		return oapi.GetTasksOrdered500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetTasksOrdered200JSONResponse(lo.Map(taskList, func(t tasks.Task, _ int) oapi.Todo {
+		return mapTaskToAPI(t)
+	})), nil
+}
+
+func mapDependenciesToAPI(deps dependencies.Dependencies) oapi.TaskDependencies {
+	return oapi.TaskDependencies{
+		TaskId:    deps.TaskID,
+		BlockedBy: lo.Ternary(deps.BlockedBy == nil, []uuid.UUID{}, deps.BlockedBy),
+		Blocked:   deps.Blocked,
+	}
+}
@@ -0,0 +1,51 @@
+package router
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+)
+
+// Events streams the same events as the websocket over Server-Sent Events,
+// for the clients that make their changes over REST. EventSource can't set
+// headers, so the user comes from the query like for the websocket, and the
+// Last-Event-ID header can also be passed as lastEventId.
+func (r *Router) Events(writer http.ResponseWriter, request *http.Request) {
+	username := request.URL.Query().Get("user")
+	if username == "" {
+		http.Error(writer, "no user name provided", http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := writer.(http.Flusher)
+	if !ok {
+		http.Error(writer, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	lastEventID := request.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = request.URL.Query().Get("lastEventId")
+	}
+
+	stream, err := r.websocketServer.Subscribe(username, lastEventID)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(writer, "user not found", http.StatusNotFound)
+			return
+		}
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "text/event-stream")
+	writer.Header().Set("Cache-Control", "no-cache")
+	writer.Header().Set("Connection", "keep-alive")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := stream.ServeSSE(request.Context(), writer, flusher.Flush); err != nil {
+		log.Println("failed to stream events ", err)
+	}
+}
@@ -0,0 +1,47 @@
+package router
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) GetTasksIdHistory(
+	ctx context.Context, request oapi.GetTasksIdHistoryRequestObject,
+) (oapi.GetTasksIdHistoryResponseObject, error) {
+	entries, err := r.historyListByTask.Run(request.Id)
+	if err != nil {
+		return oapi.GetTasksIdHistory500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetTasksIdHistory200JSONResponse(lo.Map(entries, func(e history.Entry, _ int) oapi.HistoryEntry {
+		return mapHistoryEntryToAPI(e)
+	})), nil
+}
+
+func (r *Router) GetActivity(
+	ctx context.Context, request oapi.GetActivityRequestObject,
+) (oapi.GetActivityResponseObject, error) {
+	entries, err := r.historyListActivity.Run(lo.FromPtr(request.Params.Limit), lo.FromPtr(request.Params.Before))
+	if err != nil {
+		return oapi.GetActivity500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetActivity200JSONResponse(lo.Map(entries, func(e history.Entry, _ int) oapi.HistoryEntry {
+		return mapHistoryEntryToAPI(e)
+	})), nil
+}
+
+func mapHistoryEntryToAPI(entry history.Entry) oapi.HistoryEntry {
+	return oapi.HistoryEntry{
+		Id:        entry.ID,
+		TaskId:    entry.TaskID,
+		ActorId:   entry.ActorID,
+		Field:     entry.Field,
+		OldValue:  entry.OldValue,
+		NewValue:  entry.NewValue,
+		CreatedAt: entry.CreatedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package router
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/inbox"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) GetInboxAddress(
+	ctx context.Context, request oapi.GetInboxAddressRequestObject,
+) (oapi.GetInboxAddressResponseObject, error) {
+	address, err := r.inboxGetAddress.Run(request.Params.XUserId)
+	if err != nil {
+		if errors.Is(err, inbox.ErrUnknownUser) {
+			return oapi.GetInboxAddress400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
+		return oapi.GetInboxAddress500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetInboxAddress200JSONResponse(mapInboxAddressToAPI(address)), nil
+}
+
+func (r *Router) PostInboxAddress(
+	ctx context.Context, request oapi.PostInboxAddressRequestObject,
+) (oapi.PostInboxAddressResponseObject, error) {
+	address, err := r.inboxRotateAddress.Run(request.Params.XUserId)
+	if err != nil {
+		if errors.Is(err, inbox.ErrUnknownUser) {
+			return oapi.PostInboxAddress400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
+		return oapi.PostInboxAddress500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.PostInboxAddress201JSONResponse(mapInboxAddressToAPI(address)), nil
+}
+
+func mapInboxAddressToAPI(address inbox.Address) oapi.InboxAddress {
+	return oapi.InboxAddress{Token: address.Token, Address: address.Address}
+}
@@ -0,0 +1,121 @@
+package router
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/labels"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) GetLabels(
+	ctx context.Context, request oapi.GetLabelsRequestObject,
+) (oapi.GetLabelsResponseObject, error) {
+	labelList, err := r.labelsList.Run(lo.FromPtr(request.Params.BoardId))
+	if err != nil {
+		return oapi.GetLabels500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetLabels200JSONResponse(lo.Map(labelList, func(l labels.Label, _ int) oapi.Label {
+		return mapLabelToAPI(l)
+	})), nil
+}
+
+func (r *Router) PostLabels(
+	ctx context.Context, request oapi.PostLabelsRequestObject,
+) (oapi.PostLabelsResponseObject, error) {
+	label, err := r.labelsCreate.Run(request.Body.BoardId, request.Body.Name, request.Body.Color)
+	if errors.Is(err, labels.ErrInvalidLabel) {
+		return oapi.PostLabels400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if errors.Is(err, labels.ErrDuplicateName) {
+		return oapi.PostLabels409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostLabels500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.websocketServer.BroadcastLabelCreated(label)
+
+	return oapi.PostLabels201JSONResponse(mapLabelToAPI(label)), nil
+}
+
+func (r *Router) PutLabelsId(
+	ctx context.Context, request oapi.PutLabelsIdRequestObject,
+) (oapi.PutLabelsIdResponseObject, error) {
+	label, err := r.labelsUpdate.Run(labels.Label{
+		ID:    request.Id,
+		Name:  request.Body.Name,
+		Color: request.Body.Color,
+	})
+	if errors.Is(err, labels.ErrInvalidLabel) {
+		return oapi.PutLabelsId400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return oapi.PutLabelsId404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if errors.Is(err, labels.ErrDuplicateName) {
+		return oapi.PutLabelsId409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PutLabelsId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.websocketServer.BroadcastLabelUpdated(label)
+
+	return oapi.PutLabelsId200JSONResponse(mapLabelToAPI(label)), nil
+}
+
+func (r *Router) DeleteLabelsId(
+	ctx context.Context, request oapi.DeleteLabelsIdRequestObject,
+) (oapi.DeleteLabelsIdResponseObject, error) {
+	if err := r.labelsDelete.Run(request.Id, lo.FromPtr(request.Params.XUserId)); err != nil {
+		return oapi.DeleteLabelsId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.websocketServer.BroadcastLabelDeleted(request.Id)
+
+	return oapi.DeleteLabelsId204Response{}, nil
+}
+
+func (r *Router) PostTasksIdLabels(
+	ctx context.Context, request oapi.PostTasksIdLabelsRequestObject,
+) (oapi.PostTasksIdLabelsResponseObject, error) {
+	labelIDs, attached, err := r.labelsAttach.Run(request.Id, request.Body.LabelId, lo.FromPtr(request.Params.XUserId))
+	if errors.Is(err, labels.ErrOtherBoard) {
+		return oapi.PostTasksIdLabels400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return oapi.PostTasksIdLabels404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostTasksIdLabels500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	if attached {
+		r.websocketServer.BroadcastTaskLabelsChanged(request.Id, labelIDs)
+	}
+
+	return oapi.PostTasksIdLabels200JSONResponse{TaskId: request.Id, Labels: labelIDs}, nil
+}
+
+func (r *Router) DeleteTasksIdLabelsLabelId(
+	ctx context.Context, request oapi.DeleteTasksIdLabelsLabelIdRequestObject,
+) (oapi.DeleteTasksIdLabelsLabelIdResponseObject, error) {
+	labelIDs, detached, err := r.labelsDetach.Run(request.Id, request.LabelId, lo.FromPtr(request.Params.XUserId))
+	if err != nil {
+		return oapi.DeleteTasksIdLabelsLabelId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	if detached {
+		r.websocketServer.BroadcastTaskLabelsChanged(request.Id, labelIDs)
+	}
+
+	return oapi.DeleteTasksIdLabelsLabelId200JSONResponse{TaskId: request.Id, Labels: labelIDs}, nil
+}
+
+func mapLabelToAPI(label labels.Label) oapi.Label {
+	return oapi.Label{Id: label.ID, BoardId: label.BoardID, Name: label.Name, Color: label.Color}
+}
@@ -0,0 +1,47 @@
+package router
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) PostTasksIdReorder(
+	ctx context.Context, request oapi.PostTasksIdReorderRequestObject,
+) (oapi.PostTasksIdReorderResponseObject, error) {
+	var afterID, beforeID uuid.UUID
+	if request.Body != nil {
+		afterID = lo.FromPtr(request.Body.AfterId)
+		beforeID = lo.FromPtr(request.Body.BeforeId)
+	}
+
+	actorID := lo.FromPtr(request.Params.XUserId)
+	reordered, op, err := r.tasksReorder.Run(request.Id, afterID, beforeID, actorID)
+	if errors.Is(err, tasks.ErrNotSibling) {
+		return oapi.PostTasksIdReorder400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if errors.Is(err, tasks.ErrArchived) || errors.Is(err, tasks.ErrTrashed) {
+		return oapi.PostTasksIdReorder409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostTasksIdReorder500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.tasksUndoStack.Push(actorID, op)
+	r.websocketServer.BroadcastTaskReordered(reordered)
+
+	return oapi.PostTasksIdReorder200JSONResponse(mapReorderedToAPI(reordered)), nil
+}
+
+func mapReorderedToAPI(reordered tasks.Reordered) oapi.ReorderedTasks {
+	return oapi.ReorderedTasks{
+		ParentId: lo.EmptyableToPtr(reordered.ParentID),
+		Positions: lo.Map(reordered.Positions, func(p tasks.TaskPosition, _ int) oapi.TaskPosition {
+			return oapi.TaskPosition{Id: p.ID, Position: p.Position}
+		}),
+	}
+}
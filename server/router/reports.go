@@ -0,0 +1,104 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/reports"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+// defaultReportPeriod is how far back a report goes when it has no start.
+const defaultReportPeriod = 30 * 24 * time.Hour
+
+func (r *Router) GetReportsCost(
+	ctx context.Context, request oapi.GetReportsCostRequestObject,
+) (oapi.GetReportsCostResponseObject, error) {
+	period, groupBy := reportParams(
+		request.Params.From, request.Params.To, request.Params.Bucket, request.Params.GroupBy,
+	)
+
+	report, err := r.reportsCost.Run(period, groupBy)
+	if err != nil {
+		if isInvalidReport(err) {
+			return oapi.GetReportsCost400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
+		return oapi.GetReportsCost500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetReportsCost200JSONResponse{
+		Currency: report.Currency,
+		Buckets:  report.Buckets,
+		Groups: lo.Map(report.Groups, func(group reports.CostGroup, _ int) oapi.CostReportGroup {
+			return oapi.CostReportGroup{
+				Key:  group.Key,
+				Name: group.Name,
+				Costs: lo.Map(group.Costs, func(cost money.Money, _ int) oapi.Money {
+					return *moneyToAPI(cost)
+				}),
+				Total: *moneyToAPI(group.Total),
+			}
+		}),
+		Total: *moneyToAPI(report.Total),
+	}, nil
+}
+
+func (r *Router) GetReportsCompletion(
+	ctx context.Context, request oapi.GetReportsCompletionRequestObject,
+) (oapi.GetReportsCompletionResponseObject, error) {
+	period, groupBy := reportParams(
+		request.Params.From, request.Params.To, request.Params.Bucket, request.Params.GroupBy,
+	)
+
+	report, err := r.reportsCompletion.Run(period, groupBy)
+	if err != nil {
+		if isInvalidReport(err) {
+			return oapi.GetReportsCompletion400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
+		return oapi.GetReportsCompletion500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetReportsCompletion200JSONResponse{
+		Buckets: report.Buckets,
+		Groups: lo.Map(report.Groups, func(group reports.CompletionGroup, _ int) oapi.CompletionReportGroup {
+			return oapi.CompletionReportGroup{
+				Key:       group.Key,
+				Name:      group.Name,
+				Created:   group.Created,
+				Completed: group.Completed,
+			}
+		}),
+		Burndown: lo.Map(report.Burndown, func(point reports.BurndownPoint, _ int) oapi.BurndownPoint {
+			return oapi.BurndownPoint{
+				At:        point.At,
+				OpenTasks: point.OpenTasks,
+				OpenCost:  *moneyToAPI(point.OpenCost),
+			}
+		}),
+	}, nil
+}
+
+// reportParams fills in the defaults of the query of a report, the last 30
+// days in daily buckets grouped by the creator.
+func reportParams(
+	from *time.Time, to *time.Time, bucket *oapi.ReportBucket, groupBy *oapi.ReportGroupBy,
+) (reports.Period, reports.GroupBy) {
+	end := lo.FromPtrOr(to, time.Now().UTC())
+
+	return reports.Period{
+		From:   lo.FromPtrOr(from, end.Add(-defaultReportPeriod)),
+		To:     end,
+		Bucket: reports.Bucket(lo.FromPtrOr(bucket, oapi.Day)),
+	}, reports.GroupBy(lo.FromPtrOr(groupBy, oapi.Creator))
+}
+
+func isInvalidReport(err error) bool {
+	return errors.Is(err, reports.ErrInvalidPeriod) ||
+		errors.Is(err, reports.ErrInvalidBucket) ||
+		errors.Is(err, reports.ErrInvalidGroup)
+}
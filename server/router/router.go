@@ -9,9 +9,23 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/zemzale/ubiquitest/dav"
+	"github.com/zemzale/ubiquitest/domain/attachments"
+	"github.com/zemzale/ubiquitest/domain/calendar"
+	"github.com/zemzale/ubiquitest/domain/comments"
+	"github.com/zemzale/ubiquitest/domain/dependencies"
+	"github.com/zemzale/ubiquitest/domain/history"
+	"github.com/zemzale/ubiquitest/domain/inbox"
+	"github.com/zemzale/ubiquitest/domain/labels"
+	"github.com/zemzale/ubiquitest/domain/reports"
 	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/domain/timetracking"
+	"github.com/zemzale/ubiquitest/domain/transfer"
 	"github.com/zemzale/ubiquitest/domain/users"
+	"github.com/zemzale/ubiquitest/domain/webhooks"
 	"github.com/zemzale/ubiquitest/oapi"
+	"github.com/zemzale/ubiquitest/rpc"
+	"github.com/zemzale/ubiquitest/smtpd"
 	"github.com/zemzale/ubiquitest/ws"
 	"golang.org/x/sync/errgroup"
 )
@@ -19,11 +33,65 @@ import (
 var _ oapi.StrictServerInterface = (*Router)(nil)
 
 type Router struct {
-	websocketServer *ws.Server
-	taskList        *tasks.List
-	tasksStore      *tasks.Store
-	usersFindByID   *users.FindByID
-	usersUpsert     *users.FindOrCreate
+	websocketServer         *ws.Server
+	taskList                *tasks.List
+	tasksStore              *tasks.Store
+	tasksUpdate             *tasks.Update
+	tasksAssign             *tasks.Assign
+	tasksUnassign           *tasks.Unassign
+	tasksDelete             *tasks.Delete
+	tasksRestore            *tasks.Restore
+	tasksListTrash          *tasks.ListTrash
+	tasksTrashPurger        *tasks.TrashPurger
+	tasksUndoStack          *tasks.UndoStack
+	tasksUndo               *tasks.Undo
+	tasksRedo               *tasks.Redo
+	tasksArchive            *tasks.Archive
+	tasksUnarchive          *tasks.Unarchive
+	tasksReorder            *tasks.Reorder
+	tasksExport             *tasks.Export
+	tasksImport             *tasks.Import
+	transferDecoder         *transfer.Decoder
+	transferImport          *transfer.Import
+	usersFindByID           *users.FindByID
+	usersUpsert             *users.FindOrCreate
+	labelsList              *labels.List
+	labelsCreate            *labels.Create
+	labelsUpdate            *labels.Update
+	labelsDelete            *labels.Delete
+	labelsAttach            *labels.Attach
+	labelsDetach            *labels.Detach
+	commentsList            *comments.List
+	commentsAdd             *comments.Add
+	commentsEdit            *comments.Edit
+	commentsDelete          *comments.Delete
+	attachmentsUpload       *attachments.Upload
+	attachmentsDownload     *attachments.Download
+	attachmentsDelete       *attachments.Delete
+	historyListByTask       *history.ListByTask
+	historyListActivity     *history.ListActivity
+	dependenciesAdd         *dependencies.Add
+	dependenciesRemove      *dependencies.Remove
+	dependenciesListOrdered *dependencies.ListOrdered
+	timeEntriesList         *timetracking.List
+	timeEntriesAdd          *timetracking.Add
+	timeEntriesDelete       *timetracking.Delete
+	reportsCost             *reports.Cost
+	reportsCompletion       *reports.Completion
+	calendarGetToken        *calendar.GetToken
+	calendarRotateToken     *calendar.RotateToken
+	calendarFeed            *calendar.Feed
+	davHandler              *dav.Handler
+	webhooksList            *webhooks.List
+	webhooksCreate          *webhooks.Create
+	webhooksDelete          *webhooks.Delete
+	webhooksListDeliveries  *webhooks.ListDeliveries
+	webhooksRedeliver       *webhooks.Redeliver
+	webhooksDeliverer       *webhooks.Deliverer
+	inboxGetAddress         *inbox.GetAddress
+	inboxRotateAddress      *inbox.RotateAddress
+	smtpServer              *smtpd.Server
+	grpcServer              *rpc.Server
 
 	httpPort string
 	mux      *chi.Mux
@@ -32,19 +100,127 @@ type Router struct {
 func NewRouter(
 	httpPort string,
 	taskStore *tasks.Store,
+	taskUpdate *tasks.Update,
 	taskList *tasks.List,
 	taskCalculate *tasks.CalculateCost,
+	taskAssign *tasks.Assign,
+	taskUnassign *tasks.Unassign,
+	taskDelete *tasks.Delete,
+	taskRestore *tasks.Restore,
+	taskListTrash *tasks.ListTrash,
+	taskTrashPurger *tasks.TrashPurger,
+	taskUndoStack *tasks.UndoStack,
+	taskUndo *tasks.Undo,
+	taskRedo *tasks.Redo,
+	taskArchive *tasks.Archive,
+	taskUnarchive *tasks.Unarchive,
+	taskReorder *tasks.Reorder,
+	taskExport *tasks.Export,
+	taskImport *tasks.Import,
+	transferDecoder *transfer.Decoder,
+	transferImport *transfer.Import,
 	upsertUser *users.FindOrCreate,
 	userFindByID *users.FindByID,
+	labelList *labels.List,
+	labelCreate *labels.Create,
+	labelUpdate *labels.Update,
+	labelDelete *labels.Delete,
+	labelAttach *labels.Attach,
+	labelDetach *labels.Detach,
+	commentList *comments.List,
+	commentAdd *comments.Add,
+	commentEdit *comments.Edit,
+	commentDelete *comments.Delete,
+	attachmentUpload *attachments.Upload,
+	attachmentDownload *attachments.Download,
+	attachmentDelete *attachments.Delete,
+	historyListByTask *history.ListByTask,
+	historyListActivity *history.ListActivity,
+	dependencyAdd *dependencies.Add,
+	dependencyRemove *dependencies.Remove,
+	dependencyListOrdered *dependencies.ListOrdered,
+	timeEntryList *timetracking.List,
+	timeEntryAdd *timetracking.Add,
+	timeEntryDelete *timetracking.Delete,
+	reportCost *reports.Cost,
+	reportCompletion *reports.Completion,
+	calendarGetToken *calendar.GetToken,
+	calendarRotateToken *calendar.RotateToken,
+	calendarFeed *calendar.Feed,
+	davHandler *dav.Handler,
+	webhookList *webhooks.List,
+	webhookCreate *webhooks.Create,
+	webhookDelete *webhooks.Delete,
+	webhookListDeliveries *webhooks.ListDeliveries,
+	webhookRedeliver *webhooks.Redeliver,
+	webhookDeliverer *webhooks.Deliverer,
+	inboxGetAddress *inbox.GetAddress,
+	inboxRotateAddress *inbox.RotateAddress,
+	smtpServer *smtpd.Server,
+	grpcServer *rpc.Server,
 	wss *ws.Server,
 ) *Router {
 	return &Router{
-		websocketServer: wss,
-		taskList:        taskList,
-		usersUpsert:     upsertUser,
-		tasksStore:      taskStore,
-		usersFindByID:   userFindByID,
-		mux:             chi.NewRouter(),
+		websocketServer:         wss,
+		taskList:                taskList,
+		usersUpsert:             upsertUser,
+		tasksStore:              taskStore,
+		tasksUpdate:             taskUpdate,
+		tasksAssign:             taskAssign,
+		tasksUnassign:           taskUnassign,
+		tasksDelete:             taskDelete,
+		tasksRestore:            taskRestore,
+		tasksListTrash:          taskListTrash,
+		tasksTrashPurger:        taskTrashPurger,
+		tasksUndoStack:          taskUndoStack,
+		tasksUndo:               taskUndo,
+		tasksRedo:               taskRedo,
+		tasksArchive:            taskArchive,
+		tasksUnarchive:          taskUnarchive,
+		tasksReorder:            taskReorder,
+		tasksExport:             taskExport,
+		tasksImport:             taskImport,
+		transferDecoder:         transferDecoder,
+		transferImport:          transferImport,
+		usersFindByID:           userFindByID,
+		labelsList:              labelList,
+		labelsCreate:            labelCreate,
+		labelsUpdate:            labelUpdate,
+		labelsDelete:            labelDelete,
+		labelsAttach:            labelAttach,
+		labelsDetach:            labelDetach,
+		commentsList:            commentList,
+		commentsAdd:             commentAdd,
+		commentsEdit:            commentEdit,
+		commentsDelete:          commentDelete,
+		attachmentsUpload:       attachmentUpload,
+		attachmentsDownload:     attachmentDownload,
+		attachmentsDelete:       attachmentDelete,
+		historyListByTask:       historyListByTask,
+		historyListActivity:     historyListActivity,
+		dependenciesAdd:         dependencyAdd,
+		dependenciesRemove:      dependencyRemove,
+		dependenciesListOrdered: dependencyListOrdered,
+		timeEntriesList:         timeEntryList,
+		timeEntriesAdd:          timeEntryAdd,
+		timeEntriesDelete:       timeEntryDelete,
+		reportsCost:             reportCost,
+		reportsCompletion:       reportCompletion,
+		calendarGetToken:        calendarGetToken,
+		calendarRotateToken:     calendarRotateToken,
+		calendarFeed:            calendarFeed,
+		davHandler:              davHandler,
+		webhooksList:            webhookList,
+		webhooksCreate:          webhookCreate,
+		webhooksDelete:          webhookDelete,
+		webhooksListDeliveries:  webhookListDeliveries,
+		webhooksRedeliver:       webhookRedeliver,
+		webhooksDeliverer:       webhookDeliverer,
+		inboxGetAddress:         inboxGetAddress,
+		inboxRotateAddress:      inboxRotateAddress,
+		smtpServer:              smtpServer,
+		grpcServer:              grpcServer,
+		mux:                     chi.NewRouter(),
 
 		httpPort: httpPort,
 	}
@@ -61,6 +237,26 @@ func (r *Router) Run() error {
 		return nil
 	})
 
+	errGroup.Go(func() error {
+		r.tasksTrashPurger.Run(ctx)
+
+		return nil
+	})
+
+	errGroup.Go(func() error {
+		r.webhooksDeliverer.Run(ctx)
+
+		return nil
+	})
+
+	errGroup.Go(func() error {
+		return r.smtpServer.Run(ctx)
+	})
+
+	errGroup.Go(func() error {
+		return r.grpcServer.Run(ctx)
+	})
+
 	errGroup.Go(func() error {
 		return http.ListenAndServe(r.httpPort, r.mux)
 	})
@@ -73,10 +269,20 @@ func (r *Router) setupRoutes() {
 	r.mux.Use(cors.New(cors.Options{
 		AllowedOrigins: []string{"https://ubiquitest.netlify.app", "http://localhost:3000"},
 		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedHeaders: []string{"Content-Type", "X-User-Id", "Last-Event-ID"},
 	}).Handler)
 	oapi.HandlerFromMux(oapi.NewStrictHandler(r, nil), r.mux)
 	r.mux.HandleFunc("/ws/tasks", r.WsTasks)
+	r.mux.Get("/events", r.Events)
 	r.mux.Handle("/metrics", promhttp.Handler())
+
+	// chi only routes the methods it knows, CalDAV adds its own on top of
+	// the HTTP ones.
+	for _, method := range []string{"PROPFIND", "PROPPATCH", "REPORT", "MKCOL", "COPY", "MOVE"} {
+		chi.RegisterMethod(method)
+	}
+	r.mux.Mount(dav.Prefix, r.davHandler)
+	r.mux.Handle("/.well-known/caldav", r.davHandler)
 }
 
 func (r *Router) printDebugRoutes() {
@@ -0,0 +1,75 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/timetracking"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) GetTasksIdTimeEntries(
+	ctx context.Context, request oapi.GetTasksIdTimeEntriesRequestObject,
+) (oapi.GetTasksIdTimeEntriesResponseObject, error) {
+	entries, err := r.timeEntriesList.Run(request.Id)
+	if err != nil {
+		return oapi.GetTasksIdTimeEntries500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetTasksIdTimeEntries200JSONResponse(lo.Map(entries, func(e timetracking.Entry, _ int) oapi.TimeEntry {
+		return mapTimeEntryToAPI(e)
+	})), nil
+}
+
+func (r *Router) PostTasksIdTimeEntries(
+	ctx context.Context, request oapi.PostTasksIdTimeEntriesRequestObject,
+) (oapi.PostTasksIdTimeEntriesResponseObject, error) {
+	entry, err := r.timeEntriesAdd.Run(
+		request.Id,
+		request.Params.XUserId,
+		request.Body.StartedAt,
+		lo.FromPtr(request.Body.EndedAt),
+		time.Duration(lo.FromPtr(request.Body.DurationSeconds))*time.Second,
+		lo.FromPtr(request.Body.Note),
+	)
+	if errors.Is(err, timetracking.ErrInvalidEntry) || errors.Is(err, timetracking.ErrTaskTrashed) {
+		return oapi.PostTasksIdTimeEntries400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostTasksIdTimeEntries500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.websocketServer.BroadcastTimeEntryAdded(entry)
+
+	return oapi.PostTasksIdTimeEntries201JSONResponse(mapTimeEntryToAPI(entry)), nil
+}
+
+func (r *Router) DeleteTasksIdTimeEntriesEntryId(
+	ctx context.Context, request oapi.DeleteTasksIdTimeEntriesEntryIdRequestObject,
+) (oapi.DeleteTasksIdTimeEntriesEntryIdResponseObject, error) {
+	entry, err := r.timeEntriesDelete.Run(request.Id, request.EntryId, request.Params.XUserId)
+	if errors.Is(err, timetracking.ErrNotOwner) {
+		return oapi.DeleteTasksIdTimeEntriesEntryId403JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.DeleteTasksIdTimeEntriesEntryId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.websocketServer.BroadcastTimeEntryDeleted(entry)
+
+	return oapi.DeleteTasksIdTimeEntriesEntryId204Response{}, nil
+}
+
+func mapTimeEntryToAPI(entry timetracking.Entry) oapi.TimeEntry {
+	return oapi.TimeEntry{
+		Id:              entry.ID,
+		TaskId:          entry.TaskID,
+		UserId:          entry.UserID,
+		StartedAt:       entry.StartedAt,
+		EndedAt:         lo.EmptyableToPtr(entry.EndedAt),
+		DurationSeconds: int64(entry.Duration / time.Second),
+		Note:            lo.EmptyableToPtr(entry.Note),
+	}
+}
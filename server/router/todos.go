@@ -2,9 +2,16 @@ package router
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/attachments"
+	"github.com/zemzale/ubiquitest/domain/money"
 	"github.com/zemzale/ubiquitest/domain/tasks"
 	"github.com/zemzale/ubiquitest/oapi"
 )
@@ -17,45 +24,229 @@ func (r *Router) PostTasks(
 		parnetID = *request.Body.ParentId
 	}
 
-	err := r.tasksStore.Run(tasks.Task{
-		ID:        request.Body.Id,
-		Title:     request.Body.Title,
-		CreatedBy: request.Body.CreatedBy,
-		Completed: false,
-		ParentID:  parnetID,
-		Cost:      lo.FromPtr(request.Body.Cost),
-	})
-	if err != nil {
+	task := tasks.Task{
+		ID:         request.Body.Id,
+		Title:      request.Body.Title,
+		CreatedBy:  request.Body.CreatedBy,
+		Completed:  false,
+		ParentID:   parnetID,
+		Cost:       moneyFromAPI(request.Body.Cost),
+		Budget:     moneyFromAPI(request.Body.Budget),
+		DueAt:      lo.FromPtr(request.Body.DueAt),
+		Recurrence: recurrenceFromAPI(request.Body.Recurrence),
+		Assignees:  lo.FromPtr(request.Body.Assignees),
+	}
+
+	if err := r.tasksStore.Run(task); err != nil {
+		if errors.Is(err, money.ErrUnknownCurrency) || errors.Is(err, money.ErrCurrencyMismatch) ||
+			errors.Is(err, tasks.ErrNegativeBudget) {
+			return oapi.PostTasks400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
 		return oapi.PostTasks500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
 	}
 
+	r.tasksUndoStack.Push(task.CreatedBy, tasks.Operation{Kind: tasks.OperationCreate, After: task})
+	go r.websocketServer.BroadcastTaskCreated(task)
+
 	return oapi.PostTasks201Response{}, nil
 }
 
+func (r *Router) PutTasksId(
+	ctx context.Context, request oapi.PutTasksIdRequestObject,
+) (oapi.PutTasksIdResponseObject, error) {
+	userID := request.Params.XUserId
+	op, err := r.tasksUpdate.Run(changeFromAPI(request.Id, *request.Body), userID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return oapi.PutTasksId404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	case errors.Is(err, money.ErrUnknownCurrency) || errors.Is(err, money.ErrCurrencyMismatch) ||
+		errors.Is(err, tasks.ErrNegativeBudget):
+		return oapi.PutTasksId400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	case errors.Is(err, tasks.ErrTrashed) || errors.Is(err, tasks.ErrBlocked):
+		return oapi.PutTasksId409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	case err != nil:
+		return oapi.PutTasksId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.tasksUndoStack.Push(userID, op)
+	go r.websocketServer.BroadcastTaskUpdated(op)
+
+	return oapi.PutTasksId204Response{}, nil
+}
+
+// changeFromAPI keeps the fields that are left out, the cleared ones are set
+// to their zero value.
+func changeFromAPI(id uuid.UUID, update oapi.TodoUpdate) tasks.Change {
+	change := tasks.Change{ID: id, Title: update.Title, Completed: update.Completed}
+	if update.Cost != nil {
+		change.Cost = lo.ToPtr(moneyFromAPI(update.Cost))
+	}
+	if update.Budget != nil {
+		change.Budget = lo.ToPtr(moneyFromAPI(update.Budget))
+	}
+	if update.DueAt != nil {
+		change.DueAt = update.DueAt
+	}
+	if update.Recurrence != nil {
+		change.Recurrence = lo.ToPtr(recurrenceFromAPI(update.Recurrence))
+	}
+
+	for _, field := range lo.FromPtr(update.Clear) {
+		switch field {
+		case oapi.TodoUpdateClearBudget:
+			change.Budget = &money.Money{}
+		case oapi.TodoUpdateClearDueAt:
+			change.DueAt = &time.Time{}
+		case oapi.TodoUpdateClearRecurrence:
+			change.Recurrence = &tasks.Recurrence{}
+		}
+	}
+
+	return change
+}
+
 func (r *Router) GetTasks(
 	ctx context.Context, request oapi.GetTasksRequestObject,
 ) (oapi.GetTasksResponseObject, error) {
-	taskList, err := r.taskList.Run()
+	assigneeID, err := parseAssignee(request.Params.Assignee, request.Params.XUserId)
+	if err != nil {
+		return oapi.GetTasks400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	taskList, err := r.taskList.Run(tasks.ListFilter{
+		Overdue:    lo.FromPtr(request.Params.Overdue),
+		AssigneeID: assigneeID,
+		LabelIDs:   lo.FromPtr(request.Params.Label),
+		Archived:   lo.FromPtr(request.Params.Archived),
+	})
 	if err != nil {
 		return oapi.GetTasks500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
 	}
 
-	return oapi.GetTasks200JSONResponse(
-		lo.Map(taskList, func(t tasks.Task, _ int) oapi.Todo {
-			return oapi.Todo{
-				Id:        t.ID,
-				Title:     t.Title,
-				CreatedBy: t.CreatedBy,
-				Completed: t.Completed,
-				ParentId: func() *uuid.UUID {
-					if t.ParentID == uuid.Nil {
-						return nil
-					}
-
-					return &t.ParentID
-				}(),
-				Cost: lo.ToPtr(t.Cost),
+	return oapi.GetTasks200JSONResponse(lo.Map(taskList, func(t tasks.Task, _ int) oapi.Todo {
+		return mapTaskToAPI(t)
+	})), nil
+}
+
+// parseAssignee resolves the assignee filter, which is either a user ID or
+// "me" for the user making the request.
+func parseAssignee(assignee *string, userID *uint) (uint, error) {
+	if assignee == nil || *assignee == "" {
+		return 0, nil
+	}
+
+	if *assignee == "me" {
+		if userID == nil {
+			return 0, fmt.Errorf("X-User-Id header is required for assignee=me")
+		}
+
+		return *userID, nil
+	}
+
+	id, err := strconv.ParseUint(*assignee, 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("assignee must be a user id or 'me'")
+	}
+
+	return uint(id), nil
+}
+
+func mapTaskToAPI(t tasks.Task) oapi.Todo {
+	return oapi.Todo{
+		Id:        t.ID,
+		Title:     t.Title,
+		CreatedBy: t.CreatedBy,
+		Completed: t.Completed,
+		ParentId: func() *uuid.UUID {
+			if t.ParentID == uuid.Nil {
+				return nil
+			}
+
+			return &t.ParentID
+		}(),
+		Cost: moneyToAPI(t.Cost),
+		Budget: func() *oapi.Money {
+			if !t.HasBudget() {
+				return nil
+			}
+
+			return moneyToAPI(t.Budget)
+		}(),
+		BudgetRemaining: func() *oapi.Money {
+			if !t.HasBudget() {
+				return nil
 			}
-		}),
-	), nil
+
+			return moneyToAPI(t.RemainingBudget())
+		}(),
+		OverBudget:    lo.ToPtr(t.OverBudget()),
+		LoggedSeconds: lo.ToPtr(int64(t.Logged / time.Second)),
+		Rollup:        rollupToAPI(t.Rollup),
+		DueAt:         lo.EmptyableToPtr(t.DueAt),
+		DeletedAt:     lo.EmptyableToPtr(t.DeletedAt),
+		ArchivedAt:    lo.EmptyableToPtr(t.ArchivedAt),
+		Position:      lo.EmptyableToPtr(t.Position),
+		Recurrence:    recurrenceToAPI(t.Recurrence),
+		Assignees:     lo.ToPtr(lo.Ternary(t.Assignees == nil, []uint{}, t.Assignees)),
+		Labels:        lo.ToPtr(lo.Ternary(t.Labels == nil, []uuid.UUID{}, t.Labels)),
+		Attachments: lo.ToPtr(lo.Map(t.Attachments, func(a attachments.Attachment, _ int) oapi.Attachment {
+			return mapAttachmentToAPI(a)
+		})),
+		BlockedBy: lo.ToPtr(lo.Ternary(t.BlockedBy == nil, []uuid.UUID{}, t.BlockedBy)),
+		Blocked:   lo.ToPtr(t.Blocked),
+	}
+}
+
+func recurrenceFromAPI(recurrence *oapi.Recurrence) tasks.Recurrence {
+	if recurrence == nil {
+		return tasks.Recurrence{}
+	}
+
+	return tasks.Recurrence{
+		Rule:        recurrence.Rule,
+		Timezone:    lo.FromPtr(recurrence.Timezone),
+		CopySubtree: lo.FromPtr(recurrence.CopySubtree),
+	}
+}
+
+func recurrenceToAPI(recurrence tasks.Recurrence) *oapi.Recurrence {
+	if recurrence.IsZero() {
+		return nil
+	}
+
+	return &oapi.Recurrence{
+		Rule:        recurrence.Rule,
+		Timezone:    lo.EmptyableToPtr(recurrence.Timezone),
+		CopySubtree: lo.ToPtr(recurrence.CopySubtree),
+	}
+}
+
+func rollupToAPI(rollup tasks.Rollup) *oapi.Rollup {
+	if rollup.IsZero() {
+		return nil
+	}
+
+	return &oapi.Rollup{
+		Cost:            *moneyToAPI(rollup.Cost),
+		RemainingCost:   *moneyToAPI(rollup.RemainingCost),
+		Descendants:     rollup.Descendants,
+		PercentComplete: rollup.PercentComplete,
+		MaxDueAt:        lo.EmptyableToPtr(rollup.MaxDueAt),
+	}
+}
+
+func moneyFromAPI(m *oapi.Money) money.Money {
+	if m == nil {
+		return money.Money{}
+	}
+
+	return money.New(m.Amount, lo.FromPtr(m.Currency))
+}
+
+func moneyToAPI(m money.Money) *oapi.Money {
+	return &oapi.Money{
+		Amount:   m.Amount,
+		Currency: lo.ToPtr(m.Currency),
+	}
 }
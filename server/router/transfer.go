@@ -0,0 +1,93 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/domain/transfer"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) GetExport(
+	ctx context.Context, request oapi.GetExportRequestObject,
+) (oapi.GetExportResponseObject, error) {
+	format := transfer.Format(lo.FromPtrOr(request.Params.Format, oapi.Json))
+
+	exported, err := r.tasksExport.Run()
+	if err != nil {
+		return oapi.GetExport500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	var buf bytes.Buffer
+	if err := transfer.Encode(&buf, format, exported); err != nil {
+		if errors.Is(err, transfer.ErrUnknownFormat) {
+			return oapi.GetExport400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
+		return oapi.GetExport500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	filename := "tasks-" + time.Now().UTC().Format(time.DateOnly) + "." + string(format)
+
+	return oapi.GetExport200AsteriskResponse{
+		Body:          &buf,
+		ContentType:   format.ContentType(),
+		ContentLength: int64(buf.Len()),
+		Headers: oapi.GetExport200ResponseHeaders{
+			ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": filename}),
+		},
+	}, nil
+}
+
+func (r *Router) PostImport(
+	ctx context.Context, request oapi.PostImportRequestObject,
+) (oapi.PostImportResponseObject, error) {
+	format := transfer.Format(lo.FromPtrOr(request.Params.Format, oapi.Json))
+
+	exported, err := r.transferDecoder.Decode(request.Body, format)
+	if err != nil {
+		return oapi.PostImport400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	dryRun := lo.FromPtr(request.Params.DryRun)
+	run := r.tasksImport.Run
+	switch {
+	case dryRun:
+		run = r.tasksImport.Preview
+	case !format.Native():
+		run = r.transferImport.Run
+	}
+
+	imported, err := run(exported, lo.FromPtr(request.Params.ParentId), request.Params.XUserId)
+	if err != nil {
+		if errors.Is(err, tasks.ErrInvalidImport) || errors.Is(err, tasks.ErrNegativeBudget) ||
+			errors.Is(err, money.ErrUnknownCurrency) || errors.Is(err, money.ErrCurrencyMismatch) {
+			return oapi.PostImport400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+		}
+
+		return oapi.PostImport500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	result := oapi.ImportResult{
+		Tasks: lo.Map(imported.Tasks, func(t tasks.Task, _ int) oapi.Todo {
+			return mapTaskToAPI(t)
+		}),
+		Ids: lo.MapEntries(imported.IDs, func(from uuid.UUID, to uuid.UUID) (string, uuid.UUID) {
+			return from.String(), to
+		}),
+	}
+	if dryRun {
+		return oapi.PostImport200JSONResponse(result), nil
+	}
+
+	r.websocketServer.BroadcastTasksImported(imported)
+
+	return oapi.PostImport201JSONResponse(result), nil
+}
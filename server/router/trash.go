@@ -0,0 +1,61 @@
+package router
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) DeleteTasksId(
+	ctx context.Context, request oapi.DeleteTasksIdRequestObject,
+) (oapi.DeleteTasksIdResponseObject, error) {
+	actorID := lo.FromPtr(request.Params.XUserId)
+	task, deletedIDs, err := r.tasksDelete.Run(request.Id, actorID)
+	if errors.Is(err, tasks.ErrTrashed) || errors.Is(err, tasks.ErrArchived) {
+		return oapi.DeleteTasksId409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.DeleteTasksId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.tasksUndoStack.Push(actorID, tasks.Operation{Kind: tasks.OperationDelete, Before: task})
+	r.websocketServer.BroadcastTaskDeleted(task, deletedIDs)
+
+	return oapi.DeleteTasksId200JSONResponse{Ids: deletedIDs}, nil
+}
+
+func (r *Router) PostTasksIdRestore(
+	ctx context.Context, request oapi.PostTasksIdRestoreRequestObject,
+) (oapi.PostTasksIdRestoreResponseObject, error) {
+	actorID := lo.FromPtr(request.Params.XUserId)
+	restored, err := r.tasksRestore.Run(request.Id, actorID)
+	if errors.Is(err, tasks.ErrNotTrashed) || errors.Is(err, tasks.ErrParentTrashed) {
+		return oapi.PostTasksIdRestore409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostTasksIdRestore500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	r.tasksUndoStack.Push(actorID, tasks.Operation{Kind: tasks.OperationRestore, After: restored[0]})
+	r.websocketServer.BroadcastTaskRestored(restored)
+
+	return oapi.PostTasksIdRestore200JSONResponse(lo.Map(restored, func(t tasks.Task, _ int) oapi.Todo {
+		return mapTaskToAPI(t)
+	})), nil
+}
+
+func (r *Router) GetTrash(
+	ctx context.Context, request oapi.GetTrashRequestObject,
+) (oapi.GetTrashResponseObject, error) {
+	trashed, err := r.tasksListTrash.Run()
+	if err != nil {
+		return oapi.GetTrash500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetTrash200JSONResponse(lo.Map(trashed, func(t tasks.Task, _ int) oapi.Todo {
+		return mapTaskToAPI(t)
+	})), nil
+}
@@ -0,0 +1,58 @@
+package router
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) PostUndo(
+	ctx context.Context, request oapi.PostUndoRequestObject,
+) (oapi.PostUndoResponseObject, error) {
+	changes, err := r.tasksUndo.Run(request.Params.XUserId)
+	r.websocketServer.BroadcastChanges(changes)
+	if errors.Is(err, tasks.ErrNothingToUndo) || errors.Is(err, tasks.ErrUndoConflict) {
+		return oapi.PostUndo409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostUndo500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.PostUndo200JSONResponse(mapChangesToAPI(changes)), nil
+}
+
+func (r *Router) PostRedo(
+	ctx context.Context, request oapi.PostRedoRequestObject,
+) (oapi.PostRedoResponseObject, error) {
+	changes, err := r.tasksRedo.Run(request.Params.XUserId)
+	r.websocketServer.BroadcastChanges(changes)
+	if errors.Is(err, tasks.ErrNothingToRedo) || errors.Is(err, tasks.ErrUndoConflict) {
+		return oapi.PostRedo409JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostRedo500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.PostRedo200JSONResponse(mapChangesToAPI(changes)), nil
+}
+
+func mapChangesToAPI(changes tasks.Changes) oapi.UndoChanges {
+	mapTasks := func(taskList []tasks.Task) []oapi.Todo {
+		return lo.Map(taskList, func(t tasks.Task, _ int) oapi.Todo {
+			return mapTaskToAPI(t)
+		})
+	}
+
+	return oapi.UndoChanges{
+		Updated:    mapTasks(changes.Updated),
+		DeletedIds: lo.Ternary(changes.DeletedIDs == nil, []uuid.UUID{}, changes.DeletedIDs),
+		Restored:   mapTasks(changes.Restored),
+		Reordered: lo.Map(changes.Reordered, func(reordered tasks.Reordered, _ int) oapi.ReorderedTasks {
+			return mapReorderedToAPI(reordered)
+		}),
+	}
+}
@@ -0,0 +1,113 @@
+package router
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/webhooks"
+	"github.com/zemzale/ubiquitest/oapi"
+)
+
+func (r *Router) GetWebhooks(
+	ctx context.Context, request oapi.GetWebhooksRequestObject,
+) (oapi.GetWebhooksResponseObject, error) {
+	list, err := r.webhooksList.Run()
+	if err != nil {
+		return oapi.GetWebhooks500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetWebhooks200JSONResponse(lo.Map(list, func(w webhooks.Webhook, _ int) oapi.Webhook {
+		return mapWebhookToAPI(w)
+	})), nil
+}
+
+func (r *Router) PostWebhooks(
+	ctx context.Context, request oapi.PostWebhooksRequestObject,
+) (oapi.PostWebhooksResponseObject, error) {
+	webhook, err := r.webhooksCreate.Run(webhooks.Webhook{
+		URL:        request.Body.Url,
+		EventTypes: lo.FromPtr(request.Body.EventTypes),
+		Secret:     lo.FromPtr(request.Body.Secret),
+		CreatedBy:  request.Params.XUserId,
+	})
+	if errors.Is(err, webhooks.ErrInvalidWebhook) {
+		return oapi.PostWebhooks400JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostWebhooks500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.PostWebhooks201JSONResponse(mapWebhookToAPI(webhook)), nil
+}
+
+func (r *Router) DeleteWebhooksId(
+	ctx context.Context, request oapi.DeleteWebhooksIdRequestObject,
+) (oapi.DeleteWebhooksIdResponseObject, error) {
+	err := r.webhooksDelete.Run(request.Id)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		return oapi.DeleteWebhooksId404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.DeleteWebhooksId500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.DeleteWebhooksId204Response{}, nil
+}
+
+func (r *Router) GetWebhooksIdDeliveries(
+	ctx context.Context, request oapi.GetWebhooksIdDeliveriesRequestObject,
+) (oapi.GetWebhooksIdDeliveriesResponseObject, error) {
+	deliveries, err := r.webhooksListDeliveries.Run(request.Id)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		return oapi.GetWebhooksIdDeliveries404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.GetWebhooksIdDeliveries500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.GetWebhooksIdDeliveries200JSONResponse(lo.Map(deliveries, func(d webhooks.Delivery, _ int) oapi.WebhookDelivery {
+		return mapWebhookDeliveryToAPI(d)
+	})), nil
+}
+
+func (r *Router) PostWebhooksIdDeliveriesDeliveryIdRedeliver(
+	ctx context.Context, request oapi.PostWebhooksIdDeliveriesDeliveryIdRedeliverRequestObject,
+) (oapi.PostWebhooksIdDeliveriesDeliveryIdRedeliverResponseObject, error) {
+	delivery, err := r.webhooksRedeliver.Run(request.Id, request.DeliveryId)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		return oapi.PostWebhooksIdDeliveriesDeliveryIdRedeliver404JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+	if err != nil {
+		return oapi.PostWebhooksIdDeliveriesDeliveryIdRedeliver500JSONResponse{Error: lo.ToPtr(err.Error())}, nil
+	}
+
+	return oapi.PostWebhooksIdDeliveriesDeliveryIdRedeliver202JSONResponse(mapWebhookDeliveryToAPI(delivery)), nil
+}
+
+func mapWebhookToAPI(webhook webhooks.Webhook) oapi.Webhook {
+	return oapi.Webhook{
+		Id:         webhook.ID,
+		Url:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+		Secret:     lo.EmptyableToPtr(webhook.Secret),
+		CreatedBy:  webhook.CreatedBy,
+		CreatedAt:  webhook.CreatedAt,
+	}
+}
+
+func mapWebhookDeliveryToAPI(delivery webhooks.Delivery) oapi.WebhookDelivery {
+	return oapi.WebhookDelivery{
+		Id:             delivery.ID,
+		WebhookId:      delivery.WebhookID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         oapi.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  lo.EmptyableToPtr(delivery.NextAttemptAt),
+		ResponseStatus: lo.EmptyableToPtr(delivery.ResponseStatus),
+		Error:          lo.EmptyableToPtr(delivery.Error),
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    lo.EmptyableToPtr(delivery.DeliveredAt),
+	}
+}
@@ -0,0 +1,205 @@
+// Package rpc serves the gRPC API for internal services, through the same use
+// cases as the REST API and with the same events as the websocket.
+package rpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/domain/users"
+	"github.com/zemzale/ubiquitest/pb"
+	"github.com/zemzale/ubiquitest/ws"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// userIDKey is the metadata with the user making the request.
+const userIDKey = "x-user-id"
+
+// Server is the gRPC listener, it only listens when it has an address.
+type Server struct {
+	pb.UnimplementedUbiquitestServer
+
+	addr string
+
+	taskStore          *tasks.Store
+	taskFind           *tasks.Find
+	taskList           *tasks.List
+	taskUpdate         *tasks.Update
+	taskDelete         *tasks.Delete
+	taskUndoStack      *tasks.UndoStack
+	userFindByID       *users.FindByID
+	userFindByUsername *users.FindByUsername
+	websocketServer    *ws.Server
+}
+
+func NewServer(
+	addr string,
+	taskStore *tasks.Store,
+	taskFind *tasks.Find,
+	taskList *tasks.List,
+	taskUpdate *tasks.Update,
+	taskDelete *tasks.Delete,
+	taskUndoStack *tasks.UndoStack,
+	userFindByID *users.FindByID,
+	userFindByUsername *users.FindByUsername,
+	websocketServer *ws.Server,
+) *Server {
+	return &Server{
+		addr:               addr,
+		taskStore:          taskStore,
+		taskFind:           taskFind,
+		taskList:           taskList,
+		taskUpdate:         taskUpdate,
+		taskDelete:         taskDelete,
+		taskUndoStack:      taskUndoStack,
+		userFindByID:       userFindByID,
+		userFindByUsername: userFindByUsername,
+		websocketServer:    websocketServer,
+	}
+}
+
+// Run blocks until the context is cancelled.
+func (s *Server) Run(ctx context.Context) error {
+	if s.addr == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen for grpc: %w", err)
+	}
+
+	server := grpc.NewServer()
+	pb.RegisterUbiquitestServer(server, s)
+	reflection.Register(server)
+
+	go func() {
+		<-ctx.Done()
+		// Subscriptions never finish on their own, so they are not waited for.
+		server.Stop()
+	}()
+
+	log.Printf("serving grpc on %s\n", s.addr)
+	if err := server.Serve(listener); err != nil {
+		return fmt.Errorf("failed to serve grpc: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server) GetUser(ctx context.Context, request *pb.GetUserRequest) (*pb.User, error) {
+	var (
+		user users.User
+		err  error
+	)
+	switch lookup := request.GetUser().(type) {
+	case *pb.GetUserRequest_Id:
+		user, err = s.userFindByID.Run(uint(lookup.Id))
+	case *pb.GetUserRequest_Username:
+		user, err = s.userFindByUsername.Run(lookup.Username)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "id or username is required")
+	}
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.User{Id: uint64(user.ID), Username: user.Username}, nil
+}
+
+// Subscribe gets a stream of its own, the user can subscribe several times and
+// follow the events over SSE at the same time.
+func (s *Server) Subscribe(request *pb.SubscribeRequest, stream pb.Ubiquitest_SubscribeServer) error {
+	userID, err := userFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	if userID == 0 {
+		return status.Errorf(codes.Unauthenticated, "%s metadata is required", userIDKey)
+	}
+
+	user, err := s.userFindByID.Run(userID)
+	if err != nil {
+		return statusFromError(err)
+	}
+
+	subscription, err := s.websocketServer.Subscribe(user.Username, request.GetLastEventId())
+	if err != nil {
+		return statusFromError(err)
+	}
+	defer subscription.Close()
+
+	for _, message := range subscription.Missed() {
+		if err := sendEvent(stream, message); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-subscription.Done():
+			return status.Error(codes.Unavailable, "subscription was closed, subscribe again with the last event ID")
+		case message := <-subscription.Messages():
+			if err := sendEvent(stream, message); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func sendEvent(stream pb.Ubiquitest_SubscribeServer, message ws.Message) error {
+	event, err := message.Event()
+	if err != nil {
+		log.Println("failed to send event over grpc ", err)
+		return nil
+	}
+
+	return stream.Send(&pb.Event{
+		Id:   strconv.FormatUint(message.ID, 10),
+		Type: string(event.EventType),
+		Data: event.Data,
+	})
+}
+
+// userFromContext returns the user in the metadata, 0 when there is none.
+func userFromContext(ctx context.Context) (uint, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(userIDKey)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseUint(values[0], 10, 0)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "%s metadata must be a user id", userIDKey)
+	}
+
+	return uint(id), nil
+}
+
+func statusFromError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows) || errors.Is(err, tasks.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, money.ErrUnknownCurrency) || errors.Is(err, money.ErrCurrencyMismatch) ||
+		errors.Is(err, tasks.ErrNegativeBudget):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, tasks.ErrTrashed) || errors.Is(err, tasks.ErrArchived) || errors.Is(err, tasks.ErrBlocked):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
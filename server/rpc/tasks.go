@@ -0,0 +1,264 @@
+package rpc
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) CreateTask(ctx context.Context, request *pb.CreateTaskRequest) (*pb.Task, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userID == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "%s metadata is required", userIDKey)
+	}
+
+	id := uuid.New()
+	if request.GetId() != "" {
+		if id, err = parseID(request.GetId()); err != nil {
+			return nil, err
+		}
+	}
+
+	parentID := uuid.Nil
+	if request.GetParentId() != "" {
+		if parentID, err = parseID(request.GetParentId()); err != nil {
+			return nil, err
+		}
+	}
+
+	task := tasks.Task{
+		ID:         id,
+		Title:      request.GetTitle(),
+		CreatedBy:  userID,
+		ParentID:   parentID,
+		Cost:       moneyFromPB(request.GetCost()),
+		Budget:     moneyFromPB(request.GetBudget()),
+		DueAt:      timeFromPB(request.GetDueAt()),
+		Recurrence: recurrenceFromPB(request.GetRecurrence()),
+		Assignees:  lo.Map(request.GetAssignees(), func(id uint64, _ int) uint { return uint(id) }),
+	}
+
+	if err := s.taskStore.Run(task); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	s.taskUndoStack.Push(userID, tasks.Operation{Kind: tasks.OperationCreate, After: task})
+	go s.websocketServer.BroadcastTaskCreated(task)
+
+	return s.findTask(id)
+}
+
+func (s *Server) GetTask(ctx context.Context, request *pb.GetTaskRequest) (*pb.Task, error) {
+	id, err := parseID(request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.findTask(id)
+}
+
+func (s *Server) ListTasks(ctx context.Context, request *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	labelIDs := make([]uuid.UUID, 0, len(request.GetLabelIds()))
+	for _, labelID := range request.GetLabelIds() {
+		id, err := parseID(labelID)
+		if err != nil {
+			return nil, err
+		}
+		labelIDs = append(labelIDs, id)
+	}
+
+	taskList, err := s.taskList.Run(tasks.ListFilter{
+		Overdue:    request.GetOverdue(),
+		AssigneeID: uint(request.GetAssigneeId()),
+		LabelIDs:   labelIDs,
+		Archived:   request.GetArchived(),
+	})
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.ListTasksResponse{Tasks: lo.Map(taskList, func(t tasks.Task, _ int) *pb.Task {
+		return mapTaskToPB(t)
+	})}, nil
+}
+
+func (s *Server) UpdateTask(ctx context.Context, request *pb.UpdateTaskRequest) (*pb.Task, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userID == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "%s metadata is required", userIDKey)
+	}
+
+	id, err := parseID(request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	mask := request.GetUpdateMask().GetPaths()
+	op, err := s.taskUpdate.Run(tasks.Change{
+		ID:         id,
+		Title:      request.GetTitle(),
+		Completed:  request.GetCompleted(),
+		Cost:       masked(mask, "cost", request.GetCost() != nil, moneyFromPB(request.GetCost())),
+		Budget:     masked(mask, "budget", request.GetBudget() != nil, moneyFromPB(request.GetBudget())),
+		DueAt:      masked(mask, "due_at", request.GetDueAt() != nil, timeFromPB(request.GetDueAt())),
+		Recurrence: masked(mask, "recurrence", request.GetRecurrence() != nil, recurrenceFromPB(request.GetRecurrence())),
+	}, userID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	s.taskUndoStack.Push(userID, op)
+	go s.websocketServer.BroadcastTaskUpdated(op)
+
+	return s.findTask(id)
+}
+
+func (s *Server) DeleteTask(ctx context.Context, request *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userID == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "%s metadata is required", userIDKey)
+	}
+
+	id, err := parseID(request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	task, deletedIDs, err := s.taskDelete.Run(id, userID)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	s.taskUndoStack.Push(userID, tasks.Operation{Kind: tasks.OperationDelete, Before: task})
+	s.websocketServer.BroadcastTaskDeleted(task, deletedIDs)
+
+	return &pb.DeleteTaskResponse{Ids: lo.Map(deletedIDs, func(id uuid.UUID, _ int) string { return id.String() })}, nil
+}
+
+func (s *Server) findTask(id uuid.UUID) (*pb.Task, error) {
+	task, err := s.taskFind.Run(id)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return mapTaskToPB(task), nil
+}
+
+// masked returns the value to change a field to, nil to keep the field as it
+// is. Without a mask the fields that are set are changed, with one the fields
+// in it are.
+func masked[T any](mask []string, field string, set bool, value T) *T {
+	if len(mask) == 0 && !set || len(mask) > 0 && !slices.Contains(mask, field) {
+		return nil
+	}
+
+	return &value
+}
+
+func parseID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid id %q", id)
+	}
+
+	return parsed, nil
+}
+
+func mapTaskToPB(t tasks.Task) *pb.Task {
+	task := &pb.Task{
+		Id:            t.ID.String(),
+		Title:         t.Title,
+		CreatedBy:     uint64(t.CreatedBy),
+		Completed:     t.Completed,
+		Cost:          moneyToPB(t.Cost),
+		DueAt:         timeToPB(t.DueAt),
+		Recurrence:    recurrenceToPB(t.Recurrence),
+		Assignees:     lo.Map(t.Assignees, func(id uint, _ int) uint64 { return uint64(id) }),
+		Labels:        lo.Map(t.Labels, func(id uuid.UUID, _ int) string { return id.String() }),
+		BlockedBy:     lo.Map(t.BlockedBy, func(id uuid.UUID, _ int) string { return id.String() }),
+		Blocked:       t.Blocked,
+		LoggedSeconds: int64(t.Logged / time.Second),
+		ArchivedAt:    timeToPB(t.ArchivedAt),
+		Position:      t.Position,
+	}
+
+	if t.ParentID != uuid.Nil {
+		task.ParentId = t.ParentID.String()
+	}
+
+	if t.HasBudget() {
+		task.Budget = moneyToPB(t.Budget)
+	}
+
+	return task
+}
+
+func moneyFromPB(m *pb.Money) money.Money {
+	if m == nil {
+		return money.Money{}
+	}
+
+	return money.New(m.GetAmount(), m.GetCurrency())
+}
+
+func moneyToPB(m money.Money) *pb.Money {
+	return &pb.Money{Amount: m.Amount, Currency: m.Currency}
+}
+
+func recurrenceFromPB(recurrence *pb.Recurrence) tasks.Recurrence {
+	if recurrence == nil {
+		return tasks.Recurrence{}
+	}
+
+	return tasks.Recurrence{
+		Rule:        recurrence.GetRule(),
+		Timezone:    recurrence.GetTimezone(),
+		CopySubtree: recurrence.GetCopySubtree(),
+	}
+}
+
+func recurrenceToPB(recurrence tasks.Recurrence) *pb.Recurrence {
+	if recurrence.IsZero() {
+		return nil
+	}
+
+	return &pb.Recurrence{
+		Rule:        recurrence.Rule,
+		Timezone:    recurrence.Timezone,
+		CopySubtree: recurrence.CopySubtree,
+	}
+}
+
+func timeFromPB(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return t.AsTime()
+}
+
+func timeToPB(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
@@ -0,0 +1,199 @@
+// Package smtpd receives emails and turns them into tasks, so they can be
+// forwarded to the board.
+package smtpd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/emersion/go-smtp"
+	"github.com/zemzale/ubiquitest/domain/inbox"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/ws"
+)
+
+const timeout = time.Minute
+
+var (
+	errUnknownRecipient = &smtp.SMTPError{
+		Code:         550,
+		EnhancedCode: smtp.EnhancedCode{5, 1, 1},
+		Message:      "No such user or board here",
+	}
+	errNoRecipients = &smtp.SMTPError{
+		Code:         554,
+		EnhancedCode: smtp.EnhancedCode{5, 5, 1},
+		Message:      "No valid recipients",
+	}
+	errTemporary = &smtp.SMTPError{
+		Code:         451,
+		EnhancedCode: smtp.EnhancedCode{4, 3, 0},
+		Message:      "Temporary failure, try again later",
+	}
+	// errPartial is permanent, so the sender doesn't retry and create the
+	// tasks that were already created again.
+	errPartial = &smtp.SMTPError{
+		Code:         554,
+		EnhancedCode: smtp.EnhancedCode{5, 3, 0},
+		Message:      "Only some of the tasks were created, don't send the email again",
+	}
+)
+
+// Server is the SMTP listener, it only listens when it has an address.
+type Server struct {
+	server *smtp.Server
+}
+
+func NewServer(
+	addr string,
+	domain string,
+	maxMessageSize int64,
+	resolve *inbox.Resolve,
+	create *inbox.Create,
+	undoStack *tasks.UndoStack,
+	websocketServer *ws.Server,
+) *Server {
+	if addr == "" {
+		return &Server{}
+	}
+
+	server := smtp.NewServer(&backend{
+		resolve:         resolve,
+		create:          create,
+		undoStack:       undoStack,
+		websocketServer: websocketServer,
+	})
+	server.Addr = addr
+	server.Domain = domain
+	server.MaxMessageBytes = maxMessageSize
+	server.MaxRecipients = 10
+	server.ReadTimeout = timeout
+	server.WriteTimeout = timeout
+
+	return &Server{server: server}
+}
+
+// Run blocks until the context is cancelled.
+func (s *Server) Run(ctx context.Context) error {
+	if s.server == nil {
+		return nil
+	}
+
+	go func() {
+		<-ctx.Done()
+		if err := s.server.Close(); err != nil && !errors.Is(err, smtp.ErrServerClosed) {
+			log.Println("failed to close smtp server ", err)
+		}
+	}()
+
+	log.Printf("receiving emails for %s on %s\n", s.server.Domain, s.server.Addr)
+	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, smtp.ErrServerClosed) {
+		return fmt.Errorf("failed to serve smtp: %w", err)
+	}
+
+	return nil
+}
+
+type backend struct {
+	resolve         *inbox.Resolve
+	create          *inbox.Create
+	undoStack       *tasks.UndoStack
+	websocketServer *ws.Server
+}
+
+func (b *backend) NewSession(c *smtp.Conn) (smtp.Session, error) {
+	return &session{backend: b}, nil
+}
+
+// session is a connection, which can send several emails one after another.
+type session struct {
+	backend    *backend
+	recipients []inbox.Recipient
+}
+
+func (s *session) Reset() {
+	s.recipients = nil
+}
+
+func (s *session) Logout() error {
+	return nil
+}
+
+// Mail accepts any sender, only those who know the address of a user, with
+// its secret token, can send to it.
+func (s *session) Mail(from string, opts *smtp.MailOptions) error {
+	return nil
+}
+
+func (s *session) Rcpt(to string, opts *smtp.RcptOptions) error {
+	recipient, err := s.backend.resolve.Run(to)
+	if errors.Is(err, inbox.ErrUnknownRecipient) {
+		return errUnknownRecipient
+	}
+	if err != nil {
+		log.Println("failed to resolve email recipient ", err)
+		return errTemporary
+	}
+
+	if !slices.Contains(s.recipients, recipient) {
+		s.recipients = append(s.recipients, recipient)
+	}
+
+	return nil
+}
+
+// Data creates the tasks for every recipient. It fails if any of them can't
+// be created, the ones that were are kept. The failure is only temporary when
+// nothing was created yet, so a retry doesn't create the same tasks twice.
+func (s *session) Data(r io.Reader) error {
+	if len(s.recipients) == 0 {
+		return errNoRecipients
+	}
+
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	createdAny := false
+	for _, recipient := range s.recipients {
+		created, err := s.backend.create.Run(context.Background(), recipient, raw)
+		s.backend.broadcastCreated(recipient, created)
+		createdAny = createdAny || len(created) > 0
+		if errors.Is(err, inbox.ErrInvalidMessage) {
+			return &smtp.SMTPError{Code: 554, EnhancedCode: smtp.EnhancedCode{5, 6, 0}, Message: err.Error()}
+		}
+		if err != nil {
+			log.Println("failed to create tasks from email ", err)
+			if createdAny {
+				return errPartial
+			}
+
+			return errTemporary
+		}
+
+		log.Printf("created %d tasks from an email to %s\n", len(created), recipient.User.Username)
+	}
+
+	return nil
+}
+
+// broadcastCreated sends the tasks to everyone and lets the recipient undo
+// them, undoing the first one takes its subtasks with it.
+func (b *backend) broadcastCreated(recipient inbox.Recipient, created []tasks.Task) {
+	if len(created) == 0 {
+		return
+	}
+
+	b.undoStack.Push(recipient.User.ID, tasks.Operation{Kind: tasks.OperationCreate, After: created[0]})
+	go func() {
+		for _, task := range created {
+			b.websocketServer.BroadcastTaskCreated(task)
+		}
+	}()
+}
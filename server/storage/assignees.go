@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Assignee struct {
+	TaskID     string    `db:"task_id"`
+	UserID     uint      `db:"user_id"`
+	AssignedBy uint      `db:"assigned_by"`
+	AssignedAt time.Time `db:"assigned_at"`
+}
+
+type AssigneeRepository struct {
+	db *sqlx.DB
+}
+
+func NewAssigneeRepository(db *sqlx.DB) *AssigneeRepository {
+	return &AssigneeRepository{db: db}
+}
+
+// Create assigns the user to the task. It returns false if the user was
+// already assigned.
+func (r *AssigneeRepository) Create(assignee Assignee) (bool, error) {
+	query := `INSERT OR IGNORE INTO task_assignees
+		(task_id, user_id, assigned_by, assigned_at)
+	VALUES
+		(:task_id, :user_id, :assigned_by, :assigned_at)`
+	result, err := r.db.NamedExec(query, assignee)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert assignee: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return res > 0, nil
+}
+
+// Delete unassigns the user from the task. It returns false if the user
+// wasn't assigned.
+func (r *AssigneeRepository) Delete(taskID string, userID uint) (bool, error) {
+	result, err := r.db.Exec("DELETE FROM task_assignees WHERE task_id = ? AND user_id = ?", taskID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete assignee: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return res > 0, nil
+}
+
+func (r *AssigneeRepository) ListByTask(taskID string) ([]uint, error) {
+	userIDs := make([]uint, 0)
+	err := r.db.Select(&userIDs, "SELECT user_id FROM task_assignees WHERE task_id = ? ORDER BY assigned_at, user_id", taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assignees: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// ListAll returns the assignees of every task, grouped by the task ID.
+func (r *AssigneeRepository) ListAll() (map[string][]uint, error) {
+	assignees := make([]Assignee, 0)
+	err := r.db.Select(&assignees, "SELECT * FROM task_assignees ORDER BY assigned_at, user_id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assignees: %w", err)
+	}
+
+	byTask := make(map[string][]uint)
+	for _, assignee := range assignees {
+		byTask[assignee.TaskID] = append(byTask[assignee.TaskID], assignee.UserID)
+	}
+
+	return byTask, nil
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Attachment struct {
+	ID          string    `db:"id"`
+	TaskID      string    `db:"task_id"`
+	Filename    string    `db:"filename"`
+	ContentType string    `db:"content_type"`
+	Size        int64     `db:"size"`
+	UploadedBy  uint      `db:"uploaded_by"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type AttachmentRepository struct {
+	db *sqlx.DB
+}
+
+func NewAttachmentRepository(db *sqlx.DB) *AttachmentRepository {
+	return &AttachmentRepository{db: db}
+}
+
+func (r *AttachmentRepository) Create(attachment Attachment) error {
+	query := `INSERT INTO attachments
+		(id, task_id, filename, content_type, size, uploaded_by, created_at)
+	VALUES
+		(:id, :task_id, :filename, :content_type, :size, :uploaded_by, :created_at)`
+	if _, err := r.db.NamedExec(query, attachment); err != nil {
+		return fmt.Errorf("failed to insert attachment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AttachmentRepository) Delete(id string) error {
+	if _, err := r.db.Exec("DELETE FROM attachments WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AttachmentRepository) Find(id string) (Attachment, error) {
+	var attachment Attachment
+	if err := r.db.Get(&attachment, "SELECT * FROM attachments WHERE id = ?", id); err != nil {
+		return Attachment{}, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// ListAllByTask returns the attachments of every task keyed by the task id.
+func (r *AttachmentRepository) ListAllByTask() (map[string][]Attachment, error) {
+	attachments := make([]Attachment, 0)
+	if err := r.db.Select(&attachments, "SELECT * FROM attachments ORDER BY created_at, id"); err != nil {
+		return nil, fmt.Errorf("failed to query attachments: %w", err)
+	}
+
+	byTask := make(map[string][]Attachment)
+	for _, attachment := range attachments {
+		byTask[attachment.TaskID] = append(byTask[attachment.TaskID], attachment)
+	}
+
+	return byTask, nil
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// CalendarToken is the secret in the URL of the calendar feed of a user, a
+// user has at most one.
+type CalendarToken struct {
+	UserID    uint      `db:"user_id"`
+	Token     string    `db:"token"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type CalendarTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewCalendarTokenRepository(db *sqlx.DB) *CalendarTokenRepository {
+	return &CalendarTokenRepository{db: db}
+}
+
+// Save sets the token of the user, replacing the one they had.
+func (r *CalendarTokenRepository) Save(token CalendarToken) error {
+	query := `INSERT INTO calendar_tokens
+		(user_id, token, created_at)
+	VALUES
+		(:user_id, :token, :created_at)
+	ON CONFLICT (user_id) DO UPDATE SET token = excluded.token, created_at = excluded.created_at`
+	if _, err := r.db.NamedExec(query, token); err != nil {
+		return fmt.Errorf("failed to save calendar token: %w", err)
+	}
+
+	return nil
+}
+
+// FindByUser returns the token of the user, sql.ErrNoRows is wrapped in the
+// error when they have none.
+func (r *CalendarTokenRepository) FindByUser(userID uint) (CalendarToken, error) {
+	var token CalendarToken
+	if err := r.db.Get(&token, "SELECT * FROM calendar_tokens WHERE user_id = ?", userID); err != nil {
+		return CalendarToken{}, fmt.Errorf("failed to get calendar token: %w", err)
+	}
+
+	return token, nil
+}
+
+// FindByToken returns the token with the secret, sql.ErrNoRows is wrapped in
+// the error when there is none.
+func (r *CalendarTokenRepository) FindByToken(secret string) (CalendarToken, error) {
+	var token CalendarToken
+	if err := r.db.Get(&token, "SELECT * FROM calendar_tokens WHERE token = ?", secret); err != nil {
+		return CalendarToken{}, fmt.Errorf("failed to get calendar token: %w", err)
+	}
+
+	return token, nil
+}
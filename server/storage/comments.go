@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Comment struct {
+	ID        string    `db:"id"`
+	TaskID    string    `db:"task_id"`
+	AuthorID  uint      `db:"author_id"`
+	Body      string    `db:"body"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type CommentRepository struct {
+	db *sqlx.DB
+}
+
+func NewCommentRepository(db *sqlx.DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+func (r *CommentRepository) Create(comment Comment) error {
+	query := `INSERT INTO comments
+		(id, task_id, author_id, body, created_at, updated_at)
+	VALUES
+		(:id, :task_id, :author_id, :body, :created_at, :updated_at)`
+	if _, err := r.db.NamedExec(query, comment); err != nil {
+		return fmt.Errorf("failed to insert comment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CommentRepository) Update(comment Comment) error {
+	result, err := r.db.NamedExec(`UPDATE comments SET body = :body, updated_at = :updated_at WHERE id = :id`, comment)
+	if err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if res == 0 {
+		return fmt.Errorf("no rows affected")
+	}
+
+	return nil
+}
+
+func (r *CommentRepository) Delete(id string) error {
+	if _, err := r.db.Exec("DELETE FROM comments WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CommentRepository) Find(id string) (Comment, error) {
+	var comment Comment
+	if err := r.db.Get(&comment, "SELECT * FROM comments WHERE id = ?", id); err != nil {
+		return Comment{}, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+func (r *CommentRepository) ListByTask(taskID string) ([]Comment, error) {
+	comments := make([]Comment, 0)
+	err := r.db.Select(&comments, "SELECT * FROM comments WHERE task_id = ? ORDER BY created_at, id", taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query comments: %w", err)
+	}
+
+	return comments, nil
+}
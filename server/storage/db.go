@@ -37,5 +37,268 @@ func CreateDB(db *sqlx.DB) error {
 		return fmt.Errorf("failed to create users table: %w", err)
 	}
 
+	// Columns added after the first deploy have to be added with ALTER TABLE,
+	// since CREATE TABLE IF NOT EXISTS won't touch an existing table.
+	if err := addColumn(db, "tasks", "due_at", "DATETIME NULL"); err != nil {
+		return err
+	}
+
+	if err := addColumn(db, "tasks", "recurrence_rule", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+
+	if err := addColumn(db, "tasks", "recurrence_timezone", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+
+	if err := addColumn(db, "tasks", "recurrence_copy_subtree", "BOOLEAN NOT NULL DEFAULT false"); err != nil {
+		return err
+	}
+
+	if err := addColumn(db, "tasks", "deleted_at", "DATETIME NULL"); err != nil {
+		return err
+	}
+
+	if err := addColumn(db, "tasks", "completed_at", "DATETIME NULL"); err != nil {
+		return err
+	}
+
+	if err := addColumn(db, "tasks", "archived_at", "DATETIME NULL"); err != nil {
+		return err
+	}
+
+	if err := addColumn(db, "tasks", "position", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+
+	// Tasks without a currency are from before costs had one, see
+	// MigrateCosts.
+	if err := addColumn(db, "tasks", "currency", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+
+	if err := addColumn(db, "tasks", "budget", "INTEGER NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	if err := addColumn(db, "tasks", "total_logged", "INTEGER NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS task_reminders (
+			task_id TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			lead_seconds INTEGER NOT NULL,
+			due_at DATETIME NOT NULL,
+			sent_at DATETIME NOT NULL,
+			PRIMARY KEY (task_id, kind, lead_seconds, due_at)
+		);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create task_reminders table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS task_assignees (
+			task_id TEXT NOT NULL,
+			user_id INTEGER NOT NULL,
+			assigned_by INTEGER NOT NULL,
+			assigned_at DATETIME NOT NULL,
+			PRIMARY KEY (task_id, user_id)
+		);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create task_assignees table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS labels (
+			id TEXT PRIMARY KEY,
+			board_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			color TEXT NOT NULL,
+			UNIQUE (board_id, name)
+		);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create labels table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS task_labels (
+			task_id TEXT NOT NULL,
+			label_id TEXT NOT NULL,
+			PRIMARY KEY (task_id, label_id)
+		);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create task_labels table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS comments (
+			id TEXT PRIMARY KEY,
+			task_id TEXT NOT NULL,
+			author_id INTEGER NOT NULL,
+			body TEXT NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS comments_task_id ON comments (task_id);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create comments table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS attachments (
+			id TEXT PRIMARY KEY,
+			task_id TEXT NOT NULL,
+			filename TEXT NOT NULL,
+			content_type TEXT NOT NULL,
+			size INTEGER NOT NULL,
+			uploaded_by INTEGER NOT NULL,
+			created_at DATETIME NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS attachments_task_id ON attachments (task_id);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create attachments table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS task_history (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			task_id TEXT NOT NULL,
+			actor_id INTEGER NOT NULL,
+			field TEXT NOT NULL,
+			old_value TEXT NOT NULL,
+			new_value TEXT NOT NULL,
+			created_at DATETIME NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS task_history_task_id ON task_history (task_id);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create task_history table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS task_dependencies (
+			task_id TEXT NOT NULL,
+			blocked_by TEXT NOT NULL,
+			PRIMARY KEY (task_id, blocked_by)
+		);
+		CREATE INDEX IF NOT EXISTS task_dependencies_blocked_by ON task_dependencies (blocked_by);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create task_dependencies table: %w", err)
+	}
+
+	// Every user can have only one running timer, which is the entry that
+	// hasn't ended yet.
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS time_entries (
+			id TEXT PRIMARY KEY,
+			task_id TEXT NOT NULL,
+			user_id INTEGER NOT NULL,
+			started_at DATETIME NOT NULL,
+			ended_at DATETIME NULL,
+			duration INTEGER NOT NULL DEFAULT 0,
+			note TEXT NOT NULL DEFAULT ''
+		);
+		CREATE INDEX IF NOT EXISTS time_entries_task_id ON time_entries (task_id);
+		CREATE UNIQUE INDEX IF NOT EXISTS time_entries_running ON time_entries (user_id) WHERE ended_at IS NULL;
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create time_entries table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS calendar_tokens (
+			user_id INTEGER PRIMARY KEY,
+			token TEXT NOT NULL UNIQUE,
+			created_at DATETIME NOT NULL
+		);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create calendar_tokens table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS inbox_tokens (
+			user_id INTEGER PRIMARY KEY,
+			token TEXT NOT NULL UNIQUE,
+			created_at DATETIME NOT NULL
+		);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create inbox_tokens table: %w", err)
+	}
+
+	// The deliveries are the queue of events to post as well as the log of
+	// what was posted, event_types is a comma separated list.
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS webhooks (
+			id TEXT PRIMARY KEY,
+			url TEXT NOT NULL,
+			event_types TEXT NOT NULL DEFAULT '',
+			secret TEXT NOT NULL,
+			created_by INTEGER NOT NULL,
+			created_at DATETIME NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS webhook_deliveries (
+			id TEXT PRIMARY KEY,
+			webhook_id TEXT NOT NULL,
+			event_type TEXT NOT NULL,
+			payload TEXT NOT NULL,
+			status TEXT NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at DATETIME NULL,
+			response_status INTEGER NULL,
+			error TEXT NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL,
+			delivered_at DATETIME NULL
+		);
+		CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id ON webhook_deliveries (webhook_id);
+		CREATE INDEX IF NOT EXISTS webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create webhooks tables: %w", err)
+	}
+
+	return nil
+}
+
+// MigrateCosts moves the costs of tasks from before costs had a currency, which
+// were whole numbers, to the minor units of the given currency.
+func MigrateCosts(db *sqlx.DB, currency string, minorUnits int64) error {
+	_, err := db.Exec(
+		"UPDATE tasks SET currency = ?, cost = cost * ?, total_cost = total_cost * ? WHERE currency = ''",
+		currency, minorUnits, minorUnits,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to migrate costs: %w", err)
+	}
+
+	return nil
+}
+
+func addColumn(db *sqlx.DB, table, column, definition string) error {
+	var count int
+	err := db.Get(&count, "SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?", table, column)
+	if err != nil {
+		return fmt.Errorf("failed to check if column %s.%s exists: %w", table, column, err)
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	if err != nil {
+		return fmt.Errorf("failed to add column %s.%s: %w", table, column, err)
+	}
+
 	return nil
 }
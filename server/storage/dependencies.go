@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// DependencyRepository keeps which tasks block which. A task can only be
+// completed once all of the tasks blocking it are.
+type DependencyRepository struct {
+	db *sqlx.DB
+}
+
+func NewDependencyRepository(db *sqlx.DB) *DependencyRepository {
+	return &DependencyRepository{db: db}
+}
+
+// Add makes blockedBy block the task. It returns false if it already did.
+func (r *DependencyRepository) Add(taskID string, blockedBy string) (bool, error) {
+	result, err := r.db.Exec("INSERT OR IGNORE INTO task_dependencies (task_id, blocked_by) VALUES (?, ?)", taskID, blockedBy)
+	if err != nil {
+		return false, fmt.Errorf("failed to add dependency: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return res > 0, nil
+}
+
+// Remove stops blockedBy from blocking the task. It returns false if it
+// didn't block it.
+func (r *DependencyRepository) Remove(taskID string, blockedBy string) (bool, error) {
+	result, err := r.db.Exec("DELETE FROM task_dependencies WHERE task_id = ? AND blocked_by = ?", taskID, blockedBy)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove dependency: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return res > 0, nil
+}
+
+// ListByTask returns the IDs of the tasks blocking the task.
+func (r *DependencyRepository) ListByTask(taskID string) ([]string, error) {
+	blockedBy := make([]string, 0)
+	query := "SELECT blocked_by FROM task_dependencies WHERE task_id = ? ORDER BY blocked_by"
+	if err := r.db.Select(&blockedBy, query, taskID); err != nil {
+		return nil, fmt.Errorf("failed to query task dependencies: %w", err)
+	}
+
+	return blockedBy, nil
+}
+
+// ListIncompleteBlockers returns the IDs of the tasks blocking the task that
+// are not completed yet. Blockers in the trash don't block anything.
+func (r *DependencyRepository) ListIncompleteBlockers(taskID string) ([]string, error) {
+	blockedBy := make([]string, 0)
+	query := `
+		SELECT task_dependencies.blocked_by
+		FROM task_dependencies
+		JOIN tasks ON tasks.id = task_dependencies.blocked_by
+		WHERE task_dependencies.task_id = ? AND tasks.completed = false AND tasks.deleted_at IS NULL
+		ORDER BY task_dependencies.blocked_by
+	`
+	if err := r.db.Select(&blockedBy, query, taskID); err != nil {
+		return nil, fmt.Errorf("failed to query task blockers: %w", err)
+	}
+
+	return blockedBy, nil
+}
+
+// ListAllByTask returns the IDs of the tasks blocking every task, grouped by
+// the task ID.
+func (r *DependencyRepository) ListAllByTask() (map[string][]string, error) {
+	rows := make([]struct {
+		TaskID    string `db:"task_id"`
+		BlockedBy string `db:"blocked_by"`
+	}, 0)
+	query := "SELECT task_id, blocked_by FROM task_dependencies ORDER BY task_id, blocked_by"
+	if err := r.db.Select(&rows, query); err != nil {
+		return nil, fmt.Errorf("failed to query task dependencies: %w", err)
+	}
+
+	byTask := make(map[string][]string)
+	for _, row := range rows {
+		byTask[row.TaskID] = append(byTask[row.TaskID], row.BlockedBy)
+	}
+
+	return byTask, nil
+}
+
+// ListDependents returns the IDs of the tasks the task blocks.
+func (r *DependencyRepository) ListDependents(blockedBy string) ([]string, error) {
+	taskIDs := make([]string, 0)
+	query := "SELECT task_id FROM task_dependencies WHERE blocked_by = ? ORDER BY task_id"
+	if err := r.db.Select(&taskIDs, query, blockedBy); err != nil {
+		return nil, fmt.Errorf("failed to query dependent tasks: %w", err)
+	}
+
+	return taskIDs, nil
+}
+
+// ListBlocked returns the IDs of the tasks that have at least one incomplete
+// blocker.
+func (r *DependencyRepository) ListBlocked() ([]string, error) {
+	taskIDs := make([]string, 0)
+	query := `
+		SELECT DISTINCT task_dependencies.task_id
+		FROM task_dependencies
+		JOIN tasks ON tasks.id = task_dependencies.blocked_by
+		WHERE tasks.completed = false AND tasks.deleted_at IS NULL
+	`
+	if err := r.db.Select(&taskIDs, query); err != nil {
+		return nil, fmt.Errorf("failed to query blocked tasks: %w", err)
+	}
+
+	return taskIDs, nil
+}
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type HistoryEntry struct {
+	ID        int64     `db:"id"`
+	TaskID    string    `db:"task_id"`
+	ActorID   uint      `db:"actor_id"`
+	Field     string    `db:"field"`
+	OldValue  string    `db:"old_value"`
+	NewValue  string    `db:"new_value"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// HistoryRepository is append only, entries are never changed or removed.
+type HistoryRepository struct {
+	db *sqlx.DB
+}
+
+func NewHistoryRepository(db *sqlx.DB) *HistoryRepository {
+	return &HistoryRepository{db: db}
+}
+
+func (r *HistoryRepository) Create(entries []HistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO task_history
+		(task_id, actor_id, field, old_value, new_value, created_at)
+	VALUES
+		(:task_id, :actor_id, :field, :old_value, :new_value, :created_at)`
+	if _, err := r.db.NamedExec(query, entries); err != nil {
+		return fmt.Errorf("failed to insert history: %w", err)
+	}
+
+	return nil
+}
+
+// ListByTask returns the history of the task, oldest first.
+func (r *HistoryRepository) ListByTask(taskID string) ([]HistoryEntry, error) {
+	entries := make([]HistoryEntry, 0)
+	if err := r.db.Select(&entries, "SELECT * FROM task_history WHERE task_id = ? ORDER BY id", taskID); err != nil {
+		return nil, fmt.Errorf("failed to query history: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ListRecent returns up to limit entries of all tasks, newest first. When
+// beforeID is set only the entries older than it are returned, so the caller
+// can page through the whole history.
+func (r *HistoryRepository) ListRecent(limit int, beforeID int64) ([]HistoryEntry, error) {
+	query := "SELECT * FROM task_history"
+	args := []any{}
+	if beforeID > 0 {
+		query += " WHERE id < ?"
+		args = append(args, beforeID)
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, limit)
+
+	entries := make([]HistoryEntry, 0)
+	if err := r.db.Select(&entries, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to query history: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ListByFields returns the entries of all tasks that changed one of the
+// fields, oldest first.
+func (r *HistoryRepository) ListByFields(fields ...string) ([]HistoryEntry, error) {
+	entries := make([]HistoryEntry, 0)
+	if len(fields) == 0 {
+		return entries, nil
+	}
+
+	query, args, err := sqlx.In("SELECT * FROM task_history WHERE field IN (?) ORDER BY id", fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build history query: %w", err)
+	}
+
+	if err := r.db.Select(&entries, r.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("failed to query history: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// InboxToken is the secret in the email address of a user, a user has at
+// most one.
+type InboxToken struct {
+	UserID    uint      `db:"user_id"`
+	Token     string    `db:"token"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type InboxTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewInboxTokenRepository(db *sqlx.DB) *InboxTokenRepository {
+	return &InboxTokenRepository{db: db}
+}
+
+// Save sets the token of the user, replacing the one they had.
+func (r *InboxTokenRepository) Save(token InboxToken) error {
+	query := `INSERT INTO inbox_tokens
+		(user_id, token, created_at)
+	VALUES
+		(:user_id, :token, :created_at)
+	ON CONFLICT (user_id) DO UPDATE SET token = excluded.token, created_at = excluded.created_at`
+	if _, err := r.db.NamedExec(query, token); err != nil {
+		return fmt.Errorf("failed to save inbox token: %w", err)
+	}
+
+	return nil
+}
+
+// FindByUser returns the token of the user, sql.ErrNoRows is wrapped in the
+// error when they have none.
+func (r *InboxTokenRepository) FindByUser(userID uint) (InboxToken, error) {
+	var token InboxToken
+	if err := r.db.Get(&token, "SELECT * FROM inbox_tokens WHERE user_id = ?", userID); err != nil {
+		return InboxToken{}, fmt.Errorf("failed to get inbox token: %w", err)
+	}
+
+	return token, nil
+}
+
+// FindByToken returns the token with the secret, sql.ErrNoRows is wrapped in
+// the error when there is none.
+func (r *InboxTokenRepository) FindByToken(secret string) (InboxToken, error) {
+	var token InboxToken
+	if err := r.db.Get(&token, "SELECT * FROM inbox_tokens WHERE token = ?", secret); err != nil {
+		return InboxToken{}, fmt.Errorf("failed to get inbox token: %w", err)
+	}
+
+	return token, nil
+}
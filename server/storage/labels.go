@@ -0,0 +1,197 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Label struct {
+	ID      string `db:"id"`
+	BoardID string `db:"board_id"`
+	Name    string `db:"name"`
+	Color   string `db:"color"`
+}
+
+type LabelRepository struct {
+	db *sqlx.DB
+}
+
+func NewLabelRepository(db *sqlx.DB) *LabelRepository {
+	return &LabelRepository{db: db}
+}
+
+func (r *LabelRepository) Create(label Label) error {
+	_, err := r.db.NamedExec(`INSERT INTO labels (id, board_id, name, color) VALUES (:id, :board_id, :name, :color)`, label)
+	if err != nil {
+		return fmt.Errorf("failed to insert label: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LabelRepository) Update(label Label) error {
+	result, err := r.db.NamedExec(`UPDATE labels SET name = :name, color = :color WHERE id = :id`, label)
+	if err != nil {
+		return fmt.Errorf("failed to update label: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if res == 0 {
+		return fmt.Errorf("no rows affected")
+	}
+
+	return nil
+}
+
+// Delete removes the label and detaches it from all the tasks.
+func (r *LabelRepository) Delete(id string) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM task_labels WHERE label_id = ?", id); err != nil {
+		return fmt.Errorf("failed to detach label: %w", err)
+	}
+
+	result, err := tx.Exec("DELETE FROM labels WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if res == 0 {
+		return fmt.Errorf("no rows affected")
+	}
+
+	return tx.Commit()
+}
+
+func (r *LabelRepository) Find(id string) (Label, error) {
+	var label Label
+	if err := r.db.Get(&label, "SELECT * FROM labels WHERE id = ?", id); err != nil {
+		return Label{}, fmt.Errorf("failed to get label: %w", err)
+	}
+
+	return label, nil
+}
+
+// FindByName returns the label of the board with the given name.
+func (r *LabelRepository) FindByName(boardID string, name string) (Label, error) {
+	var label Label
+	if err := r.db.Get(&label, "SELECT * FROM labels WHERE board_id = ? AND name = ?", boardID, name); err != nil {
+		return Label{}, fmt.Errorf("failed to get label: %w", err)
+	}
+
+	return label, nil
+}
+
+// List returns the labels of the board, or the labels of all the boards when
+// the board ID is empty.
+func (r *LabelRepository) List(boardID string) ([]Label, error) {
+	query := "SELECT * FROM labels"
+	args := []any{}
+	if boardID != "" {
+		query += " WHERE board_id = ?"
+		args = append(args, boardID)
+	}
+
+	labels := make([]Label, 0)
+	if err := r.db.Select(&labels, query+" ORDER BY name", args...); err != nil {
+		return nil, fmt.Errorf("failed to query labels: %w", err)
+	}
+
+	return labels, nil
+}
+
+// Attach adds the label to the task. It returns false if the task already
+// had the label.
+func (r *LabelRepository) Attach(taskID string, labelID string) (bool, error) {
+	result, err := r.db.Exec("INSERT OR IGNORE INTO task_labels (task_id, label_id) VALUES (?, ?)", taskID, labelID)
+	if err != nil {
+		return false, fmt.Errorf("failed to attach label: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return res > 0, nil
+}
+
+// Detach removes the label from the task. It returns false if the task didn't
+// have the label.
+func (r *LabelRepository) Detach(taskID string, labelID string) (bool, error) {
+	result, err := r.db.Exec("DELETE FROM task_labels WHERE task_id = ? AND label_id = ?", taskID, labelID)
+	if err != nil {
+		return false, fmt.Errorf("failed to detach label: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return res > 0, nil
+}
+
+// ListTasks returns the IDs of the tasks that have the label.
+func (r *LabelRepository) ListTasks(labelID string) ([]string, error) {
+	taskIDs := make([]string, 0)
+	if err := r.db.Select(&taskIDs, "SELECT task_id FROM task_labels WHERE label_id = ?", labelID); err != nil {
+		return nil, fmt.Errorf("failed to query label tasks: %w", err)
+	}
+
+	return taskIDs, nil
+}
+
+func (r *LabelRepository) ListByTask(taskID string) ([]string, error) {
+	labelIDs := make([]string, 0)
+	query := `
+		SELECT task_labels.label_id
+		FROM task_labels
+		JOIN labels ON labels.id = task_labels.label_id
+		WHERE task_labels.task_id = ?
+		ORDER BY labels.name
+	`
+	if err := r.db.Select(&labelIDs, query, taskID); err != nil {
+		return nil, fmt.Errorf("failed to query task labels: %w", err)
+	}
+
+	return labelIDs, nil
+}
+
+// ListAllByTask returns the label IDs of every task, grouped by the task ID.
+func (r *LabelRepository) ListAllByTask() (map[string][]string, error) {
+	rows := make([]struct {
+		TaskID  string `db:"task_id"`
+		LabelID string `db:"label_id"`
+	}, 0)
+	query := `
+		SELECT task_labels.task_id, task_labels.label_id
+		FROM task_labels
+		JOIN labels ON labels.id = task_labels.label_id
+		ORDER BY labels.name
+	`
+	if err := r.db.Select(&rows, query); err != nil {
+		return nil, fmt.Errorf("failed to query task labels: %w", err)
+	}
+
+	byTask := make(map[string][]string)
+	for _, row := range rows {
+		byTask[row.TaskID] = append(byTask[row.TaskID], row.LabelID)
+	}
+
+	return byTask, nil
+}
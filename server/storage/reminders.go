@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Reminder struct {
+	TaskID      string    `db:"task_id"`
+	Kind        string    `db:"kind"`
+	LeadSeconds int64     `db:"lead_seconds"`
+	DueAt       time.Time `db:"due_at"`
+	SentAt      time.Time `db:"sent_at"`
+}
+
+type ReminderRepository struct {
+	db *sqlx.DB
+}
+
+func NewReminderRepository(db *sqlx.DB) *ReminderRepository {
+	return &ReminderRepository{db: db}
+}
+
+// ListSent returns the reminders that were already sent for the current due
+// date of every incomplete task. Reminders for an old due date are skipped, so
+// moving the due date makes the reminders fire again.
+func (r *ReminderRepository) ListSent() ([]Reminder, error) {
+	const query = `
+		SELECT task_reminders.*
+		FROM task_reminders
+		JOIN tasks ON tasks.id = task_reminders.task_id AND tasks.due_at = task_reminders.due_at
+		WHERE tasks.completed = false
+	`
+
+	reminders := make([]Reminder, 0)
+	if err := r.db.Select(&reminders, query); err != nil {
+		return nil, fmt.Errorf("failed to query sent reminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+func (r *ReminderRepository) MarkSent(reminder Reminder) error {
+	query := `INSERT OR IGNORE INTO task_reminders
+		(task_id, kind, lead_seconds, due_at, sent_at)
+	VALUES
+		(:task_id, :kind, :lead_seconds, :due_at, :sent_at)`
+	if _, err := r.db.NamedExec(query, reminder); err != nil {
+		return fmt.Errorf("failed to mark reminder as sent: %w", err)
+	}
+
+	return nil
+}
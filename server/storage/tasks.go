@@ -2,9 +2,13 @@ package storage
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
 )
 
 type Task struct {
@@ -14,8 +18,67 @@ type Task struct {
 	Completed   bool             `db:"completed"`
 	CompletedBy sql.Null[uint]   `db:"completed_by"`
 	ParentID    sql.Null[string] `db:"parent_id"`
-	Cost        uint             `db:"cost"`
-	TotalCost   uint             `db:"total_cost"`
+	// Cost, TotalCost and Budget are in the minor units of Currency, Budget
+	// is 0 when the task has none.
+	Cost      int64               `db:"cost"`
+	TotalCost int64               `db:"total_cost"`
+	Budget    int64               `db:"budget"`
+	Currency  string              `db:"currency"`
+	DueAt     sql.Null[time.Time] `db:"due_at"`
+	// TotalLogged is the time logged on the whole subtree in seconds.
+	TotalLogged int64 `db:"total_logged"`
+
+	RecurrenceRule        string `db:"recurrence_rule"`
+	RecurrenceTimezone    string `db:"recurrence_timezone"`
+	RecurrenceCopySubtree bool   `db:"recurrence_copy_subtree"`
+
+	// DeletedAt is set when the task is in the trash.
+	DeletedAt   sql.Null[time.Time] `db:"deleted_at"`
+	CompletedAt sql.Null[time.Time] `db:"completed_at"`
+	ArchivedAt  sql.Null[time.Time] `db:"archived_at"`
+	// Position orders the task among its siblings, compared as a string.
+	Position string `db:"position"`
+}
+
+// TaskFilter narrows down the tasks returned by List. Zero values don't filter
+// anything.
+type TaskFilter struct {
+	// ID keeps only the task with this ID.
+	ID string
+	// OverdueAt keeps only the incomplete tasks that were due before this time.
+	OverdueAt time.Time
+	// AssigneeID keeps only the tasks assigned to this user.
+	AssigneeID uint
+	// LabelIDs keeps only the tasks that have all of these labels.
+	LabelIDs []string
+	// Archived returns only the archived tasks instead of the ones that are
+	// not archived.
+	Archived bool
+}
+
+// ErrTaskChanged is returned by the conditional updates when the task no
+// longer has the expected state.
+var ErrTaskChanged = errors.New("task was changed")
+
+// TaskCondition is the state a task has to still have for a conditional
+// update to be made.
+type TaskCondition struct {
+	ParentID    string
+	Title       string
+	Completed   bool
+	CompletedAt sql.Null[time.Time]
+	DueAt       sql.Null[time.Time]
+}
+
+// where returns the condition to add to the WHERE clause of an update, none
+// when the condition is nil.
+func (c *TaskCondition) where() (string, []any) {
+	if c == nil {
+		return "", nil
+	}
+
+	return " AND parent_id = ? AND title = ? AND completed = ? AND completed_at IS ? AND due_at IS ?",
+		[]any{c.ParentID, c.Title, c.Completed, c.CompletedAt, c.DueAt}
 }
 
 type TaksRepository struct {
@@ -28,9 +91,11 @@ func NewTaskRepository(db *sqlx.DB) *TaksRepository {
 
 func (r *TaksRepository) Create(todo Task) error {
 	query := `INSERT INTO tasks 
-		(id, title, created_by, completed, completed_by, parent_id, cost, total_cost)
+		(id, title, created_by, completed, completed_by, completed_at, parent_id, cost, total_cost, due_at,
+		recurrence_rule, recurrence_timezone, recurrence_copy_subtree, position, currency, budget)
 	VALUES 
-		(:id, :title, :created_by, :completed, :completed_by, :parent_id, :cost, :total_cost)`
+		(:id, :title, :created_by, :completed, :completed_by, :completed_at, :parent_id, :cost, :total_cost, :due_at,
+		:recurrence_rule, :recurrence_timezone, :recurrence_copy_subtree, :position, :currency, :budget)`
 	result, err := r.db.NamedExec(query, todo)
 	if err != nil {
 		return fmt.Errorf("failed to insert task: %w", err)
@@ -48,9 +113,142 @@ func (r *TaksRepository) Create(todo Task) error {
 	return nil
 }
 
+// CreateMany inserts all the tasks in a single transaction, so either all of
+// them are created or none. Unlike Create it also sets when and by whom they
+// were completed and archived. The total costs are added to the tasks they
+// are for, the ancestors of the inserted tasks, in the same transaction.
+func (r *TaksRepository) CreateMany(tasks []Task, totalCosts map[string]int64) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO tasks
+		(id, title, created_by, completed, completed_by, completed_at, archived_at, parent_id, cost, total_cost,
+		due_at, recurrence_rule, recurrence_timezone, recurrence_copy_subtree, position, currency, budget)
+	VALUES
+		(:id, :title, :created_by, :completed, :completed_by, :completed_at, :archived_at, :parent_id, :cost, :total_cost,
+		:due_at, :recurrence_rule, :recurrence_timezone, :recurrence_copy_subtree, :position, :currency, :budget)`
+	for _, task := range tasks {
+		if _, err := tx.NamedExec(query, task); err != nil {
+			return fmt.Errorf("failed to insert task %s: %w", task.ID, err)
+		}
+	}
+
+	for id, cost := range totalCosts {
+		if _, err := tx.Exec(`UPDATE tasks SET total_cost = total_cost + ? WHERE id = ?`, cost, id); err != nil {
+			return fmt.Errorf("failed to update total cost of %s: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Update stores the editable fields of the task. The total costs are added to
+// the tasks they are for, the ancestors of the task, in the same transaction.
+// When the condition is set nothing is stored unless the task meets it, and
+// ErrTaskChanged is returned.
+func (r *TaksRepository) Update(task Task, totalCosts map[string]int64, condition *TaskCondition) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := sqlx.Named(`UPDATE tasks SET
+		title = :title, completed = :completed, completed_by = :completed_by, completed_at = :completed_at,
+		cost = :cost, currency = :currency, total_cost = :total_cost, budget = :budget, due_at = :due_at,
+		recurrence_rule = :recurrence_rule, recurrence_timezone = :recurrence_timezone,
+		recurrence_copy_subtree = :recurrence_copy_subtree
+	WHERE id = :id`, task)
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	where, whereArgs := condition.where()
+	result, err := tx.Exec(query+where, append(args, whereArgs...)...)
+	if err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	if err := checkUpdated(result, condition); err != nil {
+		return err
+	}
+
+	for id, cost := range totalCosts {
+		if _, err := tx.Exec(`UPDATE tasks SET total_cost = total_cost + ? WHERE id = ?`, cost, id); err != nil {
+			return fmt.Errorf("failed to update total cost of %s: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Complete marks the task as completed by the user, keeping the time it was
+// first completed at. When the condition is set nothing is stored unless the
+// task meets it, and ErrTaskChanged is returned.
+func (r *TaksRepository) Complete(id string, title string, userID uint, at time.Time, condition *TaskCondition) error {
+	where, whereArgs := condition.where()
+	result, err := r.db.Exec(
+		"UPDATE tasks SET title = ?, completed = true, completed_by = ?, completed_at = COALESCE(completed_at, ?) WHERE id = ?"+where,
+		append([]any{title, userID, at.UTC(), id}, whereArgs...)...,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	return checkUpdated(result, condition)
+}
+
+func checkUpdated(result sql.Result, condition *TaskCondition) error {
+	res, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if res == 0 && condition != nil {
+		return ErrTaskChanged
+	}
+
+	if res == 0 {
+		return fmt.Errorf("no rows affected")
+	}
+
+	return nil
+}
+
+// ExistingIDs returns which of the IDs are already taken, by tasks in the
+// trash too.
+func (s *TaksRepository) ExistingIDs(ids []string) ([]string, error) {
+	existing := make([]string, 0)
+	if len(ids) == 0 {
+		return existing, nil
+	}
+
+	query, args, err := sqlx.In("SELECT id FROM tasks WHERE id IN (?)", ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if err := s.db.Select(&existing, s.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+
+	return existing, nil
+}
+
 func (s *TaksRepository) CheckIfParentExists(parentID string) error {
 	var id string
-	err := s.db.Get(&id, "SELECT id FROM tasks WHERE id = ?", parentID)
+	err := s.db.Get(&id, "SELECT id FROM tasks WHERE id = ? AND deleted_at IS NULL AND archived_at IS NULL", parentID)
 	if err != nil {
 		return fmt.Errorf("failed to get parent id: %w", err)
 	}
@@ -58,95 +256,127 @@ func (s *TaksRepository) CheckIfParentExists(parentID string) error {
 	return nil
 }
 
-func (s *TaksRepository) List() ([]*Task, error) {
-	const query = `
-		SELECT 
-			tasks.id, 
-			tasks.title, 
-			tasks.created_by, 
-			tasks.parent_id, 
-			tasks.completed, 
-			tasks.cost,
-			tasks.total_cost,
-			users.username 
-		FROM tasks 
-		LEFT JOIN users ON users.id = tasks.created_by
-	`
-	rows, err := s.db.Queryx(query)
-	if err != nil {
+func (s *TaksRepository) List(filter TaskFilter) ([]*Task, error) {
+	conditions := []string{"tasks.deleted_at IS NULL", "tasks.archived_at IS NULL"}
+	if filter.Archived {
+		conditions[1] = "tasks.archived_at IS NOT NULL"
+	}
+	args := make([]any, 0)
+
+	if filter.ID != "" {
+		conditions = append(conditions, "tasks.id = ?")
+		args = append(args, filter.ID)
+	}
+
+	if !filter.OverdueAt.IsZero() {
+		conditions = append(conditions, "tasks.completed = false AND tasks.due_at IS NOT NULL AND tasks.due_at < ?")
+		args = append(args, filter.OverdueAt.UTC())
+	}
+
+	if filter.AssigneeID != 0 {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM task_assignees WHERE task_assignees.task_id = tasks.id AND task_assignees.user_id = ?)")
+		args = append(args, filter.AssigneeID)
+	}
+
+	for _, labelID := range filter.LabelIDs {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM task_labels WHERE task_labels.task_id = tasks.id AND task_labels.label_id = ?)")
+		args = append(args, labelID)
+	}
+
+	// Tasks created before positions existed all have an empty one and keep
+	// the order they were created in.
+	query := "SELECT tasks.* FROM tasks WHERE " + strings.Join(conditions, " AND ") + " ORDER BY tasks.position, tasks.rowid"
+
+	tasks := make([]*Task, 0)
+	if err := s.db.Select(&tasks, query, args...); err != nil {
 		return nil, fmt.Errorf("failed to query tasks: %w", err)
 	}
-	defer rows.Close()
 
+	return tasks, nil
+}
+
+// LastPosition returns the highest position among the children of the parent,
+// or an empty string if it has none.
+func (s *TaksRepository) LastPosition(parentID string) (string, error) {
+	var position string
+	err := s.db.Get(&position, "SELECT COALESCE(MAX(position), '') FROM tasks WHERE parent_id = ?", parentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to query last position: %w", err)
+	}
+
+	return position, nil
+}
+
+// ListSiblings returns all the children of the parent in order, including the
+// ones in the trash or the archive, which keep their positions.
+func (s *TaksRepository) ListSiblings(parentID string) ([]*Task, error) {
 	tasks := make([]*Task, 0)
-	for rows.Next() {
-		var id string
-		var title string
-		var createdBy uint
-		var parentID sql.Null[string]
-		var completed bool
-		var username string
-		var cost uint
-		var totalCost uint
-		if err := rows.Scan(&id, &title, &createdBy, &parentID, &completed, &cost, &totalCost, &username); err != nil {
-			return nil, fmt.Errorf("failed to scan tasks: %w", err)
+	err := s.db.Select(&tasks, "SELECT * FROM tasks WHERE parent_id = ? ORDER BY position, rowid", parentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query siblings: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// UpdatePositions sets the positions of the tasks, keyed by the task ID, in a
+// single transaction.
+func (s *TaksRepository) UpdatePositions(positions map[string]string) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for id, position := range positions {
+		if _, err := tx.Exec("UPDATE tasks SET position = ? WHERE id = ?", position, id); err != nil {
+			return fmt.Errorf("failed to update position: %w", err)
 		}
+	}
 
-		tasks = append(tasks, &Task{
-			ID:        id,
-			Title:     title,
-			CreatedBy: createdBy,
-			Completed: completed,
-			ParentID:  parentID,
-			Cost:      cost,
-			TotalCost: totalCost,
-		})
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return tasks, nil
+	return nil
 }
 
-func (s *TaksRepository) ListChildren(parentID string) ([]*Task, error) {
-	const query = `
-		SELECT 
-			tasks.id, 
-			tasks.title, 
-			tasks.created_by, 
-			tasks.parent_id, 
-			tasks.completed, 
-			tasks.cost,
-			users.username 
-		FROM tasks 
-		LEFT JOIN users ON users.id = tasks.created_by
-		WHERE tasks.parent_id = ?
-	`
-	rows, err := s.db.Queryx(query, parentID)
+// ListWithDueDate returns all incomplete tasks that have a due date set.
+func (s *TaksRepository) ListWithDueDate() ([]*Task, error) {
+	tasks := make([]*Task, 0)
+	err := s.db.Select(&tasks, "SELECT * FROM tasks WHERE completed = false AND due_at IS NOT NULL AND deleted_at IS NULL AND archived_at IS NULL")
 	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks with due date: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// ListNotTrashed returns all the tasks that are not in the trash, archived
+// ones included.
+func (s *TaksRepository) ListNotTrashed() ([]*Task, error) {
+	tasks := make([]*Task, 0)
+	if err := s.db.Select(&tasks, "SELECT * FROM tasks WHERE deleted_at IS NULL"); err != nil {
 		return nil, fmt.Errorf("failed to query tasks: %w", err)
 	}
-	defer rows.Close()
 
+	return tasks, nil
+}
+
+// ListAll returns all the tasks, the ones in the trash included.
+func (s *TaksRepository) ListAll() ([]*Task, error) {
 	tasks := make([]*Task, 0)
-	for rows.Next() {
-		var id string
-		var title string
-		var createdBy uint
-		var parentID sql.Null[string]
-		var completed bool
-		var username string
-		var cost uint
-		if err := rows.Scan(&id, &title, &createdBy, &parentID, &completed, &cost, &username); err != nil {
-			return nil, fmt.Errorf("failed to scan tasks: %w", err)
-		}
+	if err := s.db.Select(&tasks, "SELECT * FROM tasks"); err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+
+	return tasks, nil
+}
 
-		tasks = append(tasks, &Task{
-			ID:        id,
-			Title:     title,
-			CreatedBy: createdBy,
-			Completed: completed,
-			ParentID:  parentID,
-			Cost:      cost,
-		})
+func (s *TaksRepository) ListChildren(parentID string) ([]*Task, error) {
+	tasks := make([]*Task, 0)
+	if err := s.db.Select(&tasks, "SELECT * FROM tasks WHERE parent_id = ? AND deleted_at IS NULL", parentID); err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
 	}
 
 	return tasks, nil
@@ -163,8 +393,264 @@ func (s *TaksRepository) Find(id string) (*Task, error) {
 	return &task, nil
 }
 
-func (s *TaksRepository) UpdateTotalCost(parentID string, cost uint) error {
+// FindRootID returns the ID of the top level task the task is under, or the
+// ID of the task itself when it is a top level task.
+func (s *TaksRepository) FindRootID(id string) (string, error) {
+	query := `
+	WITH RECURSIVE ancestors(id, parent_id) AS (
+		SELECT id, parent_id FROM tasks WHERE id = ?
+		UNION ALL
+		SELECT tasks.id, tasks.parent_id FROM tasks
+		JOIN ancestors ON tasks.id = ancestors.parent_id
+	)
+	SELECT id FROM ancestors
+	WHERE NOT EXISTS (SELECT 1 FROM tasks WHERE tasks.id = ancestors.parent_id)`
+
+	var rootID string
+	if err := s.db.Get(&rootID, query, id); err != nil {
+		return "", fmt.Errorf("failed to find root task: %w", err)
+	}
+
+	return rootID, nil
+}
+
+// UpdateTotalCost adds the cost, which can be negative, to the total cost of
+// the task. The cost has to be in the currency of the task.
+func (s *TaksRepository) UpdateTotalCost(parentID string, cost int64) error {
 	query := `UPDATE tasks SET total_cost = total_cost + ? WHERE id = ?`
 	_, err := s.db.Exec(query, cost, parentID)
 	return err
 }
+
+// AddLoggedTime adds the seconds to the total logged time of the task and its
+// ancestors. It stops at the first ancestor that wasn't deleted together with
+// the task, because the time of a subtree in the trash is already taken out of
+// the ancestors.
+func (s *TaksRepository) AddLoggedTime(id string, seconds int64) error {
+	_, err := s.db.Exec(`
+		WITH RECURSIVE ancestors(id, parent_id, deleted_at) AS (
+			SELECT id, parent_id, deleted_at FROM tasks WHERE id = ?
+			UNION ALL
+			SELECT tasks.id, tasks.parent_id, tasks.deleted_at FROM tasks
+			JOIN ancestors ON tasks.id = ancestors.parent_id
+			WHERE tasks.deleted_at IS ancestors.deleted_at
+		)
+		UPDATE tasks SET total_logged = total_logged + ? WHERE id IN (SELECT id FROM ancestors)`,
+		id, seconds,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update logged time: %w", err)
+	}
+
+	return nil
+}
+
+func (s *TaksRepository) ClearRecurrence(id string) error {
+	query := `UPDATE tasks SET recurrence_rule = '', recurrence_timezone = '', recurrence_copy_subtree = false WHERE id = ?`
+	_, err := s.db.Exec(query, id)
+	return err
+}
+
+// subtreeQuery selects the IDs of the task and all of its descendants that
+// have the same value as the task in all of the given columns. Tasks trashed
+// or archived separately before their ancestor keep their own timestamp, so
+// they are not part of the ancestor's subtree.
+func subtreeQuery(columns ...string) string {
+	conditions := lo.Map(columns, func(column string, _ int) string {
+		return fmt.Sprintf("tasks.%s IS (SELECT %s FROM root)", column, column)
+	})
+
+	return `
+	WITH RECURSIVE root AS (
+		SELECT * FROM tasks WHERE id = ?
+	), subtree(id) AS (
+		SELECT id FROM root
+		UNION ALL
+		SELECT tasks.id FROM tasks
+		JOIN subtree ON tasks.parent_id = subtree.id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+	)
+	SELECT id FROM subtree`
+}
+
+// SoftDeleteSubtree moves the task and its descendants to the trash and
+// returns their IDs.
+func (s *TaksRepository) SoftDeleteSubtree(id string, at time.Time) ([]string, error) {
+	return s.updateSubtree(id, subtreeQuery("deleted_at"), "UPDATE tasks SET deleted_at = ? WHERE id IN (?)", at.UTC())
+}
+
+// RestoreSubtree takes the task and the descendants that were trashed with it
+// out of the trash and returns their IDs.
+func (s *TaksRepository) RestoreSubtree(id string) ([]string, error) {
+	return s.updateSubtree(id, subtreeQuery("deleted_at"), "UPDATE tasks SET deleted_at = NULL WHERE id IN (?)")
+}
+
+// ArchiveSubtree archives the task and its descendants that are not archived
+// or in the trash yet and returns their IDs.
+func (s *TaksRepository) ArchiveSubtree(id string, at time.Time) ([]string, error) {
+	return s.updateSubtree(
+		id,
+		subtreeQuery("deleted_at", "archived_at"),
+		"UPDATE tasks SET archived_at = ? WHERE id IN (?)",
+		at.UTC(),
+	)
+}
+
+// UnarchiveSubtree unarchives the task and the descendants that were archived
+// with it and returns their IDs.
+func (s *TaksRepository) UnarchiveSubtree(id string) ([]string, error) {
+	return s.updateSubtree(
+		id,
+		subtreeQuery("deleted_at", "archived_at"),
+		"UPDATE tasks SET archived_at = NULL WHERE id IN (?)",
+	)
+}
+
+// ListCompletedBefore returns the tasks that are not archived or in the trash
+// and were completed before the given time.
+func (s *TaksRepository) ListCompletedBefore(before time.Time) ([]*Task, error) {
+	tasks := make([]*Task, 0)
+	err := s.db.Select(
+		&tasks,
+		`SELECT * FROM tasks WHERE completed = true AND completed_at IS NOT NULL AND completed_at < ?
+			AND archived_at IS NULL AND deleted_at IS NULL`,
+		before.UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query completed tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// HasIncompleteSubtasks reports whether any of the descendants of the task
+// that are not in the trash or archived is incomplete.
+func (s *TaksRepository) HasIncompleteSubtasks(id string) (bool, error) {
+	query := `
+	WITH RECURSIVE subtree(id, completed) AS (
+		SELECT id, completed FROM tasks
+		WHERE parent_id = ? AND deleted_at IS NULL AND archived_at IS NULL
+		UNION ALL
+		SELECT tasks.id, tasks.completed FROM tasks
+		JOIN subtree ON tasks.parent_id = subtree.id
+		WHERE tasks.deleted_at IS NULL AND tasks.archived_at IS NULL
+	)
+	SELECT EXISTS (SELECT 1 FROM subtree WHERE completed = false)`
+
+	var incomplete bool
+	if err := s.db.Get(&incomplete, query, id); err != nil {
+		return false, fmt.Errorf("failed to query subtasks: %w", err)
+	}
+
+	return incomplete, nil
+}
+
+func (s *TaksRepository) updateSubtree(id string, subtree string, update string, args ...any) ([]string, error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	ids := make([]string, 0)
+	if err := tx.Select(&ids, subtree, id); err != nil {
+		return nil, fmt.Errorf("failed to query subtree: %w", err)
+	}
+
+	query, queryArgs, err := sqlx.In(update, append(args, ids)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	if _, err := tx.Exec(query, queryArgs...); err != nil {
+		return nil, fmt.Errorf("failed to update subtree: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit subtree update: %w", err)
+	}
+
+	return ids, nil
+}
+
+// ListTrashed returns the tasks in the trash, the most recently deleted first.
+func (s *TaksRepository) ListTrashed() ([]*Task, error) {
+	tasks := make([]*Task, 0)
+	if err := s.db.Select(&tasks, "SELECT * FROM tasks WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC"); err != nil {
+		return nil, fmt.Errorf("failed to query trashed tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// ListTrashedBefore returns the IDs of the tasks that were put in the trash
+// before the given time.
+func (s *TaksRepository) ListTrashedBefore(before time.Time) ([]string, error) {
+	ids := make([]string, 0)
+	err := s.db.Select(&ids, "SELECT id FROM tasks WHERE deleted_at IS NOT NULL AND deleted_at < ?", before.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query trashed tasks: %w", err)
+	}
+
+	return ids, nil
+}
+
+// Purge removes the tasks for good, together with everything that belongs to
+// them. The history is kept. It returns the IDs of the removed attachments,
+// so their files can be removed from the blob store.
+func (s *TaksRepository) Purge(ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := sqlx.In("SELECT id FROM attachments WHERE task_id IN (?)", ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build attachments query: %w", err)
+	}
+
+	attachmentIDs := make([]string, 0)
+	if err := tx.Select(&attachmentIDs, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to query attachments: %w", err)
+	}
+
+	for _, table := range []string{"task_assignees", "task_labels", "task_reminders", "comments", "attachments", "time_entries"} {
+		query, args, err := sqlx.In("DELETE FROM "+table+" WHERE task_id IN (?)", ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build %s delete query: %w", table, err)
+		}
+
+		if _, err := tx.Exec(query, args...); err != nil {
+			return nil, fmt.Errorf("failed to delete from %s: %w", table, err)
+		}
+	}
+
+	query, args, err = sqlx.In("DELETE FROM task_dependencies WHERE task_id IN (?) OR blocked_by IN (?)", ids, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build task_dependencies delete query: %w", err)
+	}
+
+	if _, err := tx.Exec(query, args...); err != nil {
+		return nil, fmt.Errorf("failed to delete from task_dependencies: %w", err)
+	}
+
+	query, args, err = sqlx.In("DELETE FROM tasks WHERE id IN (?)", ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tasks delete query: %w", err)
+	}
+
+	if _, err := tx.Exec(query, args...); err != nil {
+		return nil, fmt.Errorf("failed to delete tasks: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit purge: %w", err)
+	}
+
+	return attachmentIDs, nil
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type TimeEntry struct {
+	ID        string              `db:"id"`
+	TaskID    string              `db:"task_id"`
+	UserID    uint                `db:"user_id"`
+	StartedAt time.Time           `db:"started_at"`
+	EndedAt   sql.Null[time.Time] `db:"ended_at"`
+	// Duration is in seconds, 0 while the timer is running.
+	Duration int64  `db:"duration"`
+	Note     string `db:"note"`
+}
+
+type TimeEntryRepository struct {
+	db *sqlx.DB
+}
+
+func NewTimeEntryRepository(db *sqlx.DB) *TimeEntryRepository {
+	return &TimeEntryRepository{db: db}
+}
+
+func (r *TimeEntryRepository) Create(entry TimeEntry) error {
+	query := `INSERT INTO time_entries
+		(id, task_id, user_id, started_at, ended_at, duration, note)
+	VALUES
+		(:id, :task_id, :user_id, :started_at, :ended_at, :duration, :note)`
+	if _, err := r.db.NamedExec(query, entry); err != nil {
+		return fmt.Errorf("failed to insert time entry: %w", err)
+	}
+
+	return nil
+}
+
+// Stop ends the running entry, it fails if the entry was already stopped.
+func (r *TimeEntryRepository) Stop(id string, endedAt time.Time, duration int64) error {
+	result, err := r.db.Exec(
+		"UPDATE time_entries SET ended_at = ?, duration = ? WHERE id = ? AND ended_at IS NULL",
+		endedAt.UTC(), duration, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to stop time entry: %w", err)
+	}
+
+	res, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if res == 0 {
+		return fmt.Errorf("no rows affected")
+	}
+
+	return nil
+}
+
+func (r *TimeEntryRepository) Delete(id string) error {
+	if _, err := r.db.Exec("DELETE FROM time_entries WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete time entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TimeEntryRepository) Find(id string) (TimeEntry, error) {
+	var entry TimeEntry
+	if err := r.db.Get(&entry, "SELECT * FROM time_entries WHERE id = ?", id); err != nil {
+		return TimeEntry{}, fmt.Errorf("failed to get time entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// FindRunning returns the running entry of the user, sql.ErrNoRows is wrapped
+// in the error when there is none.
+func (r *TimeEntryRepository) FindRunning(userID uint) (TimeEntry, error) {
+	var entry TimeEntry
+	err := r.db.Get(&entry, "SELECT * FROM time_entries WHERE user_id = ? AND ended_at IS NULL", userID)
+	if err != nil {
+		return TimeEntry{}, fmt.Errorf("failed to get running time entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (r *TimeEntryRepository) ListByTask(taskID string) ([]TimeEntry, error) {
+	entries := make([]TimeEntry, 0)
+	err := r.db.Select(&entries, "SELECT * FROM time_entries WHERE task_id = ? ORDER BY started_at, id", taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query time entries: %w", err)
+	}
+
+	return entries, nil
+}
@@ -48,3 +48,12 @@ func (r *UserRepository) FindByUsername(username string) (User, error) {
 
 	return user, nil
 }
+
+func (r *UserRepository) List() ([]User, error) {
+	users := make([]User, 0)
+	if err := r.db.Select(&users, "SELECT * FROM users ORDER BY id"); err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
+	}
+
+	return users, nil
+}
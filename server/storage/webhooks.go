@@ -0,0 +1,169 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Webhook struct {
+	ID         string    `db:"id"`
+	URL        string    `db:"url"`
+	EventTypes string    `db:"event_types"`
+	Secret     string    `db:"secret"`
+	CreatedBy  uint      `db:"created_by"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// WebhookDelivery is an event posted, or still to be posted, to a webhook.
+// NextAttemptAt is only set while it's pending.
+type WebhookDelivery struct {
+	ID             string              `db:"id"`
+	WebhookID      string              `db:"webhook_id"`
+	EventType      string              `db:"event_type"`
+	Payload        string              `db:"payload"`
+	Status         string              `db:"status"`
+	Attempts       int                 `db:"attempts"`
+	NextAttemptAt  sql.Null[time.Time] `db:"next_attempt_at"`
+	ResponseStatus sql.Null[int]       `db:"response_status"`
+	Error          string              `db:"error"`
+	CreatedAt      time.Time           `db:"created_at"`
+	DeliveredAt    sql.Null[time.Time] `db:"delivered_at"`
+}
+
+type WebhookRepository struct {
+	db *sqlx.DB
+}
+
+func NewWebhookRepository(db *sqlx.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+func (r *WebhookRepository) Create(webhook Webhook) error {
+	query := `INSERT INTO webhooks
+		(id, url, event_types, secret, created_by, created_at)
+	VALUES
+		(:id, :url, :event_types, :secret, :created_by, :created_at)`
+	if _, err := r.db.NamedExec(query, webhook); err != nil {
+		return fmt.Errorf("failed to insert webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) List() ([]Webhook, error) {
+	webhooks := make([]Webhook, 0)
+	if err := r.db.Select(&webhooks, "SELECT * FROM webhooks ORDER BY created_at, id"); err != nil {
+		return nil, fmt.Errorf("failed to query webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// Find returns the webhook, sql.ErrNoRows is wrapped in the error when there
+// is none.
+func (r *WebhookRepository) Find(id string) (Webhook, error) {
+	var webhook Webhook
+	if err := r.db.Get(&webhook, "SELECT * FROM webhooks WHERE id = ?", id); err != nil {
+		return Webhook{}, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// Delete removes the webhook together with its deliveries.
+func (r *WebhookRepository) Delete(id string) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM webhook_deliveries WHERE webhook_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+
+	if _, err := tx.Exec("DELETE FROM webhooks WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// CreateDeliveries queues the deliveries all at once.
+func (r *WebhookRepository) CreateDeliveries(deliveries []WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO webhook_deliveries
+		(id, webhook_id, event_type, payload, status, attempts, next_attempt_at, response_status, error, created_at, delivered_at)
+	VALUES
+		(:id, :webhook_id, :event_type, :payload, :status, :attempts, :next_attempt_at, :response_status, :error, :created_at, :delivered_at)`
+	if _, err := r.db.NamedExec(query, deliveries); err != nil {
+		return fmt.Errorf("failed to insert webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateDelivery saves the result of an attempt to post the delivery.
+func (r *WebhookRepository) UpdateDelivery(delivery WebhookDelivery) error {
+	query := `UPDATE webhook_deliveries SET
+		status = :status,
+		attempts = :attempts,
+		next_attempt_at = :next_attempt_at,
+		response_status = :response_status,
+		error = :error,
+		delivered_at = :delivered_at
+	WHERE id = :id`
+	if _, err := r.db.NamedExec(query, delivery); err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// FindDelivery returns the delivery, sql.ErrNoRows is wrapped in the error
+// when there is none.
+func (r *WebhookRepository) FindDelivery(id string) (WebhookDelivery, error) {
+	var delivery WebhookDelivery
+	if err := r.db.Get(&delivery, "SELECT * FROM webhook_deliveries WHERE id = ?", id); err != nil {
+		return WebhookDelivery{}, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// ListDeliveries returns the deliveries of the webhook, newest first.
+func (r *WebhookRepository) ListDeliveries(webhookID string) ([]WebhookDelivery, error) {
+	deliveries := make([]WebhookDelivery, 0)
+	err := r.db.Select(
+		&deliveries,
+		"SELECT * FROM webhook_deliveries WHERE webhook_id = ? ORDER BY created_at DESC, rowid DESC",
+		webhookID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// ListDueDeliveries returns at most limit pending deliveries that should be
+// attempted by the given time, oldest first.
+func (r *WebhookRepository) ListDueDeliveries(now time.Time, limit int) ([]WebhookDelivery, error) {
+	deliveries := make([]WebhookDelivery, 0)
+	err := r.db.Select(
+		&deliveries,
+		"SELECT * FROM webhook_deliveries WHERE status = 'pending' AND next_attempt_at <= ? ORDER BY next_attempt_at, rowid LIMIT ?",
+		now.UTC(), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query due webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,66 @@
+package ws
+
+import (
+	"context"
+	"log"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// BroadcastTaskArchived lets everyone know the task and its subtasks are
+// archived and sends the new cost of the parents of the task.
+func (s *Server) BroadcastTaskArchived(archived tasks.Archived) {
+	event, err := FromEventTaskArchived(EventTaskArchived{Ids: archived.IDs})
+	if err != nil {
+		log.Println("failed to create event from event_task_archived ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+
+	s.broadcastParentUpdates(archived.Task)
+}
+
+// BroadcastTaskUnarchived sends the unarchived tasks, the unarchived root
+// first, and the new cost of the parents of the root.
+func (s *Server) BroadcastTaskUnarchived(unarchived []tasks.Task) {
+	if len(unarchived) == 0 {
+		return
+	}
+
+	for _, task := range unarchived {
+		event, err := FromEventTaskUnarchived(EventTaskCreated{
+			Id:         task.ID,
+			Title:      task.Title,
+			CreatedBy:  task.CreatedBy,
+			ParentId:   task.ParentID,
+			Cost:       eventFromMoney(task.Cost),
+			Budget:     eventFromBudget(task),
+			DueAt:      lo.EmptyableToPtr(task.DueAt),
+			Recurrence: eventFromRecurrence(task.Recurrence),
+			Assignees:  task.Assignees,
+		})
+		if err != nil {
+			log.Println("failed to create event from event_task_unarchived ", err)
+			continue
+		}
+
+		go s.broadcastToAll(event)
+	}
+
+	s.broadcastParentUpdates(unarchived[0])
+}
+
+func (s *Server) handleArchived(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case archived := <-s.taskArchiver.Archived():
+			log.Printf("archived completed task `%s` with %d tasks", archived.Task.ID, len(archived.IDs))
+
+			s.BroadcastTaskArchived(archived)
+		}
+	}
+}
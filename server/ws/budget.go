@@ -0,0 +1,31 @@
+package ws
+
+import (
+	"context"
+	"log"
+)
+
+func (s *Server) handleBudgetExceeded(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case exceeded := <-s.taskUpdateParentCost.BudgetExceeded():
+			task := exceeded.Task
+			log.Printf("task `%s` is over its budget of %s with %s", task.ID, task.Budget, task.Cost)
+
+			event, err := FromEventBudgetExceeded(EventBudgetExceeded{
+				Id:        task.ID,
+				Title:     task.Title,
+				Budget:    eventFromMoney(task.Budget),
+				TotalCost: eventFromMoney(task.Cost),
+			})
+			if err != nil {
+				log.Println("failed to create event from budget_exceeded ", err)
+				continue
+			}
+
+			go s.broadcastToAll(event)
+		}
+	}
+}
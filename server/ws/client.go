@@ -7,13 +7,40 @@ import (
 
 type Client struct {
 	conn *websocket.Conn
-	user users.User
+	// stream is set instead of conn for the clients that only get the
+	// events, over SSE or gRPC.
+	stream *Stream
+	user   users.User
 }
 
 func NewClient(conn *websocket.Conn, user users.User) *Client {
 	return &Client{conn: conn, user: user}
 }
 
+// key is what the client is registered under. A new websocket connection of
+// the user replaces the previous one, while every stream has its own key, so
+// the user can follow the events from several tabs and transports at once.
+func (c *Client) key() string {
+	if c.stream != nil {
+		return "stream:" + c.stream.id
+	}
+
+	return c.user.Username
+}
+
+func (c *Client) write(msg broadcastMessage) error {
+	if c.stream != nil {
+		return c.stream.push(msg)
+	}
+
+	return c.conn.WriteJSON(msg.data)
+}
+
 func (c *Client) Close() {
+	if c.stream != nil {
+		c.stream.stop()
+		return
+	}
+
 	c.conn.Close()
 }
@@ -0,0 +1,49 @@
+package ws
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/zemzale/ubiquitest/domain/comments"
+)
+
+func (s *Server) BroadcastCommentAdded(comment comments.Comment) {
+	event, err := FromEventCommentAdded(eventFromComment(comment))
+	if err != nil {
+		log.Println("failed to create event from event_comment_added ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
+
+func (s *Server) BroadcastCommentEdited(comment comments.Comment) {
+	event, err := FromEventCommentEdited(eventFromComment(comment))
+	if err != nil {
+		log.Println("failed to create event from event_comment_edited ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
+
+func (s *Server) BroadcastCommentDeleted(taskID uuid.UUID, commentID uuid.UUID) {
+	event, err := FromEventCommentDeleted(EventCommentDeleted{Id: commentID, TaskId: taskID})
+	if err != nil {
+		log.Println("failed to create event from event_comment_deleted ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
+
+func eventFromComment(comment comments.Comment) EventComment {
+	return EventComment{
+		Id:        comment.ID,
+		TaskId:    comment.TaskID,
+		AuthorId:  comment.AuthorID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package ws
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/dependencies"
+)
+
+func (s *Server) handleEventTaskDependencyAdd(event EventTaskDependency, c *Client) {
+	log.Printf("handling task_dependency_add event from user `%s` for task `%s`", c.user.Username, event.TaskId)
+
+	deps, added, err := s.dependencyAdd.Run(event.TaskId, event.BlockedBy, c.user.ID)
+	if err != nil {
+		log.Println("failed to add dependency ", err)
+		if replyErr := s.reply(c, EventTypeTaskStoreFailure, err.Error()); replyErr != nil {
+			log.Println("failed to reply with error ", replyErr)
+		}
+		return
+	}
+
+	if added {
+		s.BroadcastTaskDependenciesChanged(deps)
+	}
+}
+
+func (s *Server) handleEventTaskDependencyRemove(event EventTaskDependency, c *Client) {
+	log.Printf("handling task_dependency_remove event from user `%s` for task `%s`", c.user.Username, event.TaskId)
+
+	deps, removed, err := s.dependencyRemove.Run(event.TaskId, event.BlockedBy, c.user.ID)
+	if err != nil {
+		log.Println("failed to remove dependency ", err)
+		if replyErr := s.reply(c, EventTypeTaskStoreFailure, err.Error()); replyErr != nil {
+			log.Println("failed to reply with error ", replyErr)
+		}
+		return
+	}
+
+	if removed {
+		s.BroadcastTaskDependenciesChanged(deps)
+	}
+}
+
+func (s *Server) BroadcastTaskDependenciesChanged(deps dependencies.Dependencies) {
+	event, err := FromEventTaskDependenciesChanged(EventTaskDependenciesChanged{
+		TaskId:    deps.TaskID,
+		BlockedBy: lo.Ternary(deps.BlockedBy == nil, []uuid.UUID{}, deps.BlockedBy),
+		Blocked:   deps.Blocked,
+	})
+	if err != nil {
+		log.Println("failed to create event from event_task_dependencies_changed ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
+
+// BroadcastDependentsChanged sends the dependencies of every task the given
+// task blocks, after it was completed or reopened.
+func (s *Server) BroadcastDependentsChanged(blockedBy uuid.UUID) {
+	dependents, err := s.dependentsList.Run(blockedBy)
+	if err != nil {
+		log.Println("failed to list dependent tasks ", err)
+		return
+	}
+
+	for _, deps := range dependents {
+		s.BroadcastTaskDependenciesChanged(deps)
+	}
+}
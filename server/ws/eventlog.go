@@ -0,0 +1,82 @@
+package ws
+
+import (
+	"slices"
+	"time"
+
+	"github.com/zemzale/ubiquitest/domain/users"
+)
+
+// eventLogSize is how many of the last messages are kept for the clients that
+// reconnect.
+const eventLogSize = 1024
+
+// audience is who a message is sent to, everyone when there are no user IDs.
+type audience struct {
+	userIDs []uint
+	// exceptUserID is the user whose change is being broadcast, they already
+	// know about it.
+	exceptUserID uint
+}
+
+func (a audience) includes(user users.User) bool {
+	if a.exceptUserID != 0 && a.exceptUserID == user.ID {
+		return false
+	}
+
+	return a.userIDs == nil || slices.Contains(a.userIDs, user.ID)
+}
+
+type loggedMessage struct {
+	id       uint64
+	data     any
+	audience audience
+}
+
+// eventLog keeps the last messages that were sent with increasing IDs, so a
+// client that reconnects can get the ones it missed. It isn't safe for
+// concurrent use, the server only touches it while fanning out.
+type eventLog struct {
+	lastID   uint64
+	messages []loggedMessage
+}
+
+// newEventLog starts the IDs from the current time, so the ones handed out
+// before a restart are never reused and are only found to be too old.
+func newEventLog() *eventLog {
+	return &eventLog{
+		lastID:   uint64(time.Now().UnixMicro()),
+		messages: make([]loggedMessage, 0, eventLogSize),
+	}
+}
+
+func (l *eventLog) append(data any, to audience) uint64 {
+	l.lastID++
+
+	if len(l.messages) == eventLogSize {
+		l.messages = slices.Delete(l.messages, 0, 1)
+	}
+	l.messages = append(l.messages, loggedMessage{id: l.lastID, data: data, audience: to})
+
+	return l.lastID
+}
+
+// since returns the messages for the user sent after the given ID. It's not
+// complete when some of them are no longer kept, or the ID was never handed
+// out.
+func (l *eventLog) since(id uint64, user users.User) ([]loggedMessage, bool) {
+	if id > l.lastID {
+		return nil, false
+	}
+
+	complete := len(l.messages) == 0 && id == l.lastID || len(l.messages) > 0 && id >= l.messages[0].id-1
+
+	missed := make([]loggedMessage, 0)
+	for _, message := range l.messages {
+		if message.id > id && message.audience.includes(user) {
+			missed = append(missed, message)
+		}
+	}
+
+	return missed, complete
+}
@@ -0,0 +1,61 @@
+package ws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zemzale/ubiquitest/domain/users"
+)
+
+func TestEventLog(t *testing.T) {
+	t.Parallel()
+
+	alice := users.User{ID: 1, Username: "alice"}
+	bob := users.User{ID: 2, Username: "bob"}
+
+	log := newEventLog()
+	start := log.lastID
+
+	missed, complete := log.since(start, alice)
+	assert.True(t, complete)
+	assert.Empty(t, missed)
+
+	first := log.append("to everyone", audience{})
+	log.append("to bob", audience{userIDs: []uint{bob.ID}})
+	third := log.append("from alice", audience{exceptUserID: alice.ID})
+
+	missed, complete = log.since(start, alice)
+	assert.True(t, complete)
+	assert.Equal(t, []any{"to everyone"}, messageData(missed))
+
+	missed, complete = log.since(first, bob)
+	assert.True(t, complete)
+	assert.Equal(t, []any{"to bob", "from alice"}, messageData(missed))
+
+	missed, complete = log.since(third, bob)
+	assert.True(t, complete)
+	assert.Empty(t, missed)
+
+	_, complete = log.since(third+1, bob)
+	assert.False(t, complete, "an ID that was never handed out")
+
+	for range eventLogSize {
+		log.append("filler", audience{})
+	}
+
+	_, complete = log.since(first, bob)
+	assert.False(t, complete, "the messages after it are no longer kept")
+
+	missed, complete = log.since(log.lastID-1, bob)
+	assert.True(t, complete)
+	assert.Len(t, missed, 1)
+}
+
+func messageData(messages []loggedMessage) []any {
+	data := make([]any, 0, len(messages))
+	for _, message := range messages {
+		data = append(data, message.data)
+	}
+
+	return data
+}
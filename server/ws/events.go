@@ -3,6 +3,8 @@ package ws
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -15,6 +17,61 @@ const (
 	EventTypeTaskCreated      EventType = "task_created"
 	EventTypeTaskStoreFailure EventType = "task_store_error"
 	EventTypeTaskUpdated      EventType = "task_updated"
+	EventTypeTaskDueSoon      EventType = "task_due_soon"
+	EventTypeTaskOverdue      EventType = "task_overdue"
+	// Sent by the clients to change the assignees of a task.
+	EventTypeTaskAssign   EventType = "task_assign"
+	EventTypeTaskUnassign EventType = "task_unassign"
+	// Sent only to the user that was assigned or unassigned.
+	EventTypeTaskAssigned   EventType = "task_assigned"
+	EventTypeTaskUnassigned EventType = "task_unassigned"
+	// Sent to everyone when the assignees of a task change.
+	EventTypeTaskAssigneesChanged EventType = "task_assignees_changed"
+	EventTypeLabelCreated         EventType = "label_created"
+	EventTypeLabelUpdated         EventType = "label_updated"
+	EventTypeLabelDeleted         EventType = "label_deleted"
+	EventTypeTaskLabelsChanged    EventType = "task_labels_changed"
+	EventTypeCommentAdded         EventType = "comment_added"
+	EventTypeCommentEdited        EventType = "comment_edited"
+	EventTypeCommentDeleted       EventType = "comment_deleted"
+	// Sent to everyone when a task and its subtasks are moved to or taken
+	// out of the trash.
+	EventTypeTaskDeleted  EventType = "task_deleted"
+	EventTypeTaskRestored EventType = "task_restored"
+	// Sent to everyone when a task and its subtasks are archived or
+	// unarchived, by a user or automatically after being completed.
+	EventTypeTaskArchived   EventType = "task_archived"
+	EventTypeTaskUnarchived EventType = "task_unarchived"
+	// Sent by the clients to undo or redo their last operation, the result is
+	// sent to everyone as the usual task events.
+	EventTypeUndo EventType = "undo"
+	EventTypeRedo EventType = "redo"
+	// Sent by the clients to make a task block another one or stop blocking
+	// it.
+	EventTypeTaskDependencyAdd    EventType = "task_dependency_add"
+	EventTypeTaskDependencyRemove EventType = "task_dependency_remove"
+	// Sent to everyone when the blockers of a task change or the task gets
+	// blocked or unblocked because one of them was completed or reopened.
+	EventTypeTaskDependenciesChanged EventType = "task_dependencies_changed"
+	// Sent by the clients to move a task among its siblings, the new
+	// positions are sent to everyone.
+	EventTypeTaskReorder   EventType = "task_reorder"
+	EventTypeTaskReordered EventType = "task_reordered"
+	// Sent to everyone when a cost added to a subtask takes the total cost of
+	// a task over its budget.
+	EventTypeBudgetExceeded EventType = "budget_exceeded"
+	// Sent by the clients to start a timer on a task or stop their running
+	// one, the entries are sent to everyone.
+	EventTypeTimerStart   EventType = "timer_start"
+	EventTypeTimerStop    EventType = "timer_stop"
+	EventTypeTimerStarted EventType = "timer_started"
+	EventTypeTimerStopped EventType = "timer_stopped"
+	// Sent to everyone when time is logged or deleted without a timer.
+	EventTypeTimeEntryAdded   EventType = "time_entry_added"
+	EventTypeTimeEntryDeleted EventType = "time_entry_deleted"
+	// Sent over SSE instead of the events a reconnecting client missed when
+	// they are no longer kept, it has to load everything again.
+	EventTypeResync EventType = "resync"
 )
 
 type Event struct {
@@ -34,6 +91,48 @@ func (e Event) AsEventTaskUpdated() (EventTaskUpdated, error) {
 	return data, err
 }
 
+func (e Event) AsEventTaskAssign() (EventTaskAssign, error) {
+	var data EventTaskAssign
+	err := json.Unmarshal(e.Data, &data)
+	return data, err
+}
+
+func (e Event) AsEventTaskUnassign() (EventTaskUnassign, error) {
+	var data EventTaskUnassign
+	err := json.Unmarshal(e.Data, &data)
+	return data, err
+}
+
+func (e Event) AsEventTaskDependency() (EventTaskDependency, error) {
+	var data EventTaskDependency
+	err := json.Unmarshal(e.Data, &data)
+	return data, err
+}
+
+func (e Event) AsEventTaskReorder() (EventTaskReorder, error) {
+	var data EventTaskReorder
+	err := json.Unmarshal(e.Data, &data)
+	return data, err
+}
+
+func (e Event) AsEventTimerStart() (EventTimerStart, error) {
+	var data EventTimerStart
+	err := json.Unmarshal(e.Data, &data)
+	return data, err
+}
+
+func FromEventTaskCreated(data EventTaskCreated) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskCreated,
+		Data:      body,
+	}, nil
+}
+
 func FromEventStoreFailure(data EventTaskStoreFailure) (Event, error) {
 	body, err := json.Marshal(data)
 	if err != nil {
@@ -58,21 +157,480 @@ func FromEventTaskUpdated(data EventTaskUpdated) (Event, error) {
 	}, nil
 }
 
+func FromEventTaskDueSoon(data EventTaskDueSoon) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskDueSoon,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskOverdue(data EventTaskOverdue) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskOverdue,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskAssigned(data EventTaskAssigned) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskAssigned,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskUnassigned(data EventTaskUnassigned) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskUnassigned,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskAssigneesChanged(data EventTaskAssigneesChanged) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskAssigneesChanged,
+		Data:      body,
+	}, nil
+}
+
+func FromEventLabelCreated(data EventLabel) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeLabelCreated,
+		Data:      body,
+	}, nil
+}
+
+func FromEventLabelUpdated(data EventLabel) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeLabelUpdated,
+		Data:      body,
+	}, nil
+}
+
+func FromEventLabelDeleted(data EventLabelDeleted) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeLabelDeleted,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskLabelsChanged(data EventTaskLabelsChanged) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskLabelsChanged,
+		Data:      body,
+	}, nil
+}
+
+func FromEventCommentAdded(data EventComment) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeCommentAdded,
+		Data:      body,
+	}, nil
+}
+
+func FromEventCommentEdited(data EventComment) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeCommentEdited,
+		Data:      body,
+	}, nil
+}
+
+func FromEventCommentDeleted(data EventCommentDeleted) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeCommentDeleted,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskDeleted(data EventTaskDeleted) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskDeleted,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskRestored(data EventTaskCreated) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskRestored,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskArchived(data EventTaskArchived) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskArchived,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskUnarchived(data EventTaskCreated) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskUnarchived,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskDependenciesChanged(data EventTaskDependenciesChanged) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskDependenciesChanged,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTaskReordered(data EventTaskReordered) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTaskReordered,
+		Data:      body,
+	}, nil
+}
+
+func FromEventBudgetExceeded(data EventBudgetExceeded) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeBudgetExceeded,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTimerStarted(data EventTimeEntry) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTimerStarted,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTimerStopped(data EventTimeEntry) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTimerStopped,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTimeEntryAdded(data EventTimeEntry) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTimeEntryAdded,
+		Data:      body,
+	}, nil
+}
+
+func FromEventTimeEntryDeleted(data EventTimeEntry) (Event, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return Event{
+		EventType: EventTypeTimeEntryDeleted,
+		Data:      body,
+	}, nil
+}
+
 type EventTaskCreated struct {
-	Id        uuid.UUID `json:"id"`
-	Title     string    `json:"title"`
-	CreatedBy uint      `json:"created_by"`
-	ParentId  uuid.UUID `json:"parent_id"`
-	Cost      uint      `json:"cost"`
+	Id         uuid.UUID        `json:"id"`
+	Title      string           `json:"title"`
+	CreatedBy  uint             `json:"created_by"`
+	ParentId   uuid.UUID        `json:"parent_id"`
+	Cost       EventMoney       `json:"cost"`
+	Budget     *EventMoney      `json:"budget,omitempty"`
+	DueAt      *time.Time       `json:"due_at,omitempty"`
+	Recurrence *EventRecurrence `json:"recurrence,omitempty"`
+	Assignees  []uint           `json:"assignees,omitempty"`
 }
 
+// EventTaskUpdated sent by a client keeps the cost, budget, due date and
+// recurrence of the task when they are left out, and clears them when they
+// are null.
 type EventTaskUpdated struct {
-	Id        uuid.UUID `json:"id"`
-	Title     string    `json:"title"`
-	Completed bool      `json:"completed"`
-	Cost      uint      `json:"cost"`
+	Id         uuid.UUID        `json:"id"`
+	Title      string           `json:"title"`
+	Completed  bool             `json:"completed"`
+	Cost       EventMoney       `json:"cost"`
+	Budget     *EventMoney      `json:"budget,omitempty"`
+	DueAt      *time.Time       `json:"due_at,omitempty"`
+	Recurrence *EventRecurrence `json:"recurrence,omitempty"`
+
+	// sent are the optional fields that were in the event, even as null.
+	sent []string
+}
+
+func (e *EventTaskUpdated) UnmarshalJSON(data []byte) error {
+	// event has the fields without this method, so it's not called again.
+	type event EventTaskUpdated
+	if err := json.Unmarshal(data, (*event)(e)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	e.sent = nil
+	for _, name := range []string{"cost", "budget", "due_at", "recurrence"} {
+		if _, ok := fields[name]; ok {
+			e.sent = append(e.sent, name)
+		}
+	}
+
+	return nil
+}
+
+func (e EventTaskUpdated) isSent(field string) bool {
+	return slices.Contains(e.sent, field)
+}
+
+// EventMoney is an amount in the minor units of the currency, e.g. cents.
+type EventMoney struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+type EventRecurrence struct {
+	Rule        string `json:"rule"`
+	Timezone    string `json:"timezone,omitempty"`
+	CopySubtree bool   `json:"copy_subtree,omitempty"`
+}
+
+type EventTaskDueSoon struct {
+	Id    uuid.UUID `json:"id"`
+	Title string    `json:"title"`
+	DueAt time.Time `json:"due_at"`
+	// LeadSeconds is how long before the due date the reminder was scheduled.
+	LeadSeconds int64 `json:"lead_seconds"`
+}
+
+type EventTaskOverdue struct {
+	Id    uuid.UUID `json:"id"`
+	Title string    `json:"title"`
+	DueAt time.Time `json:"due_at"`
 }
 
 type EventTaskStoreFailure struct {
 	Error string `json:"error"`
 }
+
+type EventTaskAssign struct {
+	TaskId uuid.UUID `json:"task_id"`
+	UserId uint      `json:"user_id"`
+}
+
+type EventTaskUnassign struct {
+	TaskId uuid.UUID `json:"task_id"`
+	UserId uint      `json:"user_id"`
+}
+
+type EventTaskAssigned struct {
+	TaskId     uuid.UUID `json:"task_id"`
+	Title      string    `json:"title"`
+	UserId     uint      `json:"user_id"`
+	AssignedBy uint      `json:"assigned_by"`
+}
+
+type EventTaskUnassigned struct {
+	TaskId uuid.UUID `json:"task_id"`
+	Title  string    `json:"title"`
+	UserId uint      `json:"user_id"`
+}
+
+type EventTaskAssigneesChanged struct {
+	TaskId    uuid.UUID `json:"task_id"`
+	Assignees []uint    `json:"assignees"`
+}
+
+type EventLabel struct {
+	Id      uuid.UUID `json:"id"`
+	BoardId uuid.UUID `json:"board_id"`
+	Name    string    `json:"name"`
+	Color   string    `json:"color"`
+}
+
+type EventLabelDeleted struct {
+	Id uuid.UUID `json:"id"`
+}
+
+type EventTaskLabelsChanged struct {
+	TaskId uuid.UUID   `json:"task_id"`
+	Labels []uuid.UUID `json:"labels"`
+}
+
+type EventComment struct {
+	Id        uuid.UUID `json:"id"`
+	TaskId    uuid.UUID `json:"task_id"`
+	AuthorId  uint      `json:"author_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type EventCommentDeleted struct {
+	Id     uuid.UUID `json:"id"`
+	TaskId uuid.UUID `json:"task_id"`
+}
+
+type EventTaskDeleted struct {
+	Ids []uuid.UUID `json:"ids"`
+}
+
+type EventTaskArchived struct {
+	Ids []uuid.UUID `json:"ids"`
+}
+
+type EventTaskDependency struct {
+	TaskId    uuid.UUID `json:"task_id"`
+	BlockedBy uuid.UUID `json:"blocked_by"`
+}
+
+type EventTaskDependenciesChanged struct {
+	TaskId    uuid.UUID   `json:"task_id"`
+	BlockedBy []uuid.UUID `json:"blocked_by"`
+	Blocked   bool        `json:"blocked"`
+}
+
+// EventTaskReorder moves the task right after AfterId, or right before
+// BeforeId when AfterId is not set, or to the end with neither.
+type EventTaskReorder struct {
+	Id       uuid.UUID  `json:"id"`
+	AfterId  *uuid.UUID `json:"after_id,omitempty"`
+	BeforeId *uuid.UUID `json:"before_id,omitempty"`
+}
+
+type EventTaskReordered struct {
+	ParentId  uuid.UUID           `json:"parent_id"`
+	Positions []EventTaskPosition `json:"positions"`
+}
+
+type EventTaskPosition struct {
+	Id       uuid.UUID `json:"id"`
+	Position string    `json:"position"`
+}
+
+type EventBudgetExceeded struct {
+	Id        uuid.UUID  `json:"id"`
+	Title     string     `json:"title"`
+	Budget    EventMoney `json:"budget"`
+	TotalCost EventMoney `json:"total_cost"`
+}
+
+type EventTimerStart struct {
+	TaskId uuid.UUID `json:"task_id"`
+	Note   string    `json:"note,omitempty"`
+}
+
+// EventTimeEntry has no end while the timer is running.
+type EventTimeEntry struct {
+	Id              uuid.UUID  `json:"id"`
+	TaskId          uuid.UUID  `json:"task_id"`
+	UserId          uint       `json:"user_id"`
+	StartedAt       time.Time  `json:"started_at"`
+	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	DurationSeconds int64      `json:"duration_seconds"`
+	Note            string     `json:"note,omitempty"`
+}
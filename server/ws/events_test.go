@@ -0,0 +1,53 @@
+package ws
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/money"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+func TestChangeFromEvent(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("7d6c5b4a-3928-4716-a5b4-c3d2e1f0a9b8")
+
+	tests := []struct {
+		name string
+		give string
+		want tasks.Change
+	}{
+		{
+			name: "left out fields are kept",
+			give: `{"id":"7d6c5b4a-3928-4716-a5b4-c3d2e1f0a9b8","title":"Plan","completed":true}`,
+			want: tasks.Change{ID: id, Title: "Plan", Completed: true},
+		},
+		{
+			name: "null fields are cleared",
+			give: `{"id":"7d6c5b4a-3928-4716-a5b4-c3d2e1f0a9b8","title":"Plan","budget":null,"due_at":null,"recurrence":null}`,
+			want: tasks.Change{
+				ID: id, Title: "Plan", Budget: &money.Money{}, DueAt: new(time.Time), Recurrence: &tasks.Recurrence{},
+			},
+		},
+		{
+			name: "sent fields are set",
+			give: `{"id":"7d6c5b4a-3928-4716-a5b4-c3d2e1f0a9b8","title":"Plan","cost":{"amount":250,"currency":"EUR"}}`,
+			want: tasks.Change{ID: id, Title: "Plan", Cost: &money.Money{Amount: 250, Currency: "EUR"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var event EventTaskUpdated
+			require.NoError(t, json.Unmarshal([]byte(tt.give), &event))
+			assert.Equal(t, tt.want, changeFromEvent(event))
+		})
+	}
+}
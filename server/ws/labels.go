@@ -0,0 +1,54 @@
+package ws
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/labels"
+)
+
+func (s *Server) BroadcastLabelCreated(label labels.Label) {
+	event, err := FromEventLabelCreated(EventLabel{Id: label.ID, BoardId: label.BoardID, Name: label.Name, Color: label.Color})
+	if err != nil {
+		log.Println("failed to create event from event_label_created ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
+
+func (s *Server) BroadcastLabelUpdated(label labels.Label) {
+	event, err := FromEventLabelUpdated(EventLabel{Id: label.ID, BoardId: label.BoardID, Name: label.Name, Color: label.Color})
+	if err != nil {
+		log.Println("failed to create event from event_label_updated ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
+
+// BroadcastLabelDeleted lets the clients know the label is gone, they should
+// also remove it from all the tasks that had it.
+func (s *Server) BroadcastLabelDeleted(id uuid.UUID) {
+	event, err := FromEventLabelDeleted(EventLabelDeleted{Id: id})
+	if err != nil {
+		log.Println("failed to create event from event_label_deleted ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
+
+func (s *Server) BroadcastTaskLabelsChanged(taskID uuid.UUID, labelIDs []uuid.UUID) {
+	event, err := FromEventTaskLabelsChanged(EventTaskLabelsChanged{
+		TaskId: taskID,
+		Labels: lo.Ternary(labelIDs == nil, []uuid.UUID{}, labelIDs),
+	})
+	if err != nil {
+		log.Println("failed to create event from event_task_labels_changed ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
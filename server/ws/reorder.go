@@ -0,0 +1,41 @@
+package ws
+
+import (
+	"log"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+func (s *Server) handleEventTaskReorder(event EventTaskReorder, c *Client) {
+	log.Printf("handling task_reorder event from user `%s` for task `%s`", c.user.Username, event.Id)
+
+	reordered, op, err := s.taskReorder.Run(event.Id, lo.FromPtr(event.AfterId), lo.FromPtr(event.BeforeId), c.user.ID)
+	if err != nil {
+		log.Println("failed to reorder task ", err)
+		if replyErr := s.reply(c, EventTypeTaskStoreFailure, err.Error()); replyErr != nil {
+			log.Println("failed to reply with error ", replyErr)
+		}
+		return
+	}
+
+	s.taskUndoStack.Push(c.user.ID, op)
+	s.BroadcastTaskReordered(reordered)
+}
+
+// BroadcastTaskReordered sends the new positions to everyone, including the
+// user that moved the task, since the positions are picked by the server.
+func (s *Server) BroadcastTaskReordered(reordered tasks.Reordered) {
+	event, err := FromEventTaskReordered(EventTaskReordered{
+		ParentId: reordered.ParentID,
+		Positions: lo.Map(reordered.Positions, func(p tasks.TaskPosition, _ int) EventTaskPosition {
+			return EventTaskPosition{Id: p.ID, Position: p.Position}
+		}),
+	})
+	if err != nil {
+		log.Println("failed to create event from event_task_reordered ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
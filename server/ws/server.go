@@ -5,30 +5,61 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/dependencies"
+	"github.com/zemzale/ubiquitest/domain/money"
 	"github.com/zemzale/ubiquitest/domain/tasks"
+	"github.com/zemzale/ubiquitest/domain/timetracking"
 	"github.com/zemzale/ubiquitest/domain/users"
+	"github.com/zemzale/ubiquitest/domain/webhooks"
 )
 
 type Server struct {
+	// connections is guarded by fanOutMu, it's read by the fan-out from the
+	// goroutines of every API.
 	connections map[string]*Client
 
-	// We are using a single channel for client changes to avoid locking
-	// the map and also to avoid race conditions with register/unregister channels
+	// We are using a single channel for client changes to avoid race
+	// conditions with register/unregister channels
 	clientChangeChan chan *clientChange
 
 	writeChan chan broadcastMessage
 
-	taskStore          *tasks.Store
-	taskUpdate         *tasks.Update
-	taskCalculateCost  *tasks.CalculateCost
-	taskFindAllParents *tasks.FindAllParents
-	userFind           *users.FindByUsername
+	// fanOutMu makes the messages reach every client in the order of their
+	// IDs, and the SSE clients subscribe under it so they neither miss nor
+	// repeat any of them.
+	fanOutMu sync.Mutex
+	events   *eventLog
+
+	taskStore            *tasks.Store
+	taskUpdate           *tasks.Update
+	taskCalculateCost    *tasks.CalculateCost
+	taskFindAllParents   *tasks.FindAllParents
+	taskDueScheduler     *tasks.DueScheduler
+	taskAssign           *tasks.Assign
+	taskUnassign         *tasks.Unassign
+	taskUndoStack        *tasks.UndoStack
+	taskUndo             *tasks.Undo
+	taskRedo             *tasks.Redo
+	taskArchiver         *tasks.Archiver
+	taskReorder          *tasks.Reorder
+	taskUpdateParentCost *tasks.UpdateParentCost
+	dependencyAdd        *dependencies.Add
+	dependencyRemove     *dependencies.Remove
+	dependentsList       *dependencies.ListDependents
+	timerStart           *timetracking.Start
+	timerStop            *timetracking.Stop
+	userFind             *users.FindByUsername
+	webhookPublish       *webhooks.Publish
 }
 
 type broadcastMessage struct {
+	id     uint64
 	data   any
 	target *Client
 }
@@ -45,17 +76,54 @@ const (
 	remove
 )
 
-func NewServer(storeTask *tasks.Store, updateTask *tasks.Update, taskCalculateCost *tasks.CalculateCost, taskFindAllParents *tasks.FindAllParents, findUserByUsername *users.FindByUsername) *Server {
+func NewServer(
+	storeTask *tasks.Store,
+	updateTask *tasks.Update,
+	taskCalculateCost *tasks.CalculateCost,
+	taskFindAllParents *tasks.FindAllParents,
+	taskDueScheduler *tasks.DueScheduler,
+	taskAssign *tasks.Assign,
+	taskUnassign *tasks.Unassign,
+	taskUndoStack *tasks.UndoStack,
+	taskUndo *tasks.Undo,
+	taskRedo *tasks.Redo,
+	taskArchiver *tasks.Archiver,
+	taskReorder *tasks.Reorder,
+	taskUpdateParentCost *tasks.UpdateParentCost,
+	dependencyAdd *dependencies.Add,
+	dependencyRemove *dependencies.Remove,
+	dependentsList *dependencies.ListDependents,
+	timerStart *timetracking.Start,
+	timerStop *timetracking.Stop,
+	findUserByUsername *users.FindByUsername,
+	webhookPublish *webhooks.Publish,
+) *Server {
 	return &Server{
 		connections:      make(map[string]*Client),
 		writeChan:        make(chan broadcastMessage),
 		clientChangeChan: make(chan *clientChange),
-
-		taskStore:          storeTask,
-		taskUpdate:         updateTask,
-		taskCalculateCost:  taskCalculateCost,
-		taskFindAllParents: taskFindAllParents,
-		userFind:           findUserByUsername,
+		events:           newEventLog(),
+
+		taskStore:            storeTask,
+		taskUpdate:           updateTask,
+		taskCalculateCost:    taskCalculateCost,
+		taskFindAllParents:   taskFindAllParents,
+		taskDueScheduler:     taskDueScheduler,
+		taskAssign:           taskAssign,
+		taskUnassign:         taskUnassign,
+		taskUndoStack:        taskUndoStack,
+		taskUndo:             taskUndo,
+		taskRedo:             taskRedo,
+		taskArchiver:         taskArchiver,
+		taskReorder:          taskReorder,
+		taskUpdateParentCost: taskUpdateParentCost,
+		dependencyAdd:        dependencyAdd,
+		dependencyRemove:     dependencyRemove,
+		dependentsList:       dependentsList,
+		timerStart:           timerStart,
+		timerStop:            timerStop,
+		userFind:             findUserByUsername,
+		webhookPublish:       webhookPublish,
 	}
 }
 
@@ -63,6 +131,9 @@ func (s *Server) Close() {
 	close(s.clientChangeChan)
 	close(s.writeChan)
 
+	s.fanOutMu.Lock()
+	defer s.fanOutMu.Unlock()
+
 	for _, client := range s.connections {
 		client.Close()
 	}
@@ -71,6 +142,11 @@ func (s *Server) Close() {
 func (s *Server) Run(ctx context.Context) {
 	go s.handleBroadcast(ctx)
 	go s.handleClients(ctx)
+	go s.taskDueScheduler.Run(ctx)
+	go s.handleReminders(ctx)
+	go s.taskArchiver.Run(ctx)
+	go s.handleArchived(ctx)
+	go s.handleBudgetExceeded(ctx)
 }
 
 func (s *Server) handleClients(ctx context.Context) {
@@ -92,25 +168,35 @@ func (s *Server) handleClients(ctx context.Context) {
 }
 
 func (s *Server) registerClient(client *Client) {
+	s.fanOutMu.Lock()
+	defer s.fanOutMu.Unlock()
+
+	s.addClient(client)
+}
+
+// addClient adds the client to the connections, the caller holds fanOutMu.
+func (s *Server) addClient(client *Client) {
 	log.Println("registering client ", client.user.Username)
 
-	if oldClient, ok := s.connections[client.user.Username]; ok {
+	if oldClient, ok := s.connections[client.key()]; ok {
 		oldClient.Close()
 	}
 
-	s.connections[client.user.Username] = client
-
-	go s.handleConnection(client)
+	s.connections[client.key()] = client
 }
 
 func (s *Server) unregisterClient(client *Client) {
 	log.Println("unregistering client ", client.user.Username)
 
-	if _, ok := s.connections[client.user.Username]; !ok {
+	s.fanOutMu.Lock()
+	defer s.fanOutMu.Unlock()
+
+	// The client might have already been replaced by a new connection.
+	if current, ok := s.connections[client.key()]; !ok || current != client {
 		return
 	}
 
-	delete(s.connections, client.user.Username)
+	delete(s.connections, client.key())
 }
 
 func (s *Server) TakeConnection(username string, conn *websocket.Conn) {
@@ -182,6 +268,63 @@ func (s *Server) handleEvent(message []byte, c *Client) {
 		}
 
 		s.handleEventTaskUpdated(taskUpdated, c)
+	case EventTypeTaskAssign:
+		log.Println("received task_assign event from user ", c.user)
+		taskAssign, err := event.AsEventTaskAssign()
+		if err != nil {
+			log.Println("failed to parse task_assign event ", err, " ", string(message))
+		}
+
+		s.handleEventTaskAssign(taskAssign, c)
+	case EventTypeTaskUnassign:
+		log.Println("received task_unassign event from user ", c.user)
+		taskUnassign, err := event.AsEventTaskUnassign()
+		if err != nil {
+			log.Println("failed to parse task_unassign event ", err, " ", string(message))
+		}
+
+		s.handleEventTaskUnassign(taskUnassign, c)
+	case EventTypeTaskReorder:
+		log.Println("received task_reorder event from user ", c.user)
+		taskReorder, err := event.AsEventTaskReorder()
+		if err != nil {
+			log.Println("failed to parse task_reorder event ", err, " ", string(message))
+		}
+
+		s.handleEventTaskReorder(taskReorder, c)
+	case EventTypeTaskDependencyAdd:
+		log.Println("received task_dependency_add event from user ", c.user)
+		dependency, err := event.AsEventTaskDependency()
+		if err != nil {
+			log.Println("failed to parse task_dependency_add event ", err, " ", string(message))
+		}
+
+		s.handleEventTaskDependencyAdd(dependency, c)
+	case EventTypeTaskDependencyRemove:
+		log.Println("received task_dependency_remove event from user ", c.user)
+		dependency, err := event.AsEventTaskDependency()
+		if err != nil {
+			log.Println("failed to parse task_dependency_remove event ", err, " ", string(message))
+		}
+
+		s.handleEventTaskDependencyRemove(dependency, c)
+	case EventTypeTimerStart:
+		log.Println("received timer_start event from user ", c.user)
+		timerStart, err := event.AsEventTimerStart()
+		if err != nil {
+			log.Println("failed to parse timer_start event ", err, " ", string(message))
+		}
+
+		s.handleEventTimerStart(timerStart, c)
+	case EventTypeTimerStop:
+		log.Println("received timer_stop event from user ", c.user)
+		s.handleEventTimerStop(c)
+	case EventTypeUndo:
+		log.Println("received undo event from user ", c.user)
+		s.handleEventUndo(c)
+	case EventTypeRedo:
+		log.Println("received redo event from user ", c.user)
+		s.handleEventRedo(c)
 	case EventTypePing:
 		log.Println("received ping from user ", c.user)
 		if err := s.reply(c, EventTypePing, nil); err != nil {
@@ -197,11 +340,15 @@ func (s *Server) handleEventTaskCreated(event EventTaskCreated, c *Client) {
 	log.Printf("handling task_created event from user `%s` with event `%s`", c.user.Username, event.Id)
 
 	task := tasks.Task{
-		ID:        event.Id,
-		Title:     event.Title,
-		CreatedBy: event.CreatedBy,
-		ParentID:  event.ParentId,
-		Cost:      event.Cost,
+		ID:         event.Id,
+		Title:      event.Title,
+		CreatedBy:  event.CreatedBy,
+		ParentID:   event.ParentId,
+		Cost:       moneyFromEvent(event.Cost),
+		Budget:     budgetFromEvent(event.Budget),
+		DueAt:      lo.FromPtr(event.DueAt),
+		Recurrence: recurrenceFromEvent(event.Recurrence),
+		Assignees:  event.Assignees,
 	}
 
 	if err := s.taskStore.Run(task); err != nil {
@@ -209,68 +356,357 @@ func (s *Server) handleEventTaskCreated(event EventTaskCreated, c *Client) {
 		if replyErr := s.reply(c, EventTypeTaskStoreFailure, err.Error()); replyErr != nil {
 			log.Println("failed to reply with error ", replyErr)
 		}
+		return
 	}
 
+	s.taskUndoStack.Push(c.user.ID, tasks.Operation{Kind: tasks.OperationCreate, After: task})
+
 	go s.broadcast(event, c)
+	go s.publishClientEvent(FromEventTaskCreated(event))
 
-	if task.Cost != 0 && task.ParentID != uuid.Nil {
-		log.Println("updating cost for task parents", task.ID)
+	s.broadcastParentUpdates(task)
+	s.NotifyAssignees(task)
+}
 
-		parents, err := s.taskFindAllParents.Run(task.ParentID)
-		if err != nil {
-			log.Println("failed to find parents ", err)
-			return
+func (s *Server) handleEventTaskUpdated(event EventTaskUpdated, c *Client) {
+	log.Printf("handling task_updated event from user `%s` with event `%s`", c.user.Username, event.Id)
+
+	op, err := s.taskUpdate.Run(changeFromEvent(event), c.user.ID)
+	if err != nil {
+		log.Println("failed to update task ", err)
+		if replyErr := s.reply(c, EventTypeTaskStoreFailure, err.Error()); replyErr != nil {
+			log.Println("failed to reply with error ", replyErr)
+		}
+	} else {
+		s.taskUndoStack.Push(c.user.ID, op)
+		if op.Before.Completed != op.After.Completed {
+			s.BroadcastDependentsChanged(event.Id)
 		}
 
-		for _, parent := range parents {
-			updateEvent, err := FromEventTaskUpdated(EventTaskUpdated{
-				Id:        parent.ID,
-				Title:     parent.Title,
-				Completed: parent.Completed,
-				Cost:      parent.Cost,
-			})
-			if err != nil {
-				log.Println("failed to create event from event_task_updated ", err)
-				continue
-			}
+		// The others get the whole task as it was stored, the event might
+		// have left some of the fields out.
+		updated := eventFromUpdatedTask(op.After)
+		go s.publishClientEvent(FromEventTaskUpdated(updated))
+		s.broadcast(updated, c)
+		s.broadcastCostChange(op)
+	}
 
-			go s.broadcastToAll(updateEvent)
+	// Completing a recurring task creates its next occurrence, which everyone,
+	// including the user that completed it, needs to receive.
+	for _, createdTask := range op.Created {
+		createdEvent, err := FromEventTaskCreated(EventTaskCreated{
+			Id:         createdTask.ID,
+			Title:      createdTask.Title,
+			CreatedBy:  createdTask.CreatedBy,
+			ParentId:   createdTask.ParentID,
+			Cost:       eventFromMoney(createdTask.Cost),
+			Budget:     eventFromBudget(createdTask),
+			DueAt:      lo.EmptyableToPtr(createdTask.DueAt),
+			Recurrence: eventFromRecurrence(createdTask.Recurrence),
+			Assignees:  createdTask.Assignees,
+		})
+		if err != nil {
+			log.Println("failed to create event from event_task_created ", err)
+			continue
 		}
+
+		go s.broadcastToAll(createdEvent)
+		s.broadcastParentUpdates(createdTask)
+		s.NotifyAssignees(createdTask)
 	}
 }
 
-func (s *Server) handleEventTaskUpdated(event EventTaskUpdated, c *Client) {
-	log.Printf("handling task_updated event from user `%s` with event `%s`", c.user.Username, event.Id)
+func (s *Server) handleEventTaskAssign(event EventTaskAssign, c *Client) {
+	log.Printf("handling task_assign event from user `%s` for task `%s`", c.user.Username, event.TaskId)
 
-	task := tasks.Task{
-		ID:        event.Id,
-		Title:     event.Title,
-		Completed: event.Completed,
-		Cost:      event.Cost,
+	task, assigned, err := s.taskAssign.Run(event.TaskId, event.UserId, c.user.ID)
+	if err != nil {
+		log.Println("failed to assign task ", err)
+		if replyErr := s.reply(c, EventTypeTaskStoreFailure, err.Error()); replyErr != nil {
+			log.Println("failed to reply with error ", replyErr)
+		}
+		return
 	}
 
-	if err := s.taskUpdate.Run(task, c.user.ID); err != nil {
-		log.Println("failed to update task ", err)
+	if assigned {
+		s.NotifyTaskAssigned(task, event.UserId, c.user.ID)
+	}
+}
+
+func (s *Server) handleEventTaskUnassign(event EventTaskUnassign, c *Client) {
+	log.Printf("handling task_unassign event from user `%s` for task `%s`", c.user.Username, event.TaskId)
+
+	task, unassigned, err := s.taskUnassign.Run(event.TaskId, event.UserId, c.user.ID)
+	if err != nil {
+		log.Println("failed to unassign task ", err)
 		if replyErr := s.reply(c, EventTypeTaskStoreFailure, err.Error()); replyErr != nil {
 			log.Println("failed to reply with error ", replyErr)
 		}
+		return
 	}
 
-	s.broadcast(event, c)
+	if unassigned {
+		s.NotifyTaskUnassigned(task, event.UserId)
+	}
 }
 
-func (s *Server) broadcastToAll(data any) {
-	for _, conn := range s.connections {
-		s.writeChan <- broadcastMessage{data: data, target: conn}
+// NotifyTaskAssigned lets the assigned user know about their new task and
+// sends the new assignees of the task to everyone.
+func (s *Server) NotifyTaskAssigned(task tasks.Task, userID uint, assignedBy uint) {
+	event, err := FromEventTaskAssigned(EventTaskAssigned{
+		TaskId:     task.ID,
+		Title:      task.Title,
+		UserId:     userID,
+		AssignedBy: assignedBy,
+	})
+	if err != nil {
+		log.Println("failed to create event from event_task_assigned ", err)
+		return
 	}
+
+	go s.sendToUsers(event, []uint{userID})
+	s.broadcastAssignees(task)
+}
+
+// NotifyTaskUnassigned lets the unassigned user know they are no longer
+// assigned and sends the new assignees of the task to everyone.
+func (s *Server) NotifyTaskUnassigned(task tasks.Task, userID uint) {
+	event, err := FromEventTaskUnassigned(EventTaskUnassigned{
+		TaskId: task.ID,
+		Title:  task.Title,
+		UserId: userID,
+	})
+	if err != nil {
+		log.Println("failed to create event from event_task_unassigned ", err)
+		return
+	}
+
+	go s.sendToUsers(event, []uint{userID})
+	s.broadcastAssignees(task)
+}
+
+func (s *Server) broadcastAssignees(task tasks.Task) {
+	event, err := FromEventTaskAssigneesChanged(EventTaskAssigneesChanged{
+		TaskId:    task.ID,
+		Assignees: lo.Ternary(task.Assignees == nil, []uint{}, task.Assignees),
+	})
+	if err != nil {
+		log.Println("failed to create event from event_task_assignees_changed ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
+
+// NotifyAssignees sends task_assigned to everyone a newly created task was
+// assigned to.
+func (s *Server) NotifyAssignees(task tasks.Task) {
+	for _, assignee := range task.Assignees {
+		event, err := FromEventTaskAssigned(EventTaskAssigned{
+			TaskId:     task.ID,
+			Title:      task.Title,
+			UserId:     assignee,
+			AssignedBy: task.CreatedBy,
+		})
+		if err != nil {
+			log.Println("failed to create event from event_task_assigned ", err)
+			continue
+		}
+
+		go s.sendToUsers(event, []uint{assignee})
+	}
+}
+
+// broadcastParentUpdates sends the new total cost of all the parents of a
+// newly created task.
+func (s *Server) broadcastParentUpdates(task tasks.Task) {
+	if task.Cost.IsZero() {
+		return
+	}
+
+	log.Println("updating cost for task parents", task.ID)
+
+	s.broadcastParents(task.ParentID)
+}
+
+// broadcastCostChange sends the new total cost of all the parents of an
+// updated task when the update changed its cost.
+func (s *Server) broadcastCostChange(op tasks.Operation) {
+	if op.Before.Cost == op.After.Cost {
+		return
+	}
+
+	s.broadcastParents(op.After.ParentID)
+}
+
+// broadcastParents sends the parent and all of its ancestors to everyone.
+func (s *Server) broadcastParents(parentID uuid.UUID) {
+	if parentID == uuid.Nil {
+		return
+	}
+
+	parents, err := s.taskFindAllParents.Run(parentID)
+	if err != nil {
+		log.Println("failed to find parents ", err)
+		return
+	}
+
+	for _, parent := range parents {
+		updateEvent, err := FromEventTaskUpdated(EventTaskUpdated{
+			Id:         parent.ID,
+			Title:      parent.Title,
+			Completed:  parent.Completed,
+			Cost:       eventFromMoney(parent.Cost),
+			Budget:     eventFromBudget(parent),
+			DueAt:      lo.EmptyableToPtr(parent.DueAt),
+			Recurrence: eventFromRecurrence(parent.Recurrence),
+		})
+		if err != nil {
+			log.Println("failed to create event from event_task_updated ", err)
+			continue
+		}
+
+		go s.broadcastToAll(updateEvent)
+	}
+}
+
+// changeFromEvent keeps the optional fields the event left out.
+func changeFromEvent(event EventTaskUpdated) tasks.Change {
+	change := tasks.Change{ID: event.Id, Title: event.Title, Completed: event.Completed}
+
+	if event.isSent("cost") {
+		change.Cost = lo.ToPtr(moneyFromEvent(event.Cost))
+	}
+
+	if event.isSent("budget") {
+		change.Budget = lo.ToPtr(budgetFromEvent(event.Budget))
+	}
+
+	if event.isSent("due_at") {
+		change.DueAt = lo.ToPtr(lo.FromPtr(event.DueAt))
+	}
+
+	if event.isSent("recurrence") {
+		change.Recurrence = lo.ToPtr(recurrenceFromEvent(event.Recurrence))
+	}
+
+	return change
+}
+
+func recurrenceFromEvent(recurrence *EventRecurrence) tasks.Recurrence {
+	if recurrence == nil {
+		return tasks.Recurrence{}
+	}
+
+	return tasks.Recurrence{
+		Rule:        recurrence.Rule,
+		Timezone:    recurrence.Timezone,
+		CopySubtree: recurrence.CopySubtree,
+	}
+}
+
+func eventFromRecurrence(recurrence tasks.Recurrence) *EventRecurrence {
+	if recurrence.IsZero() {
+		return nil
+	}
+
+	return &EventRecurrence{
+		Rule:        recurrence.Rule,
+		Timezone:    recurrence.Timezone,
+		CopySubtree: recurrence.CopySubtree,
+	}
+}
+
+func moneyFromEvent(m EventMoney) money.Money {
+	return money.New(m.Amount, m.Currency)
+}
+
+func eventFromMoney(m money.Money) EventMoney {
+	return EventMoney{Amount: m.Amount, Currency: m.Currency}
+}
+
+func budgetFromEvent(m *EventMoney) money.Money {
+	if m == nil {
+		return money.Money{}
+	}
+
+	return moneyFromEvent(*m)
+}
+
+func eventFromBudget(task tasks.Task) *EventMoney {
+	if !task.HasBudget() {
+		return nil
+	}
+
+	return lo.ToPtr(eventFromMoney(task.Budget))
+}
+
+func (s *Server) handleReminders(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case reminder := <-s.taskDueScheduler.Reminders():
+			log.Printf("sending %s reminder for task `%s`", reminder.Kind, reminder.Task.ID)
+
+			event, err := reminderEvent(reminder)
+			if err != nil {
+				log.Println("failed to create reminder event ", err)
+				continue
+			}
+
+			go s.sendToUsers(event, reminder.Recipients)
+		}
+	}
+}
+
+func reminderEvent(reminder tasks.Reminder) (Event, error) {
+	switch reminder.Kind {
+	case tasks.ReminderKindDueSoon:
+		return FromEventTaskDueSoon(EventTaskDueSoon{
+			Id:          reminder.Task.ID,
+			Title:       reminder.Task.Title,
+			DueAt:       reminder.Task.DueAt,
+			LeadSeconds: int64(reminder.Lead / time.Second),
+		})
+	case tasks.ReminderKindOverdue:
+		return FromEventTaskOverdue(EventTaskOverdue{
+			Id:    reminder.Task.ID,
+			Title: reminder.Task.Title,
+			DueAt: reminder.Task.DueAt,
+		})
+	default:
+		return Event{}, fmt.Errorf("unknown reminder kind %s", reminder.Kind)
+	}
+}
+
+func (s *Server) sendToUsers(data any, userIDs []uint) {
+	s.publish(data)
+	s.fanOut(data, audience{userIDs: userIDs})
+}
+
+func (s *Server) broadcastToAll(data any) {
+	s.publish(data)
+	s.fanOut(data, audience{})
 }
 
 func (s *Server) broadcast(data any, broadcaster *Client) {
+	s.fanOut(data, audience{exceptUserID: broadcaster.user.ID})
+}
+
+// fanOut logs the message and sends it to every connected client it is for,
+// whichever transport they use.
+func (s *Server) fanOut(data any, to audience) {
+	s.fanOutMu.Lock()
+	defer s.fanOutMu.Unlock()
+
+	id := s.events.append(data, to)
+
 	for _, conn := range s.connections {
-		if conn.user.ID == broadcaster.user.ID {
+		if !to.includes(conn.user) {
 			continue
 		}
-		s.writeChan <- broadcastMessage{data: data, target: conn}
+		s.writeChan <- broadcastMessage{id: id, data: data, target: conn}
 	}
 }
 
@@ -282,7 +718,7 @@ func (s *Server) handleBroadcast(ctx context.Context) {
 
 		case msg := <-s.writeChan:
 			log.Printf("broadcasting to user '%#v' \n", msg.target.user)
-			if err := msg.target.conn.WriteJSON(msg.data); err != nil {
+			if err := msg.target.write(msg); err != nil {
 				log.Println(err)
 			}
 		}
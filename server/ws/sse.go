@@ -0,0 +1,61 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// keepAliveInterval is how often a comment is sent over an idle SSE stream,
+// so proxies don't close it.
+const keepAliveInterval = 30 * time.Second
+
+// ServeSSE writes the events of the stream to w as Server-Sent Events,
+// calling flush after each, until the context or the stream is done.
+func (st *Stream) ServeSSE(ctx context.Context, w io.Writer, flush func()) error {
+	defer st.Close()
+
+	for _, message := range st.Missed() {
+		if err := writeStreamEvent(w, message); err != nil {
+			return err
+		}
+	}
+	flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-st.Done():
+			return nil
+		case message := <-st.Messages():
+			if err := writeStreamEvent(w, message); err != nil {
+				return err
+			}
+			flush()
+		case <-keepAlive.C:
+			if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return fmt.Errorf("failed to write keep-alive: %w", err)
+			}
+			flush()
+		}
+	}
+}
+
+func writeStreamEvent(w io.Writer, message Message) error {
+	payload, err := json.Marshal(message.Data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", message.ID, payload); err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package ws
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// streamBufferSize is how many messages can wait for a stream before it's
+// considered too slow and closed, it can resume from the last one it got.
+const streamBufferSize = 256
+
+var errStreamClosed = errors.New("stream is closed")
+
+// Message is a message sent to a stream, with the ID to resume after it.
+type Message struct {
+	ID   uint64
+	Data any
+}
+
+// Event returns the message as an Event. The changes made by websocket
+// clients are sent to the others as they were received, without their type,
+// which is added back here.
+func (m Message) Event() (Event, error) {
+	switch data := m.Data.(type) {
+	case Event:
+		return data, nil
+	case EventTaskCreated:
+		return FromEventTaskCreated(data)
+	case EventTaskUpdated:
+		return FromEventTaskUpdated(data)
+	default:
+		return Event{}, fmt.Errorf("unknown message %T", m.Data)
+	}
+}
+
+// Stream is a client that gets the messages over a channel instead of a
+// websocket, for the transports that only send events, like SSE and gRPC. The
+// changes are made over the other APIs.
+type Stream struct {
+	// id tells the streams of the same user apart.
+	id     string
+	server *Server
+	client *Client
+
+	missed []Message
+
+	messages  chan Message
+	done      chan struct{}
+	stopOnce  sync.Once
+	closeOnce sync.Once
+}
+
+// Subscribe connects the user with a stream. When lastEventID is set the
+// messages sent after it are replayed, if some of them are no longer kept a
+// resync event is replayed instead and the client has to load everything
+// again.
+func (s *Server) Subscribe(username string, lastEventID string) (*Stream, error) {
+	user, err := s.userFind.Run(username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	stream := &Stream{
+		id:       uuid.NewString(),
+		server:   s,
+		messages: make(chan Message, streamBufferSize),
+		done:     make(chan struct{}),
+	}
+	stream.client = &Client{stream: stream, user: user}
+
+	// Nothing is sent while subscribing, so every message is either replayed
+	// or queued for the stream, never both.
+	s.fanOutMu.Lock()
+	defer s.fanOutMu.Unlock()
+
+	s.addClient(stream.client)
+
+	if lastEventID == "" {
+		return stream, nil
+	}
+
+	var missed []loggedMessage
+	complete := false
+	if lastID, err := strconv.ParseUint(lastEventID, 10, 64); err == nil {
+		missed, complete = s.events.since(lastID, user)
+	}
+
+	if !complete {
+		stream.missed = []Message{{ID: s.events.lastID, Data: Event{EventType: EventTypeResync}}}
+		return stream, nil
+	}
+
+	for _, message := range missed {
+		stream.missed = append(stream.missed, Message{ID: message.id, Data: message.data})
+	}
+
+	return stream, nil
+}
+
+// Missed returns the messages to replay before the ones from Messages.
+func (st *Stream) Missed() []Message {
+	return st.missed
+}
+
+func (st *Stream) Messages() <-chan Message {
+	return st.messages
+}
+
+// Done is closed when the stream is, because it could not keep up.
+func (st *Stream) Done() <-chan struct{} {
+	return st.done
+}
+
+// Close stops the messages and disconnects the stream.
+func (st *Stream) Close() {
+	st.closeOnce.Do(func() {
+		st.stop()
+		st.server.clientChangeChan <- &clientChange{client: st.client, action: remove}
+	})
+}
+
+func (st *Stream) push(msg broadcastMessage) error {
+	select {
+	case <-st.done:
+		return errStreamClosed
+	default:
+	}
+
+	select {
+	case st.messages <- Message{ID: msg.id, Data: msg.data}:
+		return nil
+	default:
+		st.stop()
+		return fmt.Errorf("stopping the stream of user '%s', it's too slow", st.client.user.Username)
+	}
+}
+
+func (st *Stream) stop() {
+	st.stopOnce.Do(func() {
+		close(st.done)
+	})
+}
@@ -0,0 +1,72 @@
+package ws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zemzale/ubiquitest/domain/users"
+	"github.com/zemzale/ubiquitest/storage"
+)
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err, "failed to open database")
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.NoError(t, storage.CreateDB(db))
+
+	_, err = db.Exec("INSERT INTO users (username) VALUES (?)", "alice")
+	require.NoError(t, err, "failed to insert user")
+
+	s := &Server{
+		connections:      make(map[string]*Client),
+		writeChan:        make(chan broadcastMessage),
+		clientChangeChan: make(chan *clientChange),
+		events:           newEventLog(),
+		userFind:         users.NewFindByUsername(storage.NewUserRepository(db)),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go s.handleBroadcast(ctx)
+	go s.handleClients(ctx)
+
+	receive := func(st *Stream) any {
+		select {
+		case message := <-st.Messages():
+			return message.Data
+		case <-st.Done():
+			t.Fatal("the stream was closed")
+		case <-time.After(time.Second):
+			t.Fatal("no message was sent to the stream")
+		}
+
+		return nil
+	}
+
+	// The same user following the events over SSE and gRPC, from two tabs.
+	sse, err := s.Subscribe("alice", "")
+	require.NoError(t, err)
+	grpc, err := s.Subscribe("alice", "")
+	require.NoError(t, err)
+	otherTab, err := s.Subscribe("alice", "")
+	require.NoError(t, err)
+
+	s.broadcastToAll("first")
+	for _, st := range []*Stream{sse, grpc, otherTab} {
+		assert.Equal(t, "first", receive(st))
+	}
+
+	sse.Close()
+	s.broadcastToAll("second")
+	for _, st := range []*Stream{grpc, otherTab} {
+		assert.Equal(t, "second", receive(st), "closing a stream leaves the others open")
+	}
+}
@@ -0,0 +1,66 @@
+package ws
+
+import (
+	"log"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// BroadcastTaskCreated sends a task created outside of the websocket to
+// everyone, as the same events creating it over the websocket sends.
+func (s *Server) BroadcastTaskCreated(task tasks.Task) {
+	event, err := FromEventTaskCreated(EventTaskCreated{
+		Id:         task.ID,
+		Title:      task.Title,
+		CreatedBy:  task.CreatedBy,
+		ParentId:   task.ParentID,
+		Cost:       eventFromMoney(task.Cost),
+		Budget:     eventFromBudget(task),
+		DueAt:      lo.EmptyableToPtr(task.DueAt),
+		Recurrence: eventFromRecurrence(task.Recurrence),
+		Assignees:  task.Assignees,
+	})
+	if err != nil {
+		log.Println("failed to create event from event_task_created ", err)
+		return
+	}
+
+	s.broadcastToAll(event)
+	s.broadcastParentUpdates(task)
+	s.NotifyAssignees(task)
+}
+
+// BroadcastTaskUpdated sends a task updated outside of the websocket to
+// everyone, with the tasks the update created, as the same events updating
+// it over the websocket sends.
+func (s *Server) BroadcastTaskUpdated(op tasks.Operation) {
+	task := op.After
+	event, err := FromEventTaskUpdated(eventFromUpdatedTask(task))
+	if err != nil {
+		log.Println("failed to create event from event_task_updated ", err)
+		return
+	}
+
+	s.broadcastToAll(event)
+	s.broadcastCostChange(op)
+	if op.Before.Completed != op.After.Completed {
+		s.BroadcastDependentsChanged(task.ID)
+	}
+
+	for _, created := range op.Created {
+		s.BroadcastTaskCreated(created)
+	}
+}
+
+func eventFromUpdatedTask(task tasks.Task) EventTaskUpdated {
+	return EventTaskUpdated{
+		Id:         task.ID,
+		Title:      task.Title,
+		Completed:  task.Completed,
+		Cost:       eventFromMoney(task.Cost),
+		Budget:     eventFromBudget(task),
+		DueAt:      lo.EmptyableToPtr(task.DueAt),
+		Recurrence: eventFromRecurrence(task.Recurrence),
+	}
+}
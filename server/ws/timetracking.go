@@ -0,0 +1,65 @@
+package ws
+
+import (
+	"log"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/timetracking"
+)
+
+func (s *Server) handleEventTimerStart(event EventTimerStart, c *Client) {
+	log.Printf("handling timer_start event from user `%s` for task `%s`", c.user.Username, event.TaskId)
+
+	entry, err := s.timerStart.Run(event.TaskId, c.user.ID, event.Note)
+	if err != nil {
+		log.Println("failed to start timer ", err)
+		if replyErr := s.reply(c, EventTypeTaskStoreFailure, err.Error()); replyErr != nil {
+			log.Println("failed to reply with error ", replyErr)
+		}
+		return
+	}
+
+	s.broadcastTimeEntry(entry, FromEventTimerStarted)
+}
+
+func (s *Server) handleEventTimerStop(c *Client) {
+	log.Printf("handling timer_stop event from user `%s`", c.user.Username)
+
+	entry, err := s.timerStop.Run(c.user.ID)
+	if err != nil {
+		log.Println("failed to stop timer ", err)
+		if replyErr := s.reply(c, EventTypeTaskStoreFailure, err.Error()); replyErr != nil {
+			log.Println("failed to reply with error ", replyErr)
+		}
+		return
+	}
+
+	s.broadcastTimeEntry(entry, FromEventTimerStopped)
+}
+
+func (s *Server) BroadcastTimeEntryAdded(entry timetracking.Entry) {
+	s.broadcastTimeEntry(entry, FromEventTimeEntryAdded)
+}
+
+func (s *Server) BroadcastTimeEntryDeleted(entry timetracking.Entry) {
+	s.broadcastTimeEntry(entry, FromEventTimeEntryDeleted)
+}
+
+func (s *Server) broadcastTimeEntry(entry timetracking.Entry, fromEvent func(EventTimeEntry) (Event, error)) {
+	event, err := fromEvent(EventTimeEntry{
+		Id:              entry.ID,
+		TaskId:          entry.TaskID,
+		UserId:          entry.UserID,
+		StartedAt:       entry.StartedAt,
+		EndedAt:         lo.EmptyableToPtr(entry.EndedAt),
+		DurationSeconds: int64(entry.Duration / time.Second),
+		Note:            entry.Note,
+	})
+	if err != nil {
+		log.Println("failed to create time entry event ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+}
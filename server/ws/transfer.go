@@ -0,0 +1,59 @@
+package ws
+
+import (
+	"log"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// BroadcastTasksImported sends the imported tasks as if they were created,
+// parents first, and the new cost of the parents they were imported under.
+func (s *Server) BroadcastTasksImported(imported tasks.Imported) {
+	if len(imported.Tasks) == 0 {
+		return
+	}
+
+	for _, task := range imported.Tasks {
+		created, err := FromEventTaskCreated(EventTaskCreated{
+			Id:        task.ID,
+			Title:     task.Title,
+			CreatedBy: task.CreatedBy,
+			ParentId:  task.ParentID,
+			Cost:      eventFromMoney(task.Cost),
+			Budget:    eventFromBudget(task),
+			DueAt:     lo.EmptyableToPtr(task.DueAt),
+		})
+		if err != nil {
+			log.Println("failed to create event from event_task_created ", err)
+			continue
+		}
+
+		if !task.Completed {
+			go s.broadcastToAll(created)
+			continue
+		}
+
+		// Tasks are created incomplete, so the completed ones are updated
+		// right after they are created.
+		updated, err := FromEventTaskUpdated(EventTaskUpdated{
+			Id:        task.ID,
+			Title:     task.Title,
+			Completed: task.Completed,
+			Cost:      eventFromMoney(task.Cost),
+			Budget:    eventFromBudget(task),
+			DueAt:     lo.EmptyableToPtr(task.DueAt),
+		})
+		if err != nil {
+			log.Println("failed to create event from event_task_updated ", err)
+			continue
+		}
+
+		go func() {
+			s.broadcastToAll(created)
+			s.broadcastToAll(updated)
+		}()
+	}
+
+	s.broadcastParentUpdates(imported.Tasks[0])
+}
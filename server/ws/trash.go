@@ -0,0 +1,53 @@
+package ws
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+// BroadcastTaskDeleted lets everyone know the task and its subtasks are in the
+// trash and sends the new cost of the parents of the task.
+func (s *Server) BroadcastTaskDeleted(task tasks.Task, deletedIDs []uuid.UUID) {
+	event, err := FromEventTaskDeleted(EventTaskDeleted{Ids: deletedIDs})
+	if err != nil {
+		log.Println("failed to create event from event_task_deleted ", err)
+		return
+	}
+
+	go s.broadcastToAll(event)
+
+	s.broadcastParentUpdates(task)
+}
+
+// BroadcastTaskRestored sends the restored tasks, the restored root first, and
+// the new cost of the parents of the root.
+func (s *Server) BroadcastTaskRestored(restored []tasks.Task) {
+	if len(restored) == 0 {
+		return
+	}
+
+	for _, task := range restored {
+		event, err := FromEventTaskRestored(EventTaskCreated{
+			Id:         task.ID,
+			Title:      task.Title,
+			CreatedBy:  task.CreatedBy,
+			ParentId:   task.ParentID,
+			Cost:       eventFromMoney(task.Cost),
+			Budget:     eventFromBudget(task),
+			DueAt:      lo.EmptyableToPtr(task.DueAt),
+			Recurrence: eventFromRecurrence(task.Recurrence),
+			Assignees:  task.Assignees,
+		})
+		if err != nil {
+			log.Println("failed to create event from event_task_restored ", err)
+			continue
+		}
+
+		go s.broadcastToAll(event)
+	}
+
+	s.broadcastParentUpdates(restored[0])
+}
@@ -0,0 +1,78 @@
+package ws
+
+import (
+	"log"
+
+	"github.com/samber/lo"
+	"github.com/zemzale/ubiquitest/domain/tasks"
+)
+
+func (s *Server) handleEventUndo(c *Client) {
+	log.Printf("handling undo event from user `%s`", c.user.Username)
+
+	changes, err := s.taskUndo.Run(c.user.ID)
+	s.BroadcastChanges(changes)
+	if err != nil {
+		log.Println("failed to undo ", err)
+		if replyErr := s.reply(c, EventTypeTaskStoreFailure, EventTaskStoreFailure{Error: err.Error()}); replyErr != nil {
+			log.Println("failed to reply with error ", replyErr)
+		}
+	}
+}
+
+func (s *Server) handleEventRedo(c *Client) {
+	log.Printf("handling redo event from user `%s`", c.user.Username)
+
+	changes, err := s.taskRedo.Run(c.user.ID)
+	s.BroadcastChanges(changes)
+	if err != nil {
+		log.Println("failed to redo ", err)
+		if replyErr := s.reply(c, EventTypeTaskStoreFailure, EventTaskStoreFailure{Error: err.Error()}); replyErr != nil {
+			log.Println("failed to reply with error ", replyErr)
+		}
+	}
+}
+
+// BroadcastChanges sends the result of an undo or redo to everyone, including
+// the user that did it, as the same events the original operations send.
+func (s *Server) BroadcastChanges(changes tasks.Changes) {
+	for _, task := range changes.Updated {
+		event, err := FromEventTaskUpdated(EventTaskUpdated{
+			Id:         task.ID,
+			Title:      task.Title,
+			Completed:  task.Completed,
+			Cost:       eventFromMoney(task.Cost),
+			Budget:     eventFromBudget(task),
+			DueAt:      lo.EmptyableToPtr(task.DueAt),
+			Recurrence: eventFromRecurrence(task.Recurrence),
+		})
+		if err != nil {
+			log.Println("failed to create event from event_task_updated ", err)
+			continue
+		}
+
+		go s.broadcastToAll(event)
+
+		// Putting a cost back changes the total cost of the ancestors.
+		s.broadcastParents(task.ParentID)
+	}
+
+	if len(changes.DeletedIDs) > 0 {
+		event, err := FromEventTaskDeleted(EventTaskDeleted{Ids: changes.DeletedIDs})
+		if err != nil {
+			log.Println("failed to create event from event_task_deleted ", err)
+		} else {
+			go s.broadcastToAll(event)
+		}
+	}
+
+	for _, task := range changes.Deleted {
+		s.broadcastParentUpdates(task)
+	}
+
+	s.BroadcastTaskRestored(changes.Restored)
+
+	for _, reordered := range changes.Reordered {
+		s.BroadcastTaskReordered(reordered)
+	}
+}
@@ -0,0 +1,37 @@
+package ws
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// publish posts the event to the webhooks subscribed to it. Everything sent to
+// everyone, or to some users, is published once, whether anyone is connected
+// or not.
+func (s *Server) publish(data any) {
+	event, ok := data.(Event)
+	if !ok {
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Println("failed to marshal event for webhooks ", err)
+		return
+	}
+
+	if err := s.webhookPublish.Run(string(event.EventType), payload); err != nil {
+		log.Println("failed to publish event to webhooks ", err)
+	}
+}
+
+// publishClientEvent publishes a change made by a client, which is broadcast
+// to the others as it was received.
+func (s *Server) publishClientEvent(event Event, err error) {
+	if err != nil {
+		log.Println("failed to create event for webhooks ", err)
+		return
+	}
+
+	s.publish(event)
+}